@@ -40,6 +40,8 @@ import (
 	"github.com/kudobuilder/kudo/pkg/controller/instance"
 	"github.com/kudobuilder/kudo/pkg/controller/operator"
 	"github.com/kudobuilder/kudo/pkg/controller/operatorversion"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+	"github.com/kudobuilder/kudo/pkg/feature"
 	"github.com/kudobuilder/kudo/pkg/kubernetes"
 	"github.com/kudobuilder/kudo/pkg/version"
 	kudohook "github.com/kudobuilder/kudo/pkg/webhook"
@@ -58,6 +60,41 @@ func parseSyncPeriod() (*time.Duration, error) {
 	return nil, nil
 }
 
+// parseFeatureGates enables or disables KUDO features, e.g. "ServerSideApply=true",
+// if the variable is present in the environment.
+func parseFeatureGates() error {
+	if val, ok := os.LookupEnv("KUDO_FEATURE_GATES"); ok {
+		return feature.DefaultMutableFeatureGate.Set(val)
+	}
+	return nil
+}
+
+// parseLookupAllowedKinds sets the kinds that templates may look up outside of the instance namespace, e.g.
+// "v1/Namespace,storage.k8s.io/v1/StorageClass", if the variable is present in the environment.
+func parseLookupAllowedKinds() error {
+	if val, ok := os.LookupEnv("KUDO_LOOKUP_ALLOWED_KINDS"); ok {
+		gvks, err := task.ParseLookupAllowedGVKs(val)
+		if err != nil {
+			return err
+		}
+		task.LookupAllowedGVKs = gvks
+	}
+	return nil
+}
+
+// parseExternalTaskAllowedURLs sets the plugin URLs that External tasks may call, e.g.
+// "https://plugins.example.com/,http://backup.kudo-system.svc/run", if the variable is present in the environment.
+func parseExternalTaskAllowedURLs() error {
+	if val, ok := os.LookupEnv("KUDO_EXTERNAL_TASK_ALLOWED_URLS"); ok {
+		urls, err := task.ParseExternalTaskAllowedURLs(val)
+		if err != nil {
+			return err
+		}
+		task.ExternalTaskAllowedURLs = urls
+	}
+	return nil
+}
+
 func getEnv(key, def string) string {
 	val, ok := os.LookupEnv(key)
 	if !ok {
@@ -70,6 +107,21 @@ func main() {
 	// Get version of KUDO
 	log.Printf("KUDO Version: %#v", version.Get())
 
+	if err := parseFeatureGates(); err != nil {
+		log.Printf("Unable to parse feature gates variable: %v", err)
+		os.Exit(1)
+	}
+
+	if err := parseLookupAllowedKinds(); err != nil {
+		log.Printf("Unable to parse lookup allowed kinds variable: %v", err)
+		os.Exit(1)
+	}
+
+	if err := parseExternalTaskAllowedURLs(); err != nil {
+		log.Printf("Unable to parse external task allowed urls variable: %v", err)
+		os.Exit(1)
+	}
+
 	// create new controller-runtime manager
 	syncPeriod, err := parseSyncPeriod()
 	if err != nil {
@@ -118,7 +170,8 @@ func main() {
 	log.Print("Operator controller set up")
 
 	err = (&operatorversion.Reconciler{
-		Client: mgr.GetClient(),
+		Client:   mgr.GetClient(),
+		Recorder: mgr.GetEventRecorderFor("operatorversion-controller"),
 	}).SetupWithManager(mgr)
 	if err != nil {
 		log.Printf("Unable to register operator controller to the manager: %v", err)
@@ -135,6 +188,7 @@ func main() {
 
 	err = (&instance.Reconciler{
 		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
 		Config:    mgr.GetConfig(),
 		Discovery: cachedDiscoveryClient,
 		Recorder:  mgr.GetEventRecorderFor("instance-controller"),
@@ -160,6 +214,18 @@ func main() {
 	}
 	log.Printf("Instance admission webhook set up")
 
+	ovac, err := kudohook.NewOperatorVersionAdmission(mgr.GetConfig(), mgr.GetScheme())
+	if err != nil {
+		log.Printf("Unable to create an uncached client for the webhook: %v", err)
+		os.Exit(1)
+	}
+
+	if err := registerWebhook("/validate", &kudoapi.OperatorVersion{}, &webhook.Admission{Handler: ovac}, mgr); err != nil {
+		log.Printf("Unable to create operatorVersion admission webhook: %v", err)
+		os.Exit(1)
+	}
+	log.Printf("OperatorVersion admission webhook set up")
+
 	// Add more webhooks below using the above registerWebhook method
 
 	// Start the KUDO manager
@@ -7,6 +7,7 @@ require (
 	github.com/Masterminds/semver v1.5.0 // indirect
 	github.com/Masterminds/semver/v3 v3.1.0
 	github.com/Masterminds/sprig v2.22.0+incompatible
+	github.com/evanphx/json-patch v4.9.0+incompatible
 	github.com/go-bindata/go-bindata/v3 v3.1.3
 	github.com/google/go-cmp v0.5.2
 	github.com/gosuri/uitable v0.0.4
@@ -17,6 +18,8 @@ require (
 	github.com/moby/term v0.0.0-20200915141129-7f0af18e79f2 // indirect
 	github.com/onsi/ginkgo v1.14.1
 	github.com/onsi/gomega v1.10.2
+	github.com/pmezard/go-difflib v1.0.0
+	github.com/robfig/cron v1.2.0
 	github.com/spf13/afero v1.4.0
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/pflag v1.0.5
@@ -29,6 +32,7 @@ require (
 	golang.org/x/sys v0.0.0-20200916030750-2334cc1a136f // indirect
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
 	gopkg.in/yaml.v2 v2.3.0
+	helm.sh/helm/v3 v3.4.0
 	k8s.io/api v0.19.2
 	k8s.io/apiextensions-apiserver v0.19.2
 	k8s.io/apimachinery v0.19.2
@@ -39,5 +43,8 @@ require (
 	k8s.io/kubectl v0.19.2
 	sigs.k8s.io/controller-runtime v0.6.3
 	sigs.k8s.io/controller-tools v0.4.1
+	sigs.k8s.io/kustomize/api v0.8.4
 	sigs.k8s.io/yaml v1.2.0
 )
+
+replace github.com/Azure/go-autorest => github.com/Azure/go-autorest v14.2.0+incompatible
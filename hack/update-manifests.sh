@@ -72,10 +72,13 @@ echo "Working with  $NAME"
 cp "$f" "$MANCACHE/$NAME"
 done
 
-# update webhook (add config.url and remove config.caBundle and config.service)
+# update webhooks (add config.url and remove config.caBundle and config.service)
 yq w -i "$MANCACHE/kudo-manager-instance-admission-webhook-config.yaml" webhooks[0].clientConfig.url https://replace-url.com
 yq d -i "$MANCACHE/kudo-manager-instance-admission-webhook-config.yaml" webhooks[0].clientConfig.caBundle
 yq d -i "$MANCACHE/kudo-manager-instance-admission-webhook-config.yaml" webhooks[0].clientConfig.service
+yq w -i "$MANCACHE/kudo-manager-operatorversion-admission-webhook-config.yaml" webhooks[0].clientConfig.url https://replace-url.com
+yq d -i "$MANCACHE/kudo-manager-operatorversion-admission-webhook-config.yaml" webhooks[0].clientConfig.caBundle
+yq d -i "$MANCACHE/kudo-manager-operatorversion-admission-webhook-config.yaml" webhooks[0].clientConfig.service
 
 rm -rf "$TEMP"
 echo "Finished"
\ No newline at end of file
@@ -31,6 +31,32 @@ type InstanceSpec struct {
 	Parameters map[string]string `json:"parameters,omitempty"`
 
 	PlanExecution PlanExecution `json:"planExecution,omitempty"`
+
+	// Schedules trigger plans periodically, e.g. a nightly backup.
+	// +optional
+	Schedules []Schedule `json:"schedules,omitempty"`
+
+	// SensitiveParametersSecret is the name of the Secret in the namespace of the instance that stores the values of
+	// sensitive parameters. The Parameters map only contains references to these values. The Secret has to be named
+	// <instance name>-sensitive-parameters and to be controlled by the instance.
+	// +optional
+	SensitiveParametersSecret string `json:"sensitiveParametersSecret,omitempty"`
+
+	// ParametersFrom sets parameters from keys of Secrets or ConfigMaps in the namespace of the instance. A parameter
+	// can either be set in Parameters or in ParametersFrom. When a referenced value changes, the instance controller
+	// triggers the plan of the parameter the same way a parameter update does.
+	// +optional
+	ParametersFrom map[string]ParameterSource `json:"parametersFrom,omitempty"`
+}
+
+// ParameterSource references the value of a parameter. Exactly one of its fields must be set.
+type ParameterSource struct {
+	// SecretKeyRef selects a key of a Secret in the namespace of the instance.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the instance.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
 }
 
 // There are two ways a plan execution can be triggered:
@@ -38,8 +64,8 @@ type InstanceSpec struct {
 //  2) directly through setting of the InstanceSpec.PlanExecution.PlanName field
 // While indirect (1) triggers happens every time a user changes a parameter, a directly (2) triggered
 // plan is reserved for the situations when parameters doesn't change e.g. a periodic backup is triggered
-// overriding the existing backup file. Additionally, this opens room for canceling and overriding
-// currently running plans in the future.
+// overriding the existing backup file. A currently scheduled (or running) plan can be cancelled by setting
+// the InstanceSpec.PlanExecution.Cancel field.
 // Note: PlanExecution field defines plan name and corresponding parameters that IS CURRENTLY executed.
 // Once the instance controller (IC) is done with the execution, this field will be cleared.
 // Each plan execution has a unique UID so should the same plan be re-triggered it will have a new UID
@@ -48,14 +74,175 @@ type PlanExecution struct {
 	UID      apimachinerytypes.UID `json:"uid,omitempty"`
 	Status   ExecutionStatus       `json:"status,omitempty"`
 
-	// Future PE options like Force: bool. Not needed for now
+	// Cancel signals the instance controller to stop the execution of the scheduled plan. The plan status
+	// is set to CANCELLED and any pipe pods of the plan are removed.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// Trigger describes what caused the plan execution. It is set by the admission webhook and the instance controller.
+	// +optional
+	Trigger PlanTrigger `json:"trigger,omitempty"`
 }
 
+// PlanTrigger describes what caused a plan execution.
+type PlanTrigger string
+
+const (
+	// PlanTriggerInstall is set for the 'deploy' plan of a freshly created instance.
+	PlanTriggerInstall PlanTrigger = "Install"
+	// PlanTriggerParameterUpdate is set for plans triggered by a parameter update.
+	PlanTriggerParameterUpdate PlanTrigger = "ParameterUpdate"
+	// PlanTriggerUpgrade is set for plans triggered by an OperatorVersion upgrade.
+	PlanTriggerUpgrade PlanTrigger = "Upgrade"
+	// PlanTriggerDirect is set for plans directly triggered through the InstanceSpec.PlanExecution.PlanName field.
+	PlanTriggerDirect PlanTrigger = "Direct"
+	// PlanTriggerSchedule is set for plans triggered by one of the InstanceSpec.Schedules.
+	PlanTriggerSchedule PlanTrigger = "Schedule"
+	// PlanTriggerOnFailure is set for plans scheduled by the Plan.OnFailure of a failed plan.
+	PlanTriggerOnFailure PlanTrigger = "OnFailure"
+	// PlanTriggerDeletion is set for the 'cleanup' plan of a deleted instance.
+	PlanTriggerDeletion PlanTrigger = "Deletion"
+	// PlanTriggerParameterSource is set for plans triggered by a changed value of one of the InstanceSpec.ParametersFrom.
+	PlanTriggerParameterSource PlanTrigger = "ParameterSource"
+)
+
+// Schedule triggers a plan based on a cron expression. The instance controller sets the InstanceSpec.PlanExecution.PlanName
+// field when the schedule is due, the same way a user would trigger the plan directly.
+type Schedule struct {
+	// Name identifies the schedule in the InstanceStatus.
+	Name string `json:"name"`
+	// Cron is a cron expression in the standard five field format, e.g. "0 2 * * *", or a predefined schedule like "@daily".
+	Cron string `json:"cron"`
+	// Plan is the name of the triggered plan.
+	Plan string `json:"plan"`
+	// ConcurrencyPolicy defines what happens when the schedule is due while another plan is scheduled or running.
+	// Defaults to Skip.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+}
+
+// ConcurrencyPolicy describes how a schedule is handled when another plan is scheduled or running.
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicySkip skips the run, the plan is triggered next time the schedule is due.
+	ConcurrencyPolicySkip ConcurrencyPolicy = "Skip"
+	// ConcurrencyPolicyQueue queues the run, the plan is triggered as soon as the other plan is terminal.
+	// At most one run is queued per schedule.
+	ConcurrencyPolicyQueue ConcurrencyPolicy = "Queue"
+)
+
 // InstanceStatus defines the observed state of Instance
 type InstanceStatus struct {
 	// slice would be enough here but we cannot use slice because order of sequence in yaml is considered significant while here it's not
 	PlanStatus map[string]PlanStatus `json:"planStatus,omitempty"`
 	Conditions []metav1.Condition    `json:"conditions,omitempty"`
+
+	// AppliedSnapshot captures the OperatorVersion and parameters of the instance after the last successfully
+	// finished plan. It is used to provide the previous state to the plan scheduled by Plan.OnFailure.
+	// +optional
+	AppliedSnapshot *InstanceSnapshot `json:"appliedSnapshot,omitempty"`
+
+	// Schedules contains the status of each schedule of InstanceSpec.Schedules.
+	// +optional
+	Schedules []ScheduleStatus `json:"schedules,omitempty"`
+
+	// PlanHistory contains the last finished plan executions, oldest first. At most PlanHistoryLimit executions are kept.
+	// +optional
+	PlanHistory []PlanExecutionRecord `json:"planHistory,omitempty"`
+
+	// ParameterSources contains a SHA-256 hash of the last observed value of each parameter of
+	// InstanceSpec.ParametersFrom. It is used to detect changed values.
+	// +optional
+	ParameterSources map[string]string `json:"parameterSources,omitempty"`
+
+	// AppliedObjects references the namespaced objects applied by the Apply tasks of the instance. Plans with
+	// Plan.Prune enabled delete the objects of this list that they did not apply.
+	// +optional
+	AppliedObjects []AppliedObject `json:"appliedObjects,omitempty"`
+}
+
+// AppliedObject references an object applied by an Apply task.
+type AppliedObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+}
+
+// PlanHistoryLimit is the maximum number of plan executions kept in the InstanceStatus.PlanHistory.
+const PlanHistoryLimit = 20
+
+// PlanExecutionRecord is a finished plan execution.
+type PlanExecutionRecord struct {
+	// Name of the executed plan.
+	Name string `json:"name"`
+	// UID of the plan execution.
+	UID apimachinerytypes.UID `json:"uid"`
+	// Trigger describes what caused the plan execution.
+	// +optional
+	Trigger PlanTrigger `json:"trigger,omitempty"`
+	// Status is the final status of the plan execution.
+	Status ExecutionStatus `json:"status"`
+	// Message explains the final status, e.g. a detailed error message.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// OperatorVersion is the name of the OperatorVersion the plan was executed with.
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+	// StartedTimestamp is the time the first phase of the plan started.
+	// +optional
+	// +nullable
+	StartedTimestamp *metav1.Time `json:"startedTimestamp,omitempty"`
+	// FinishedTimestamp is the time the plan execution became terminal.
+	// +optional
+	// +nullable
+	FinishedTimestamp *metav1.Time `json:"finishedTimestamp,omitempty"`
+	// ParameterChanges are the parameters that changed since the last successfully finished plan.
+	// +optional
+	ParameterChanges []ParameterChange `json:"parameterChanges,omitempty"`
+}
+
+// ParameterChange is a changed parameter value.
+type ParameterChange struct {
+	// Name of the parameter.
+	Name string `json:"name"`
+	// From is the previous value, it is not set for a parameter that was added.
+	// +optional
+	From *string `json:"from,omitempty"`
+	// To is the new value, it is not set for a parameter that was removed.
+	// +optional
+	To *string `json:"to,omitempty"`
+}
+
+// ScheduleStatus is the observed state of a Schedule.
+type ScheduleStatus struct {
+	// Name of the schedule.
+	Name string `json:"name"`
+	// LastScheduleTime is the last time the plan was triggered by the schedule.
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// NextScheduleTime is the next time the schedule is due.
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// Queued is true if the schedule was due while another plan was scheduled or running and the plan
+	// is triggered as soon as the other plan is terminal.
+	// +optional
+	Queued bool `json:"queued,omitempty"`
+}
+
+// InstanceSnapshot is the state of an Instance at a point in time.
+type InstanceSnapshot struct {
+	// OperatorVersion is a reference to the OperatorVersion the instance was using.
+	OperatorVersion corev1.ObjectReference `json:"operatorVersion,omitempty"`
+	// Version is the version of the referenced OperatorVersion.
+	Version string `json:"version,omitempty"`
+	// AppVersion is the application version of the referenced OperatorVersion.
+	AppVersion string `json:"appVersion,omitempty"`
+	// Parameters are the effective parameter values (including defaults) of the instance.
+	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
 // PlanStatus is representing status of a plan
@@ -97,6 +284,8 @@ type PhaseStatus struct {
 	Status  ExecutionStatus `json:"status,omitempty"`
 	Message string          `json:"message,omitempty"` // more verbose explanation of the status, e.g. a detailed error message
 	Steps   []StepStatus    `json:"steps,omitempty"`
+	// +nullable
+	StartedTimestamp *metav1.Time `json:"startedTimestamp,omitempty"` // time when the execution of the phase started
 }
 
 // StepStatus is representing status of a step
@@ -104,6 +293,11 @@ type StepStatus struct {
 	Name    string          `json:"name,omitempty"`
 	Message string          `json:"message,omitempty"` // more verbose explanation of the status, e.g. a detailed error message
 	Status  ExecutionStatus `json:"status,omitempty"`
+	// +nullable
+	StartedTimestamp *metav1.Time `json:"startedTimestamp,omitempty"` // time when the execution of the step started
+	Attempts         int32        `json:"attempts,omitempty"`         // number of failed attempts to execute the step
+	// +nullable
+	LastAttemptTimestamp *metav1.Time `json:"lastAttemptTimestamp,omitempty"` // time of the last failed attempt
 }
 
 func (s *StepStatus) Set(status ExecutionStatus) {
@@ -146,6 +340,17 @@ func (s *PlanStatus) Phase(name string) *PhaseStatus {
 	return nil
 }
 
+// StartedTimestamp returns the start time of the first started phase of the plan or nil if no phase started yet
+func (s *PlanStatus) StartedTimestamp() *metav1.Time {
+	var started *metav1.Time
+	for _, ph := range s.Phases {
+		if ph.StartedTimestamp != nil && (started == nil || ph.StartedTimestamp.Before(started)) {
+			started = ph.StartedTimestamp
+		}
+	}
+	return started
+}
+
 func (s *PlanStatus) Set(status ExecutionStatus) {
 	if s.Status != status {
 		s.LastUpdatedTimestamp = &metav1.Time{Time: time.Now()}
@@ -184,6 +389,13 @@ const (
 	// ExecutionNeverRun is used when this plan/phase/step was never run so far
 	ExecutionNeverRun ExecutionStatus = "NEVER_RUN"
 
+	// ExecutionCancelled is used when the execution of this plan/phase/step was cancelled by the user.
+	// Any non-terminal state can transition to this one.
+	ExecutionCancelled ExecutionStatus = "CANCELLED"
+
+	// ExecutionSkipped is used when a phase/step was not executed because its 'when' expression evaluated to false.
+	ExecutionSkipped ExecutionStatus = "SKIPPED"
+
 	// DeployPlanName is the name of the deployment plan
 	DeployPlanName = "deploy"
 
@@ -206,9 +418,9 @@ var (
 	}
 )
 
-// IsTerminal returns true if the status is terminal (either complete, or in a fatal error)
+// IsTerminal returns true if the status is terminal (either complete, in a fatal error, cancelled or skipped)
 func (s ExecutionStatus) IsTerminal() bool {
-	return s == ExecutionComplete || s == ExecutionFatalError
+	return s == ExecutionComplete || s == ExecutionFatalError || s == ExecutionCancelled || s == ExecutionSkipped
 }
 
 // IsFinished returns true if the status is complete successfully (not in 'FATAL_ERROR' state)
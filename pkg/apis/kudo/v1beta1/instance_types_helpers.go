@@ -4,16 +4,32 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	instanceCleanupFinalizerName = "kudo.dev.instance.cleanup"
+
+	// SensitiveParameterRefPrefix prefixes the value of a sensitive parameter in the instance spec. The actual value is
+	// stored in the sensitive parameters Secret of the instance, the rest of the reference is its key in the Secret.
+	SensitiveParameterRefPrefix = "sensitive:"
+
+	// MaskedParameterValue replaces the values of sensitive parameters in the output of the CLI
+	MaskedParameterValue = "*****"
+
+	// sensitiveKeySuffixLength is the length of the random suffix of a key in the sensitive parameters Secret. A new
+	// key is used for every new value, so that the reference in the instance spec changes and the update triggers a plan.
+	sensitiveKeySuffixLength = 8
 )
 
 func GetInstance(namespacedName types.NamespacedName, c client.Client) (i *Instance, err error) {
@@ -89,15 +105,19 @@ func (i *Instance) UpdateInstanceStatus(ps *PlanStatus, updatedTimestamp *metav1
 }
 
 // ResetPlanStatus method resets a PlanStatus for a passed plan name and instance. Plan/phase/step statuses
-// are set to ExecutionPending meaning that the controller will restart plan execution.
+// are set to ExecutionPending and their start times and attempts are cleared meaning that the controller will restart plan execution.
 func (i *Instance) ResetPlanStatus(ps *PlanStatus, uid types.UID, updatedTimestamp *metav1.Time) {
 	ps.UID = uid
 	ps.Status = ExecutionPending
 	for i := range ps.Phases {
 		ps.Phases[i].Set(ExecutionPending)
+		ps.Phases[i].StartedTimestamp = nil
 
 		for j := range ps.Phases[i].Steps {
 			ps.Phases[i].Steps[j].Set(ExecutionPending)
+			ps.Phases[i].Steps[j].StartedTimestamp = nil
+			ps.Phases[i].Steps[j].Attempts = 0
+			ps.Phases[i].Steps[j].LastAttemptTimestamp = nil
 		}
 	}
 
@@ -105,6 +125,51 @@ func (i *Instance) ResetPlanStatus(ps *PlanStatus, uid types.UID, updatedTimesta
 	i.UpdateInstanceStatus(ps, updatedTimestamp)
 }
 
+// CancelPlanStatus method cancels a PlanStatus for a passed plan name and instance. All phases and steps that are
+// not terminal yet are set to ExecutionCancelled, completed ones are left untouched.
+func (i *Instance) CancelPlanStatus(ps *PlanStatus, updatedTimestamp *metav1.Time) {
+	for i := range ps.Phases {
+		for j := range ps.Phases[i].Steps {
+			if !ps.Phases[i].Steps[j].Status.IsTerminal() {
+				ps.Phases[i].Steps[j].Set(ExecutionCancelled)
+			}
+		}
+
+		if !ps.Phases[i].Status.IsTerminal() {
+			ps.Phases[i].Set(ExecutionCancelled)
+		}
+	}
+	ps.SetWithMessage(ExecutionCancelled, "plan execution was cancelled by the user")
+
+	// update plan status and instance aggregated status
+	i.UpdateInstanceStatus(ps, updatedTimestamp)
+}
+
+// RecordPlanExecution appends a finished plan execution to the Status.PlanHistory. The oldest executions are dropped
+// once there are more than PlanHistoryLimit. An execution that was already recorded is ignored.
+func (i *Instance) RecordPlanExecution(record PlanExecutionRecord) {
+	for _, r := range i.Status.PlanHistory {
+		if r.UID == record.UID && r.Name == record.Name {
+			return
+		}
+	}
+
+	i.Status.PlanHistory = append(i.Status.PlanHistory, record)
+	if len(i.Status.PlanHistory) > PlanHistoryLimit {
+		i.Status.PlanHistory = i.Status.PlanHistory[len(i.Status.PlanHistory)-PlanHistoryLimit:]
+	}
+}
+
+// RecordedPlanExecution returns the recorded plan execution with the given UID or nil if there is none.
+func (i *Instance) RecordedPlanExecution(uid types.UID) *PlanExecutionRecord {
+	for k := range i.Status.PlanHistory {
+		if i.Status.PlanHistory[k].UID == uid {
+			return &i.Status.PlanHistory[k]
+		}
+	}
+	return nil
+}
+
 // IsDeleting returns true is the instance is being deleted.
 func (i *Instance) IsDeleting() bool {
 	// a delete request is indicated by a non-zero 'metadata.deletionTimestamp',
@@ -189,6 +254,216 @@ func (i *Instance) GetOperatorVersion(c client.Reader) (ov *OperatorVersion, err
 	return GetOperatorVersionByName(i.Spec.OperatorVersion.Name, i.OperatorVersionNamespace(), c)
 }
 
+// IsSensitiveParameterRef returns true if the parameter value references a value in the sensitive parameters Secret
+func IsSensitiveParameterRef(value string) bool {
+	return strings.HasPrefix(value, SensitiveParameterRefPrefix)
+}
+
+// SensitiveParametersSecretName returns the name of the Secret that stores the sensitive parameter values of an instance
+func SensitiveParametersSecretName(instanceName string) string {
+	return fmt.Sprintf("%s-sensitive-parameters", instanceName)
+}
+
+// ResolveSensitiveParameters returns a copy of the passed parameters of the instance, e.g. its spec or applied snapshot
+// parameters, with the references of all parameters that the operator version declares as sensitive replaced by the
+// values stored in the sensitive parameters Secret. Values of other parameters are kept as they are, even if they look
+// like a reference. The Secret is only read if there is at least one reference.
+func (i *Instance) ResolveSensitiveParameters(params map[string]string, ov *OperatorVersion, c client.Reader) (map[string]string, error) {
+	sensitive := map[string]bool{}
+	for _, p := range ov.Spec.Parameters {
+		p := p
+		sensitive[p.Name] = p.IsSensitive()
+	}
+
+	resolved := make(map[string]string, len(params))
+	var secret *corev1.Secret
+	for name, value := range params {
+		if !sensitive[name] || !IsSensitiveParameterRef(value) {
+			resolved[name] = value
+			continue
+		}
+
+		if secret == nil {
+			if i.Spec.SensitiveParametersSecret == "" {
+				return nil, fmt.Errorf("parameter %s references a sensitive value but instance %s/%s has no sensitive parameters secret", name, i.Namespace, i.Name)
+			}
+			var err error
+			if secret, err = i.sensitiveParametersSecret(c); err != nil {
+				return nil, err
+			}
+		}
+
+		v, ok := secret.Data[strings.TrimPrefix(value, SensitiveParameterRefPrefix)]
+		if !ok {
+			return nil, fmt.Errorf("sensitive parameters secret %s/%s has no value %s for parameter %s", secret.Namespace, secret.Name, value, name)
+		}
+		resolved[name] = string(v)
+	}
+	return resolved, nil
+}
+
+// sensitiveParametersSecret returns the sensitive parameters Secret of the instance. The Secret must be named after the
+// instance and be controlled by it. An instance that is not created yet has no UID, its Secret must not be controlled
+// by any other object then.
+func (i *Instance) sensitiveParametersSecret(c client.Reader) (*corev1.Secret, error) {
+	name := SensitiveParametersSecretName(i.Name)
+	if i.Spec.SensitiveParametersSecret != name {
+		return nil, fmt.Errorf("instance %s/%s references sensitive parameters secret %s instead of %s", i.Namespace, i.Name, i.Spec.SensitiveParametersSecret, name)
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: i.Namespace, Name: name}
+	if err := c.Get(context.TODO(), key, secret); err != nil {
+		return nil, fmt.Errorf("failed to get sensitive parameters secret %s: %w", key, err)
+	}
+
+	owner := metav1.GetControllerOf(secret)
+	switch {
+	case i.UID == "" && owner != nil:
+		return nil, fmt.Errorf("sensitive parameters secret %s is controlled by %s %s", key, owner.Kind, owner.Name)
+	case i.UID != "" && (owner == nil || owner.UID != i.UID):
+		return nil, fmt.Errorf("sensitive parameters secret %s is not controlled by instance %s/%s", key, i.Namespace, i.Name)
+	}
+	return secret, nil
+}
+
+// PlaintextSensitiveParameters returns the sorted names of all parameters that the operator version declares as
+// sensitive and whose passed values are no references to the sensitive parameters Secret
+func PlaintextSensitiveParameters(ov *OperatorVersion, params map[string]string) []string {
+	names := []string{}
+	for _, p := range ov.Spec.Parameters {
+		p := p
+		if v, ok := params[p.Name]; ok && p.IsSensitive() && !IsSensitiveParameterRef(v) {
+			names = append(names, p.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StoreSensitiveParameterValues moves the values of all sensitive parameters of the operator version from the passed
+// parameters into the data of the sensitive parameters Secret of the instance and returns the parameters with
+// references to the stored values. The passed parameters are not modified. A value that is already stored keeps its
+// reference, so that updating a sensitive parameter to its current value doesn't trigger a plan. Values that are
+// neither referenced by the instance nor by its last applied snapshot are removed from the Secret.
+func (i *Instance) StoreSensitiveParameterValues(secret *corev1.Secret, ov *OperatorVersion, params map[string]string) map[string]string {
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+
+	result := make(map[string]string, len(params))
+	for n, v := range params {
+		result[n] = v
+	}
+	for _, n := range PlaintextSensitiveParameters(ov, params) {
+		ref := i.Spec.Parameters[n]
+		if stored, ok := secret.Data[sensitiveKey(ref)]; ok && IsSensitiveParameterRef(ref) && string(stored) == params[n] {
+			result[n] = ref
+			continue
+		}
+
+		key := fmt.Sprintf("%s.%s", n, rand.String(sensitiveKeySuffixLength))
+		secret.Data[key] = []byte(params[n])
+		result[n] = SensitiveParameterRefPrefix + key
+	}
+
+	i.pruneSensitiveValues(secret, result)
+	return result
+}
+
+// pruneSensitiveValues removes all values from the secret that are neither referenced by the updated parameters, the
+// remaining parameters of the instance nor by its last applied snapshot. The latter are still needed to render the
+// {{ .Previous }} values.
+func (i *Instance) pruneSensitiveValues(secret *corev1.Secret, updated map[string]string) {
+	referenced := map[string]bool{}
+	for n, v := range i.Spec.Parameters {
+		if _, ok := updated[n]; !ok {
+			referenced[sensitiveKey(v)] = true
+		}
+	}
+	for _, v := range updated {
+		referenced[sensitiveKey(v)] = true
+	}
+	if i.Status.AppliedSnapshot != nil {
+		for _, v := range i.Status.AppliedSnapshot.Parameters {
+			referenced[sensitiveKey(v)] = true
+		}
+	}
+
+	for key := range secret.Data {
+		if !referenced[key] {
+			delete(secret.Data, key)
+		}
+	}
+}
+
+// sensitiveKey returns the key in the sensitive parameters Secret of a parameter value or an empty string if the value
+// is no reference
+func sensitiveKey(value string) string {
+	if !IsSensitiveParameterRef(value) {
+		return ""
+	}
+	return strings.TrimPrefix(value, SensitiveParameterRefPrefix)
+}
+
+// String returns a description of the referenced value, e.g. "secret db-credentials/password"
+func (s ParameterSource) String() string {
+	switch {
+	case s.SecretKeyRef != nil:
+		return fmt.Sprintf("secret %s/%s", s.SecretKeyRef.Name, s.SecretKeyRef.Key)
+	case s.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("configmap %s/%s", s.ConfigMapKeyRef.Name, s.ConfigMapKeyRef.Key)
+	default:
+		return "<none>"
+	}
+}
+
+// ResolveParametersFrom returns the current values of the parameters in Spec.ParametersFrom. Parameters that reference
+// an optional Secret or ConfigMap (or key) that doesn't exist are omitted, so that their default value is used.
+func (i *Instance) ResolveParametersFrom(c client.Reader) (map[string]string, error) {
+	values := make(map[string]string, len(i.Spec.ParametersFrom))
+	for name, source := range i.Spec.ParametersFrom {
+		var (
+			value    string
+			found    bool
+			optional *bool
+			err      error
+		)
+		switch {
+		case source.SecretKeyRef != nil:
+			optional = source.SecretKeyRef.Optional
+			secret := &corev1.Secret{}
+			err = c.Get(context.TODO(), types.NamespacedName{Namespace: i.Namespace, Name: source.SecretKeyRef.Name}, secret)
+			if err == nil {
+				var b []byte
+				b, found = secret.Data[source.SecretKeyRef.Key]
+				value = string(b)
+			}
+		case source.ConfigMapKeyRef != nil:
+			optional = source.ConfigMapKeyRef.Optional
+			cm := &corev1.ConfigMap{}
+			err = c.Get(context.TODO(), types.NamespacedName{Namespace: i.Namespace, Name: source.ConfigMapKeyRef.Name}, cm)
+			if err == nil {
+				value, found = cm.Data[source.ConfigMapKeyRef.Key]
+			}
+		default:
+			return nil, fmt.Errorf("parameter %s has no secret or configmap reference", name)
+		}
+
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get %s of parameter %s: %w", source, name, err)
+		}
+		if !found {
+			if optional != nil && *optional {
+				continue
+			}
+			return nil, fmt.Errorf("%s of parameter %s in namespace %s does not exist", source, name, i.Namespace)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
 // IsChildInstance method return true if this instance is owned by another instance (as a dependency) and false otherwise.
 // If there is any owner with the same kind 'Instance' then this Instance is owned by another one.
 func (i *Instance) IsChildInstance() bool {
@@ -274,6 +549,40 @@ func SelectPlan(possiblePlans []string, ov *OperatorVersion) *string {
 	return nil
 }
 
+// TriggeredByParameterUpdate determines what plan to run based on parameters that changed and the corresponding parameter trigger.
+func TriggeredByParameterUpdate(params []Parameter, ov *OperatorVersion) (*string, error) {
+	// If no parameters were changed, we return an empty string so no plan would be triggered
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	plans := make([]string, 0)
+	for _, p := range params {
+		if p.Trigger != "" {
+			if PlanExists(p.Trigger, ov) {
+				plans = append(plans, p.Trigger)
+			} else {
+				return nil, fmt.Errorf("param %s defined trigger plan %s, but plan not defined in operatorversion", p.Name, p.Trigger)
+			}
+		}
+	}
+	plans = funk.UniqString(plans)
+
+	switch len(plans) {
+	case 0:
+		// no plan could be triggered since we do not force existence of the "deploy" plan in the operators
+		fallback := SelectPlan([]string{UpdatePlanName, DeployPlanName}, ov)
+		if fallback == nil {
+			return nil, fmt.Errorf("couldn't find any plans that would be triggered by the update")
+		}
+		return fallback, nil
+	case 1:
+		return &plans[0], nil
+	default:
+		return nil, fmt.Errorf("triggering multiple plans: [%v] at once is not allowed", plans)
+	}
+}
+
 func GetStepStatus(stepName string, phaseStatus *PhaseStatus) *StepStatus {
 	for i, p := range phaseStatus.Steps {
 		if p.Name == stepName {
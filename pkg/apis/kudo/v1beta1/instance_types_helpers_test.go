@@ -16,11 +16,16 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var (
@@ -221,3 +226,213 @@ func TestNoPlanEverExecuted(t *testing.T) {
 		assert.Equal(t, tt.expectedResult, actual)
 	}
 }
+
+func TestCancelPlanStatus(t *testing.T) {
+	i := Instance{}
+	i.Spec.PlanExecution = PlanExecution{PlanName: "deploy", UID: "test", Cancel: true}
+	i.Status.PlanStatus = map[string]PlanStatus{"deploy": {
+		Status: ExecutionInProgress,
+		Name:   "deploy",
+		Phases: []PhaseStatus{
+			{Name: "first", Status: ExecutionComplete, Steps: []StepStatus{{Status: ExecutionComplete, Name: "step"}}},
+			{Name: "second", Status: ExecutionInProgress, Steps: []StepStatus{{Status: ErrorStatus, Name: "step"}, {Status: ExecutionPending, Name: "other"}}},
+		},
+	}}
+
+	ps := i.PlanStatus("deploy")
+	i.CancelPlanStatus(ps, &metav1.Time{Time: testTime})
+
+	actual := i.Status.PlanStatus["deploy"]
+	assert.Equal(t, ExecutionCancelled, actual.Status)
+	assert.Equal(t, ExecutionComplete, actual.Phases[0].Status)
+	assert.Equal(t, ExecutionComplete, actual.Phases[0].Steps[0].Status)
+	assert.Equal(t, ExecutionCancelled, actual.Phases[1].Status)
+	assert.Equal(t, ExecutionCancelled, actual.Phases[1].Steps[0].Status)
+	assert.Equal(t, ExecutionCancelled, actual.Phases[1].Steps[1].Status)
+	assert.Equal(t, ExecutionCancelled, i.Spec.PlanExecution.Status)
+	assert.True(t, i.Spec.PlanExecution.Status.IsTerminal())
+}
+
+func TestRecordPlanExecution(t *testing.T) {
+	i := Instance{}
+	for n := 0; n < PlanHistoryLimit+5; n++ {
+		i.RecordPlanExecution(PlanExecutionRecord{Name: "deploy", UID: types.UID(fmt.Sprintf("uid-%d", n)), Status: ExecutionComplete})
+	}
+
+	assert.Equal(t, PlanHistoryLimit, len(i.Status.PlanHistory))
+	assert.Equal(t, types.UID("uid-5"), i.Status.PlanHistory[0].UID, "the oldest executions should be dropped")
+	assert.Equal(t, types.UID(fmt.Sprintf("uid-%d", PlanHistoryLimit+4)), i.Status.PlanHistory[PlanHistoryLimit-1].UID)
+
+	i.RecordPlanExecution(PlanExecutionRecord{Name: "deploy", UID: "uid-10", Status: ExecutionFatalError})
+	assert.Equal(t, PlanHistoryLimit, len(i.Status.PlanHistory))
+	assert.Equal(t, ExecutionComplete, i.RecordedPlanExecution("uid-10").Status, "an execution should only be recorded once")
+	assert.Nil(t, i.RecordedPlanExecution("uid-0"))
+}
+
+func TestResolveSensitiveParameters(t *testing.T) {
+	sensitive := true
+	ov := &OperatorVersion{Spec: OperatorVersionSpec{Parameters: []Parameter{
+		{Name: "PASSWORD", Sensitive: &sensitive},
+		{Name: "NODES"},
+		{Name: "PREFIX"},
+	}}}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-sensitive-parameters", Namespace: "default"},
+		Data:       map[string][]byte{"PASSWORD.abc": []byte("s3cr3t")},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, secret)
+
+	i := Instance{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	i.Spec.Parameters = map[string]string{"PASSWORD": "sensitive:PASSWORD.abc", "NODES": "3", "PREFIX": "sensitive:PASSWORD.abc"}
+
+	_, err := i.ResolveSensitiveParameters(i.Spec.Parameters, ov, c)
+	assert.EqualError(t, err, "parameter PASSWORD references a sensitive value but instance default/test has no sensitive parameters secret")
+
+	i.Spec.SensitiveParametersSecret = "test-sensitive-parameters"
+	resolved, err := i.ResolveSensitiveParameters(i.Spec.Parameters, ov, c)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"PASSWORD": "s3cr3t", "NODES": "3", "PREFIX": "sensitive:PASSWORD.abc"}, resolved, "only sensitive parameters must be resolved")
+	assert.Equal(t, "sensitive:PASSWORD.abc", i.Spec.Parameters["PASSWORD"], "the passed parameters must not be modified")
+
+	_, err = i.ResolveSensitiveParameters(map[string]string{"PASSWORD": "sensitive:PASSWORD.def"}, ov, c)
+	assert.EqualError(t, err, "sensitive parameters secret default/test-sensitive-parameters has no value sensitive:PASSWORD.def for parameter PASSWORD")
+
+	resolved, err = i.ResolveSensitiveParameters(map[string]string{"NODES": "3"}, ov, nil)
+	assert.NoError(t, err, "the secret should only be read if there is a reference")
+	assert.Equal(t, map[string]string{"NODES": "3"}, resolved)
+
+	other := i.DeepCopy()
+	other.Spec.SensitiveParametersSecret = "other-sensitive-parameters"
+	_, err = other.ResolveSensitiveParameters(other.Spec.Parameters, ov, c)
+	assert.EqualError(t, err, "instance default/test references sensitive parameters secret other-sensitive-parameters instead of test-sensitive-parameters")
+
+	created := i.DeepCopy()
+	created.UID = "instance-uid"
+	_, err = created.ResolveSensitiveParameters(created.Spec.Parameters, ov, c)
+	assert.EqualError(t, err, "sensitive parameters secret default/test-sensitive-parameters is not controlled by instance default/test")
+
+	owned := secret.DeepCopy()
+	owned.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(created, SchemeGroupVersion.WithKind("Instance"))}
+	resolved, err = created.ResolveSensitiveParameters(created.Spec.Parameters, ov, fake.NewFakeClientWithScheme(scheme.Scheme, owned))
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cr3t", resolved["PASSWORD"])
+
+	_, err = i.ResolveSensitiveParameters(i.Spec.Parameters, ov, fake.NewFakeClientWithScheme(scheme.Scheme, owned))
+	assert.EqualError(t, err, "sensitive parameters secret default/test-sensitive-parameters is controlled by Instance test", "a secret of another object must not be used before the instance is created")
+}
+
+func TestResolveParametersFrom(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t")},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+		Data:       map[string]string{"nodes": "3"},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, secret, cm)
+
+	optional := true
+	i := Instance{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+	i.Spec.ParametersFrom = map[string]ParameterSource{
+		"PASSWORD": {SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "password"}},
+		"NODES":    {ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "nodes"}},
+		"MEMORY":   {ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "memory", Optional: &optional}},
+	}
+
+	resolved, err := i.ResolveParametersFrom(c)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"PASSWORD": "s3cr3t", "NODES": "3"}, resolved)
+
+	i.Spec.ParametersFrom["CPUS"] = ParameterSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "cpus"}}
+	_, err = i.ResolveParametersFrom(c)
+	assert.EqualError(t, err, "secret missing/cpus of parameter CPUS in namespace default does not exist")
+
+	i.Spec.ParametersFrom["CPUS"] = ParameterSource{}
+	_, err = i.ResolveParametersFrom(c)
+	assert.EqualError(t, err, "parameter CPUS has no secret or configmap reference")
+}
+
+func TestTriggeredByParameterUpdate(t *testing.T) {
+	defaultValue := "default"
+	ov := &OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "default"},
+		TypeMeta:   metav1.TypeMeta{Kind: "OperatorVersion", APIVersion: "kudo.dev/v1beta1"},
+		Spec: OperatorVersionSpec{
+			Plans: map[string]Plan{"deploy": {}, "update": {}, "backup": {}},
+			Parameters: []Parameter{
+				{
+					Name:    "param",
+					Default: &defaultValue,
+				},
+			},
+		},
+	}
+
+	update := "update"
+	backup := "backup"
+	deploy := "deploy"
+
+	tests := []struct {
+		name    string
+		params  []Parameter
+		ov      *OperatorVersion
+		want    *string
+		wantErr bool
+	}{
+		{
+			name:    "no change doesn't trigger anything",
+			params:  []Parameter{},
+			ov:      ov,
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "param without an explicit trigger, triggers update plan",
+			params:  []Parameter{{Name: "foo"}},
+			ov:      ov,
+			want:    &update,
+			wantErr: false,
+		},
+		{
+			name:    "param with an explicit trigger",
+			params:  []Parameter{{Name: "foo", Trigger: "backup"}},
+			ov:      ov,
+			want:    &backup,
+			wantErr: false,
+		},
+		{
+			name:    "two params with the same triggers",
+			params:  []Parameter{{Name: "foo", Trigger: "deploy"}, {Name: "bar", Trigger: "deploy"}},
+			ov:      ov,
+			want:    &deploy,
+			wantErr: false,
+		},
+		{
+			name:    "two params with conflicting triggers lead to an error",
+			params:  []Parameter{{Name: "foo", Trigger: "deploy"}, {Name: "bar", Trigger: "update"}},
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "params triggering a non-existing plan",
+			params:  []Parameter{{Name: "foo", Trigger: "fake"}},
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TriggeredByParameterUpdate(tt.params, tt.ov)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TriggeredByParameterUpdate() error = %v, wantErr %v, got = %v", err, tt.wantErr, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -44,6 +44,53 @@ type OperatorVersionSpec struct {
 
 	// UpgradableFrom lists all OperatorVersions that can upgrade to this OperatorVersion.
 	UpgradableFrom []corev1.ObjectReference `json:"upgradableFrom,omitempty"`
+
+	// HealthChecks declare when objects of a kind, typically a custom resource, are healthy. They are used by the
+	// Apply task and for the readiness of an instance. Objects of kinds without a health check are healthy when they
+	// are created, unless their status follows the common conventions (`status.observedGeneration` and the
+	// `Ready`, `Reconciling` and `Stalled` conditions).
+	// +optional
+	HealthChecks []HealthCheck `json:"healthChecks,omitempty"`
+}
+
+// HealthCheck declares when the objects of a kind are healthy.
+type HealthCheck struct {
+	// APIVersion of the objects, e.g. `kafka.strimzi.io/v1beta1`.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the objects, e.g. `Kafka`.
+	Kind string `json:"kind"`
+	// Rules that all have to pass for an object to be healthy.
+	Rules []HealthRule `json:"rules"`
+}
+
+// HealthRule is a single rule of a HealthCheck. Exactly one of its fields has to be set.
+type HealthRule struct {
+	// Condition requires a status condition of the object to have a status.
+	// +optional
+	Condition *ConditionHealthRule `json:"condition,omitempty"`
+	// Field requires a field of the object to have a value.
+	// +optional
+	Field *FieldHealthRule `json:"field,omitempty"`
+	// ObservedGeneration requires `status.observedGeneration` to be equal to `metadata.generation`, i.e. the latest
+	// spec of the object was processed by its controller.
+	// +optional
+	ObservedGeneration bool `json:"observedGeneration,omitempty"`
+}
+
+// ConditionHealthRule requires a status condition, e.g. `type: Ready` and `status: "True"`.
+type ConditionHealthRule struct {
+	Type string `json:"type"`
+	// Status of the condition. Defaults to "True".
+	// +optional
+	Status string `json:"status,omitempty"`
+}
+
+// FieldHealthRule requires a field of the object to have a value, e.g. `path: "{.status.phase}"` and `value: Running`.
+type FieldHealthRule struct {
+	// Path is a JSONPath expression selecting the field.
+	Path string `json:"path"`
+	// Value the field has to have.
+	Value string `json:"value"`
 }
 
 // Ordering specifies how the subitems in this plan/phase should be rolled out.
@@ -66,6 +113,21 @@ type Plan struct {
 	// +optional
 	// +nullable
 	Phases []Phase `json:"phases"`
+	// OnFailure names a plan that is scheduled by the instance controller when this plan fails with a fatal error,
+	// e.g. a 'rollback' plan. The parameters and the OperatorVersion of the last successfully finished plan are
+	// available in its templates as {{ .Previous.Params }}, {{ .Previous.OperatorVersion }} etc.
+	// +optional
+	OnFailure string `json:"onFailure,omitempty"`
+	// Timeout is the maximum duration of the plan execution, e.g. "30m". The plan fails with a fatal error if it is
+	// not completed in time.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Prune deletes the objects of the instance that were applied by an earlier execution of a plan with Prune
+	// enabled but not by this plan, once it is successfully finished. This cleans up objects whose templates were
+	// removed from the OperatorVersion, so a pruning plan must apply all objects of the instance, e.g. 'deploy'.
+	// Objects of steps the plan skips because of a 'when' expression are kept.
+	// +optional
+	Prune bool `json:"prune,omitempty"`
 }
 
 // ParameterType specifies the type of a parameter value.
@@ -101,6 +163,14 @@ type Phase struct {
 	// Steps maps a step name to a list of templated Kubernetes objects stored as a string.
 	// +optional
 	Steps []Step `json:"steps"`
+	// When is a template expression that has to render to "true" or "false", e.g. `{{ gt (atoi .Params.NODE_COUNT) 3 }}`.
+	// If it renders to "false", the phase and all its steps are skipped.
+	// +optional
+	When string `json:"when,omitempty"`
+	// Timeout is the maximum duration of the phase execution. The plan fails with a fatal error if the phase is
+	// not completed in time.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // Step defines a specific set of operations that occur.
@@ -109,6 +179,14 @@ type Step struct {
 	Name string `json:"name"`
 	// +optional
 	Tasks []string `json:"tasks"`
+	// When is a template expression that has to render to "true" or "false", e.g. `{{ gt (atoi .Params.NODE_COUNT) 3 }}`.
+	// If it renders to "false", the step is skipped.
+	// +optional
+	When string `json:"when,omitempty"`
+	// Timeout is the maximum duration of the step execution. The plan fails with a fatal error if the step is
+	// not completed in time.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // Task is a global, polymorphic implementation of all publicly available tasks
@@ -119,6 +197,25 @@ type Task struct {
 	Kind string `json:"kind"`
 	// +optional
 	Spec TaskSpec `json:"spec"`
+	// Timeout is the maximum duration of the task execution, measured from the start of the step the task
+	// belongs to. The plan fails with a fatal error if the task is not done in time.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retry limits how often the task is retried after a transient error. Without it, transient errors are retried
+	// until the task succeeds or a timeout is reached.
+	// +optional
+	Retry *RetryPolicy `json:"retry,omitempty"`
+}
+
+// RetryPolicy specifies how a task is retried after a transient error.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of failed attempts of the step the task belongs to. Once reached, the step
+	// fails with a fatal error.
+	// +optional
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// Backoff is the minimum duration between two attempts, e.g. "30s".
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
 }
 
 // TaskSpec embeds all possible task specs. This allows us to avoid writing custom un/marshallers that would only parse
@@ -127,10 +224,16 @@ type Task struct {
 // future should this become an issue.
 type TaskSpec struct {
 	ResourceTaskSpec     `json:",inline"`
+	ApplyTaskSpec        `json:",inline"`
 	DummyTaskSpec        `json:",inline"`
 	PipeTaskSpec         `json:",inline"`
 	ToggleTaskSpec       `json:",inline"`
 	KudoOperatorTaskSpec `json:",inline"`
+	WaitTaskSpec         `json:",inline"`
+	ExecTaskSpec         `json:",inline"`
+	HelmTaskSpec         `json:",inline"`
+	KustomizeTaskSpec    `json:",inline"`
+	ExternalTaskSpec     `json:",inline"`
 }
 
 // ResourceTaskSpec is referencing a list of resources
@@ -140,12 +243,117 @@ type ResourceTaskSpec struct {
 	Resources []string `json:"resources,omitempty"`
 }
 
+// ApplyTaskSpec configures how an Apply task applies its resources
+type ApplyTaskSpec struct {
+	// ServerSideApply makes the task use server-side apply with KUDO's own field manager instead of a client-side
+	// three-way merge. Defaults to the `ServerSideApply` feature gate of the manager.
+	// +optional
+	ServerSideApply *bool `json:"serverSideApply,omitempty"`
+}
+
 // ToggleTaskSpec is referencing a ResourceTaskSpec and a parameter
 type ToggleTaskSpec struct {
 	// +optional
 	Parameter string `json:"parameter,omitempty"`
 }
 
+// WaitTaskSpec waits for existing objects, referenced by the task resources, to reach a condition. The task can be
+// bound in time with Task.Timeout.
+type WaitTaskSpec struct {
+	// Condition uses the syntax of `kubectl wait --for`: `condition=Ready` waits for the status condition "Ready" to be
+	// "True" (`condition=Ready=False` for another status) and `jsonpath={.status.phase}=Running` waits for the JSONPath
+	// expression to return the given value (or any value, if omitted). Without a condition, the objects are waited
+	// for to be healthy.
+	// +optional
+	Condition string `json:"condition,omitempty"`
+}
+
+// ExecTaskSpec runs a command in a container of an existing pod, e.g. to alter a database schema or rebalance a
+// cluster. The command might be executed more than once and should therefore be idempotent.
+type ExecTaskSpec struct {
+	// PodSelector is a templated label selector, e.g. `app=cassandra,instance={{ .Name }}`. The command is executed
+	// in the first running and ready pod (ordered by name) in the instance namespace that matches the selector.
+	// +optional
+	PodSelector string `json:"podSelector,omitempty"`
+	// Container is the name of the container to execute the command in. Defaults to the first container of the pod.
+	// +optional
+	Container string `json:"container,omitempty"`
+	// Command is the templated command (and its arguments) to execute. It is not run in a shell.
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// FailurePolicy defines how a non-zero exit code of the command is treated: either `Fatal` (the default) or
+	// `Retry`, which treats it as a transient error, bound by the Retry policy and the Timeout of the task.
+	// +optional
+	FailurePolicy ExecFailurePolicy `json:"failurePolicy,omitempty"`
+	// Output stores the stdout of the command in a pipe artifact.
+	// +optional
+	Output *ExecOutputSpec `json:"output,omitempty"`
+}
+
+// HelmTaskSpec renders a Helm chart bundled in the templates folder of the package with the template engine of Helm,
+// like `helm template` does, and applies the rendered resources like an Apply task.
+type HelmTaskSpec struct {
+	// Chart is the directory of the chart in the templates folder, e.g. `redis` for `templates/redis/Chart.yaml`.
+	// Subcharts have to be unpacked in the `charts` directory of the chart.
+	// +optional
+	Chart string `json:"chart,omitempty"`
+	// ValuesFile is a template outside of the chart directory that is rendered with the instance parameters. The
+	// resulting YAML overrides the default values of the chart.
+	// +optional
+	ValuesFile string `json:"valuesFile,omitempty"`
+}
+
+// KustomizeTaskSpec builds a kustomization in the templates folder of the package and applies the resulting
+// resources like an Apply task.
+type KustomizeTaskSpec struct {
+	// Kustomization is the directory of the kustomization in the templates folder, e.g. `overlays/prod` for
+	// `templates/overlays/prod/kustomization.yaml`. Its files are not rendered as templates.
+	// +optional
+	Kustomization string `json:"kustomization,omitempty"`
+	// Patches are templates outside of the kustomization directory that are rendered with the instance parameters
+	// and applied as strategic merge patches on top of the kustomization.
+	// +optional
+	// +nullable
+	Patches []string `json:"patches,omitempty"`
+}
+
+// ExternalTaskSpec delegates the task to an out-of-process plugin. The plugin is called with the task metadata,
+// the instance parameters and the pipe artifacts, and reports whether the task is done, in progress or has failed.
+// It must respond within a few seconds and report long running work as in progress. Sensitive parameters are only
+// POSTed to the plugin if the task opts in with SensitiveParameters.
+type ExternalTaskSpec struct {
+	// URL of the plugin endpoint the task is POSTed to, e.g. `https://my-plugin.kudo-system.svc/run`. Plain `http`
+	// is only allowed for cluster-local services. The KUDO manager can restrict the allowed plugin URLs.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// Config is passed to the plugin as is. Task kinds registered in-process can read it as well.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+	// SensitiveParameters sends the resolved values of sensitive parameters to the plugin as well. They are omitted
+	// by default.
+	// +optional
+	SensitiveParameters bool `json:"sensitiveParameters,omitempty"`
+}
+
+// ExecFailurePolicy defines how a failing command of an Exec task is treated.
+type ExecFailurePolicy string
+
+const (
+	// ExecFailurePolicyFatal fails the plan when the command fails.
+	ExecFailurePolicyFatal ExecFailurePolicy = "Fatal"
+	// ExecFailurePolicyRetry retries the command when it fails.
+	ExecFailurePolicyRetry ExecFailurePolicy = "Retry"
+)
+
+// ExecOutputSpec describes how the stdout of an Exec task is stored. Like pipe files, it is referenced in templates
+// as {{ .Pipes.<Key> }}. The stdout is stored under the `stdout` key of the ConfigMap or Secret.
+type ExecOutputSpec struct {
+	// Kind is either `ConfigMap` or `Secret`.
+	Kind string `json:"kind"`
+	// Key is the name under which the output is referenced in templates, e.g. `{{ .Pipes.<Key> }}`.
+	Key string `json:"key"`
+}
+
 // DummyTaskSpec can succeed or fail on demand and is very useful for testing operators
 type DummyTaskSpec struct {
 	// +optional
@@ -199,8 +407,10 @@ type KudoOperatorTaskSpec struct {
 
 // OperatorVersionStatus defines the observed state of OperatorVersion.
 type OperatorVersionStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Conditions contain the result of the validation of the OperatorVersion by the controller. The "Valid" condition
+	// is "True" if the OperatorVersion passes the same checks as `kubectl kudo package verify`.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -208,6 +418,7 @@ type OperatorVersionStatus struct {
 
 // OperatorVersion is the Schema for the operatorversions API.
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
 type OperatorVersion struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
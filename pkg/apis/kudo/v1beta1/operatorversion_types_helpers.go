@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,6 +48,21 @@ func GetOperatorVersionByName(name, ns string, c client.Reader) (ov *OperatorVer
 	return ov, nil
 }
 
+// ListInstancesOfOperatorVersion returns the instances of all namespaces that reference the OperatorVersion
+func ListInstancesOfOperatorVersion(name, ns string, c client.Reader) ([]Instance, error) {
+	l := &InstanceList{}
+	if err := c.List(context.TODO(), l); err != nil {
+		return nil, err
+	}
+	instances := []Instance{}
+	for _, i := range l.Items {
+		if i.Spec.OperatorVersion.Name == name && i.OperatorVersionNamespace() == ns {
+			instances = append(instances, i)
+		}
+	}
+	return instances, nil
+}
+
 // Sortable Operator implements functionality to correctly sort OVs by name, appVersion and operatorVersion
 var _ kudo.SortableOperator = &OperatorVersion{}
 
@@ -69,3 +86,36 @@ func ToSortableOperatorList(ovList []OperatorVersion) kudo.SortableOperatorList
 	}
 	return l
 }
+
+type ValidationType string
+
+const (
+	ValidationSucceeded ValidationType = "ValidationSucceeded"
+	ValidationFailed    ValidationType = "ValidationFailed"
+
+	validConditionType = "Valid"
+)
+
+// SetValidation sets the "Valid" condition of the OperatorVersion for its current generation
+func (ov *OperatorVersion) SetValidation(reason ValidationType, msg string) {
+	status := metav1.ConditionFalse
+	if reason == ValidationSucceeded {
+		status = metav1.ConditionTrue
+	}
+
+	condition := metav1.Condition{Type: validConditionType, Status: status, Message: msg, Reason: string(reason)}
+	meta.SetStatusCondition(&ov.Status.Conditions, condition)
+	// SetStatusCondition doesn't update the generation of an existing condition
+	ov.Validation().ObservedGeneration = ov.Generation
+}
+
+// Validation returns the "Valid" condition of the OperatorVersion, or nil if the OperatorVersion was not validated yet
+func (ov *OperatorVersion) Validation() *metav1.Condition {
+	return meta.FindStatusCondition(ov.Status.Conditions, validConditionType)
+}
+
+// IsValidated returns true if the current generation of the OperatorVersion was validated
+func (ov *OperatorVersion) IsValidated() bool {
+	c := ov.Validation()
+	return c != nil && c.ObservedGeneration == ov.Generation
+}
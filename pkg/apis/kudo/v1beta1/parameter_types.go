@@ -33,4 +33,8 @@ type Parameter struct {
 
 	// Defines a list of allowed values. If Default is set and Enum is not nil, the value must be in this list as well
 	Enum *[]string `json:"enum,omitempty"`
+
+	// Sensitive marks a parameter whose value is confidential, e.g. a password. Its value is stored in a Secret of the
+	// instance instead of the instance spec and is masked in the output of the CLI and in diagnostics.
+	Sensitive *bool `json:"sensitive,omitempty"`
 }
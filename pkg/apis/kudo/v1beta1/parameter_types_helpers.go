@@ -12,6 +12,10 @@ func (p *Parameter) IsImmutable() bool {
 	return p.Immutable != nil && *p.Immutable
 }
 
+func (p *Parameter) IsSensitive() bool {
+	return p.Sensitive != nil && *p.Sensitive
+}
+
 func (p *Parameter) IsRequired() bool {
 	return p.Required != nil && *p.Required
 }
@@ -54,16 +58,25 @@ func (p *Parameter) ValidateValue(pValue string) error {
 	}
 
 	if err := ValidateParameterValueForType(p.Type, pValue); err != nil {
-		return fmt.Errorf("parameter %q has an invalid value %q: %v", p.Name, pValue, err)
+		return p.invalidValueError(pValue, err)
 	}
 	if p.IsEnum() {
 		if err := ValidateParameterValueForEnum(p.EnumValues(), pValue); err != nil {
-			return fmt.Errorf("parameter %q has an invalid value %q: %v", p.Name, pValue, err)
+			return p.invalidValueError(pValue, err)
 		}
 	}
 	return nil
 }
 
+// invalidValueError returns the error for an invalid parameter value. The value of a sensitive parameter must not end
+// up in an error message, so neither the value nor the cause (which usually contains the value) are included.
+func (p *Parameter) invalidValueError(pValue string, err error) error {
+	if p.IsSensitive() {
+		return fmt.Errorf("parameter %q has an invalid value", p.Name)
+	}
+	return fmt.Errorf("parameter %q has an invalid value %q: %v", p.Name, pValue, err)
+}
+
 func ValidateParameterValueForType(pType ParameterType, pValue interface{}) error {
 	switch pType {
 	case StringValueType:
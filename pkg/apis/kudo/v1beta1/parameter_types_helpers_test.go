@@ -226,3 +226,12 @@ func TestValidateType(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateValue_Sensitive(t *testing.T) {
+	p := Parameter{Name: "PORT", Type: IntegerValueType}
+	assert.EqualError(t, p.ValidateValue("s3cr3t"), `parameter "PORT" has an invalid value "s3cr3t": type is "integer" but format of "s3cr3t" is invalid: strconv.ParseInt: parsing "s3cr3t": invalid syntax`)
+
+	sensitive := true
+	p.Sensitive = &sensitive
+	assert.EqualError(t, p.ValidateValue("s3cr3t"), `parameter "PORT" has an invalid value`)
+}
@@ -24,6 +24,59 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedObject) DeepCopyInto(out *AppliedObject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedObject.
+func (in *AppliedObject) DeepCopy() *AppliedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyTaskSpec) DeepCopyInto(out *ApplyTaskSpec) {
+	*out = *in
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyTaskSpec.
+func (in *ApplyTaskSpec) DeepCopy() *ApplyTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionHealthRule) DeepCopyInto(out *ConditionHealthRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionHealthRule.
+func (in *ConditionHealthRule) DeepCopy() *ConditionHealthRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionHealthRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DummyTaskSpec) DeepCopyInto(out *DummyTaskSpec) {
 	*out = *in
@@ -40,6 +93,152 @@ func (in *DummyTaskSpec) DeepCopy() *DummyTaskSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecOutputSpec) DeepCopyInto(out *ExecOutputSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecOutputSpec.
+func (in *ExecOutputSpec) DeepCopy() *ExecOutputSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecOutputSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecTaskSpec) DeepCopyInto(out *ExecTaskSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(ExecOutputSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecTaskSpec.
+func (in *ExecTaskSpec) DeepCopy() *ExecTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTaskSpec) DeepCopyInto(out *ExternalTaskSpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTaskSpec.
+func (in *ExternalTaskSpec) DeepCopy() *ExternalTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldHealthRule) DeepCopyInto(out *FieldHealthRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldHealthRule.
+func (in *FieldHealthRule) DeepCopy() *FieldHealthRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldHealthRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]HealthRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthRule) DeepCopyInto(out *HealthRule) {
+	*out = *in
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(ConditionHealthRule)
+		**out = **in
+	}
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(FieldHealthRule)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthRule.
+func (in *HealthRule) DeepCopy() *HealthRule {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmTaskSpec) DeepCopyInto(out *HelmTaskSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmTaskSpec.
+func (in *HelmTaskSpec) DeepCopy() *HelmTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Instance) DeepCopyInto(out *Instance) {
 	*out = *in
@@ -101,6 +300,30 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSnapshot) DeepCopyInto(out *InstanceSnapshot) {
+	*out = *in
+	out.OperatorVersion = in.OperatorVersion
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSnapshot.
+func (in *InstanceSnapshot) DeepCopy() *InstanceSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 	*out = *in
@@ -113,6 +336,18 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		}
 	}
 	out.PlanExecution = in.PlanExecution
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]Schedule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ParametersFrom != nil {
+		in, out := &in.ParametersFrom, &out.ParametersFrom
+		*out = make(map[string]ParameterSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -143,6 +378,37 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AppliedSnapshot != nil {
+		in, out := &in.AppliedSnapshot, &out.AppliedSnapshot
+		*out = new(InstanceSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]ScheduleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PlanHistory != nil {
+		in, out := &in.PlanHistory, &out.PlanHistory
+		*out = make([]PlanExecutionRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ParameterSources != nil {
+		in, out := &in.ParameterSources, &out.ParameterSources
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AppliedObjects != nil {
+		in, out := &in.AppliedObjects, &out.AppliedObjects
+		*out = make([]AppliedObject, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -172,6 +438,27 @@ func (in *KudoOperatorTaskSpec) DeepCopy() *KudoOperatorTaskSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeTaskSpec) DeepCopyInto(out *KustomizeTaskSpec) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeTaskSpec.
+func (in *KustomizeTaskSpec) DeepCopy() *KustomizeTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Maintainer) DeepCopyInto(out *Maintainer) {
 	*out = *in
@@ -298,7 +585,7 @@ func (in *OperatorVersion) DeepCopyInto(out *OperatorVersion) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -390,6 +677,13 @@ func (in *OperatorVersionSpec) DeepCopyInto(out *OperatorVersionSpec) {
 		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]HealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -406,6 +700,13 @@ func (in *OperatorVersionSpec) DeepCopy() *OperatorVersionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorVersionStatus) DeepCopyInto(out *OperatorVersionStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -446,6 +747,11 @@ func (in *Parameter) DeepCopyInto(out *Parameter) {
 			copy(*out, *in)
 		}
 	}
+	if in.Sensitive != nil {
+		in, out := &in.Sensitive, &out.Sensitive
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -459,6 +765,58 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterChange) DeepCopyInto(out *ParameterChange) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = new(string)
+		**out = **in
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterChange.
+func (in *ParameterChange) DeepCopy() *ParameterChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSource) DeepCopyInto(out *ParameterSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSource.
+func (in *ParameterSource) DeepCopy() *ParameterSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Phase) DeepCopyInto(out *Phase) {
 	*out = *in
@@ -469,6 +827,11 @@ func (in *Phase) DeepCopyInto(out *Phase) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -488,7 +851,13 @@ func (in *PhaseStatus) DeepCopyInto(out *PhaseStatus) {
 	if in.Steps != nil {
 		in, out := &in.Steps, &out.Steps
 		*out = make([]StepStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartedTimestamp != nil {
+		in, out := &in.StartedTimestamp, &out.StartedTimestamp
+		*out = (*in).DeepCopy()
 	}
 	return
 }
@@ -550,6 +919,11 @@ func (in *Plan) DeepCopyInto(out *Plan) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -579,6 +953,37 @@ func (in *PlanExecution) DeepCopy() *PlanExecution {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanExecutionRecord) DeepCopyInto(out *PlanExecutionRecord) {
+	*out = *in
+	if in.StartedTimestamp != nil {
+		in, out := &in.StartedTimestamp, &out.StartedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedTimestamp != nil {
+		in, out := &in.FinishedTimestamp, &out.FinishedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ParameterChanges != nil {
+		in, out := &in.ParameterChanges, &out.ParameterChanges
+		*out = make([]ParameterChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanExecutionRecord.
+func (in *PlanExecutionRecord) DeepCopy() *PlanExecutionRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanExecutionRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlanStatus) DeepCopyInto(out *PlanStatus) {
 	*out = *in
@@ -627,6 +1032,67 @@ func (in *ResourceTaskSpec) DeepCopy() *ResourceTaskSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
+func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Step) DeepCopyInto(out *Step) {
 	*out = *in
@@ -635,6 +1101,11 @@ func (in *Step) DeepCopyInto(out *Step) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -651,6 +1122,14 @@ func (in *Step) DeepCopy() *Step {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepStatus) DeepCopyInto(out *StepStatus) {
 	*out = *in
+	if in.StartedTimestamp != nil {
+		in, out := &in.StartedTimestamp, &out.StartedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAttemptTimestamp != nil {
+		in, out := &in.LastAttemptTimestamp, &out.LastAttemptTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -668,6 +1147,16 @@ func (in *StepStatus) DeepCopy() *StepStatus {
 func (in *Task) DeepCopyInto(out *Task) {
 	*out = *in
 	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -685,10 +1174,16 @@ func (in *Task) DeepCopy() *Task {
 func (in *TaskSpec) DeepCopyInto(out *TaskSpec) {
 	*out = *in
 	in.ResourceTaskSpec.DeepCopyInto(&out.ResourceTaskSpec)
+	in.ApplyTaskSpec.DeepCopyInto(&out.ApplyTaskSpec)
 	out.DummyTaskSpec = in.DummyTaskSpec
 	in.PipeTaskSpec.DeepCopyInto(&out.PipeTaskSpec)
 	out.ToggleTaskSpec = in.ToggleTaskSpec
 	out.KudoOperatorTaskSpec = in.KudoOperatorTaskSpec
+	out.WaitTaskSpec = in.WaitTaskSpec
+	in.ExecTaskSpec.DeepCopyInto(&out.ExecTaskSpec)
+	out.HelmTaskSpec = in.HelmTaskSpec
+	in.KustomizeTaskSpec.DeepCopyInto(&out.KustomizeTaskSpec)
+	in.ExternalTaskSpec.DeepCopyInto(&out.ExternalTaskSpec)
 	return
 }
 
@@ -717,3 +1212,19 @@ func (in *ToggleTaskSpec) DeepCopy() *ToggleTaskSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaitTaskSpec) DeepCopyInto(out *WaitTaskSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitTaskSpec.
+func (in *WaitTaskSpec) DeepCopy() *WaitTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WaitTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
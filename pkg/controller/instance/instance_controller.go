@@ -22,8 +22,11 @@ import (
 	"log"
 	"math"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/robfig/cron"
 	"github.com/thoas/go-funk"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -38,6 +41,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -47,17 +51,20 @@ import (
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/engine"
-	"github.com/kudobuilder/kudo/pkg/engine/renderer"
 	"github.com/kudobuilder/kudo/pkg/engine/task"
 	"github.com/kudobuilder/kudo/pkg/engine/workflow"
 	"github.com/kudobuilder/kudo/pkg/kubernetes/status"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/resources/dependencies"
 	"github.com/kudobuilder/kudo/pkg/util/convert"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
 )
 
 // Reconciler reconciles an Instance object.
 type Reconciler struct {
 	client.Client
+	// APIReader reads objects without the cache of the client, e.g. for lookups in templates and for Secrets and
+	// ConfigMaps
+	APIReader client.Reader
 	Discovery discovery.CachedDiscoveryInterface
 	Config    *rest.Config
 	Recorder  record.EventRecorder
@@ -67,6 +74,14 @@ type Reconciler struct {
 // SetupWithManager registers this reconciler with the controller manager
 func (r *Reconciler) SetupWithManager(
 	mgr ctrl.Manager) error {
+	if err := indexParameterSources(mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
+	secrets, configMaps, err := parameterSourceInformers(mgr)
+	if err != nil {
+		return err
+	}
+
 	addOvRelatedInstancesToReconcile := handler.ToRequestsFunc(
 		func(obj handler.MapObject) []reconcile.Request {
 			requests := make([]reconcile.Request, 0)
@@ -113,9 +128,26 @@ func (r *Reconciler) SetupWithManager(
 		Owns(&corev1.Pod{}).
 		WithEventFilter(eventFilter()).
 		Watches(&source.Kind{Type: &kudoapi.OperatorVersion{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: addOvRelatedInstancesToReconcile}).
+		// Secrets and ConfigMaps are watched for InstanceSpec.ParametersFrom. Only their metadata is cached and the
+		// events are filtered to the objects referenced by an instance (see parameterSourcePredicate).
+		Watches(&source.Informer{Informer: secrets},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: parameterSourceInstancesToReconcile(mgr.GetClient(), secretParameterSourceIndex)},
+			builder.WithPredicates(parameterSourcePredicate(mgr.GetClient(), secretParameterSourceIndex))).
+		Watches(&source.Informer{Informer: configMaps},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: parameterSourceInstancesToReconcile(mgr.GetClient(), configMapParameterSourceIndex)},
+			builder.WithPredicates(parameterSourcePredicate(mgr.GetClient(), configMapParameterSourceIndex))).
 		Complete(r)
 }
 
+// reader returns the reader for Secrets and ConfigMaps, e.g. of sensitive parameters and parameter sources. They are
+// read uncached, so that the manager doesn't cache all Secrets and ConfigMaps of the cluster.
+func (r *Reconciler) reader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
 // eventFilter ignores DeleteEvents for pipe-pods only (marked with task.PipePodAnnotation). This is due to an
 // inherent race that was described in detail in #1116 (https://github.com/kudobuilder/kudo/issues/1116)
 // tl;dr: pipe-task will delete the pipe pod at the end of the execution. this would normally trigger another
@@ -149,6 +181,13 @@ func isForPipePod(e event.DeleteEvent) bool {
 //                  |
 //                  v
 //   +-------------------------------+
+//   | Trigger the plan of a due     |
+//   | schedule or of a changed      |
+//   | parameter source              |
+//   +-------------------------------+
+//                  |
+//                  v
+//   +-------------------------------+
 //   | Execute the scheduled plan    |
 //   | if exists                     |
 //   +-------------------------------+
@@ -189,23 +228,58 @@ func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 		return reconcile.Result{}, err // OV not found has to be retried because it can really have been created after Instance
 	}
 
-	// ---------- 2. Get currently scheduled plan if it exists ----------
+	// ---------- 2. Trigger the plan of a due schedule ----------
+
+	now := time.Now()
+	triggered, skipped := processSchedules(instance, ov, now)
+	for _, s := range skipped {
+		r.Recorder.Event(instance, "Normal", "ScheduleSkipped", fmt.Sprintf("Schedule %s skipped plan %s because another plan is scheduled (or running)", s.Name, s.Plan))
+	}
+	if triggered != nil {
+		// the admission webhook sets a new UID for the triggered plan and the update triggers a new reconciliation
+		if err := updateInstance(instance, oldInstance, r.Client); err != nil {
+			log.Printf("InstanceController: Error when updating instance %s/%s. %v", instance.Namespace, instance.Name, err)
+			return reconcile.Result{}, err
+		}
+		r.Recorder.Event(instance, "Normal", "ScheduleTriggered", fmt.Sprintf("Schedule %s triggered plan %s", triggered.Name, triggered.Plan))
+		return computeScheduleResult(instance, now), nil
+	}
+
+	// a missing parameter source is only reported here: rendering the templates of the next plan fails and is retried
+	sourcePlan, changed, err := processParameterSources(instance, ov, r.reader())
+	if err != nil {
+		log.Printf("InstanceController: Error checking the parameter sources of instance %s/%s: %v", instance.Namespace, instance.Name, err)
+		r.Recorder.Event(instance, "Warning", "InvalidParameterSource", err.Error())
+	}
+	if sourcePlan != nil {
+		// as with schedules, the admission webhook sets a new UID for the triggered plan
+		if err := updateInstance(instance, oldInstance, r.Client); err != nil {
+			log.Printf("InstanceController: Error when updating instance %s/%s. %v", instance.Namespace, instance.Name, err)
+			return reconcile.Result{}, err
+		}
+		r.Recorder.Event(instance, "Normal", "ParameterSourceChanged", fmt.Sprintf("Changed value of parameter(s) %s triggered plan %s", strings.Join(changed, ", "), *sourcePlan))
+		return computeScheduleResult(instance, now), nil
+	}
+
+	// ---------- 3. Get currently scheduled plan if it exists ----------
 
 	// get the scheduled plan
 	plan, uid := scheduledPlan(instance, ov)
 	if plan == "" {
 		// no plan is running, we still need to make sure the readiness property is up to date
-		err := setReadinessOnInstance(instance, r.Client)
+		err := setReadinessOnInstance(instance, ov, r.Client)
 		if err != nil {
 			log.Printf("InstanceController: Error when computing readiness for %s/%s: %v", instance.Namespace, instance.Name, err)
 			return reconcile.Result{}, err
 		}
-		if readinessChanged(oldInstance, instance) {
+		if readinessChanged(oldInstance, instance) ||
+			!reflect.DeepEqual(oldInstance.Status.Schedules, instance.Status.Schedules) ||
+			!reflect.DeepEqual(oldInstance.Status.ParameterSources, instance.Status.ParameterSources) {
 			err = updateInstance(instance, oldInstance, r.Client)
 		} else {
 			log.Printf("InstanceController: Readiness did not change for %s/%s. Not updating.", instance.Namespace, instance.Name)
 		}
-		return reconcile.Result{}, err
+		return computeScheduleResult(instance, now), err
 	}
 
 	ensureReadinessInitialized(instance)
@@ -218,6 +292,11 @@ func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 		return reconcile.Result{}, err
 	}
 
+	// cancel the plan execution if the user requested it
+	if instance.Spec.PlanExecution.Cancel {
+		return reconcile.Result{}, r.cancelPlan(instance, oldInstance, ov, planStatus)
+	}
+
 	if planStatus.Status == kudoapi.ExecutionPending {
 		log.Printf("InstanceController: Going to start execution of plan '%s' on instance %s/%s", plan, instance.Namespace, instance.Name)
 		r.Recorder.Event(instance, "Normal", "PlanStarted", fmt.Sprintf("Execution of plan %s started", plan))
@@ -228,11 +307,12 @@ func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 	if err != nil {
 		planStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
 		instance.UpdateInstanceStatus(planStatus, &metav1.Time{Time: time.Now()})
+		recordPlanExecution(instance, ov, planStatus)
 		err = r.handleError(err, instance, oldInstance)
 		return reconcile.Result{}, err
 	}
 
-	// ---------- 3. Execute the scheduled plan ----------
+	// ---------- 4. Execute the scheduled plan ----------
 
 	metadata := &engine.Metadata{
 		OperatorVersionName: ov.Name,
@@ -244,21 +324,42 @@ func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 		InstanceName:        instance.Name,
 	}
 
-	activePlan, err := preparePlanExecution(instance, ov, planStatus, metadata)
+	activePlan, err := workflow.PreparePlanExecution(instance, ov, planStatus, metadata, r.reader())
 	if err != nil {
 		err = r.handleError(err, instance, oldInstance)
 		return reconcile.Result{}, err
 	}
 	log.Printf("InstanceController: Going to proceed with execution of the scheduled plan '%s' on instance %s/%s", activePlan.Name, instance.Namespace, instance.Name)
-	newStatus, err := workflow.Execute(activePlan, metadata, r.Client, r.Discovery, r.Config, r.Scheme)
+	activePlan.Applied = task.AppliedObjects{}
+	newStatus, err := workflow.Execute(activePlan, metadata, r.Client, r.APIReader, r.Discovery, r.Config, r.Scheme)
 
-	// ---------- 4. Update instance and its status after the execution proceeded ----------
+	// ---------- 5. Update instance and its status after the execution proceeded ----------
 
+	recordAppliedObjects(instance, activePlan.Applied)
 	if newStatus != nil {
 		instance.UpdateInstanceStatus(newStatus, &metav1.Time{Time: time.Now()})
+		recordPlanExecution(instance, ov, newStatus)
+		if newStatus.Status == kudoapi.ExecutionComplete && !isOnFailurePlan(newStatus.Name, ov) {
+			instance.Status.AppliedSnapshot = snapshotOf(instance, ov)
+		}
+		// the plan stays successful if pruning fails, the orphans are pruned by the next pruning plan
+		if newStatus.Status == kudoapi.ExecutionComplete && ov.Spec.Plans[newStatus.Name].Prune {
+			pruned, err := pruneOrphanedObjects(instance, ov, newStatus, r.Client)
+			if err != nil {
+				log.Printf("InstanceController: Error pruning objects of instance %s/%s: %v", instance.Namespace, instance.Name, err)
+				r.Recorder.Event(instance, "Warning", "PruneFailed", err.Error())
+			}
+			if len(pruned) > 0 {
+				r.Recorder.Event(instance, "Normal", "ObjectsPruned", fmt.Sprintf("Plan %s pruned %s", newStatus.Name, strings.Join(pruned, ", ")))
+			}
+		}
 	}
 	if err != nil {
+		onFailure := scheduleOnFailurePlan(instance, ov)
 		err = r.handleError(err, instance, oldInstance)
+		if err == nil && onFailure != "" {
+			r.Recorder.Event(instance, "Normal", "OnFailurePlanScheduled", fmt.Sprintf("Plan %s failed, scheduled plan %s", activePlan.Name, onFailure))
+		}
 		return reconcile.Result{}, err
 	}
 
@@ -276,6 +377,55 @@ func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 	return computeTheReconcileResult(instance, time.Now), nil
 }
 
+// cancelPlan stops the execution of the scheduled plan: plan/phase/step statuses that are not terminal are set
+// to ExecutionCancelled and pipe pods that might still be running for this plan are deleted. Resources that were already
+// applied by the plan are left untouched.
+func (r *Reconciler) cancelPlan(instance *kudoapi.Instance, oldInstance *kudoapi.Instance, ov *kudoapi.OperatorVersion, planStatus *kudoapi.PlanStatus) error {
+	if planStatus.Status.IsTerminal() {
+		log.Printf("InstanceController: Plan '%s' on instance %s/%s is already terminal, nothing to cancel", planStatus.Name, instance.Namespace, instance.Name)
+		return nil
+	}
+
+	log.Printf("InstanceController: Cancelling execution of plan '%s' on instance %s/%s", planStatus.Name, instance.Namespace, instance.Name)
+	if err := deletePipePods(instance, planStatus.Name, r.Client); err != nil {
+		log.Printf("InstanceController: Error deleting pipe pods of plan '%s' on instance %s/%s: %v", planStatus.Name, instance.Namespace, instance.Name, err)
+		return err
+	}
+
+	instance.CancelPlanStatus(planStatus, &metav1.Time{Time: time.Now()})
+	recordPlanExecution(instance, ov, planStatus)
+
+	if err := updateInstance(instance, oldInstance, r.Client); err != nil {
+		log.Printf("InstanceController: Error when updating instance %s/%s. %v", instance.Namespace, instance.Name, err)
+		return err
+	}
+
+	r.Recorder.Event(instance, "Normal", "PlanCancelled", fmt.Sprintf("Execution of plan %s was cancelled", planStatus.Name))
+	return nil
+}
+
+// deletePipePods deletes all pipe pods (marked with task.PipePodAnnotation) that were created by the given plan of the instance
+func deletePipePods(instance *kudoapi.Instance, plan string, c client.Client) error {
+	pods := &corev1.PodList{}
+	if err := c.List(context.TODO(), pods, client.InNamespace(instance.Namespace), client.MatchingLabels{kudo.InstanceLabel: instance.Name}); err != nil {
+		return err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		annotations := pod.GetAnnotations()
+		if _, ok := annotations[task.PipePodAnnotation]; !ok || annotations[kudo.PlanAnnotation] != plan {
+			continue
+		}
+
+		log.Printf("InstanceController: Deleting pipe pod %s/%s", pod.Namespace, pod.Name)
+		if err := c.Delete(context.TODO(), pod); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func ensureReadinessInitialized(i *kudoapi.Instance) {
 	if i.Spec.PlanExecution.PlanName == kudoapi.DeployPlanName || i.Spec.PlanExecution.PlanName == kudoapi.UpgradePlanName || i.Spec.PlanExecution.PlanName == kudoapi.UpdatePlanName {
 		i.SetReadiness(kudoapi.ReadinessPlanInProgress, "")
@@ -290,8 +440,8 @@ func readinessChanged(instance *kudoapi.Instance, instance2 *kudoapi.Instance) b
 	return !reflect.DeepEqual(ready, ready2)
 }
 
-func setReadinessOnInstance(instance *kudoapi.Instance, c client.Client) error {
-	ready, msg, err := status.IsReady(*instance, c)
+func setReadinessOnInstance(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, c client.Client) error {
+	ready, msg, err := status.IsReady(*instance, c, ov.Spec.HealthChecks)
 	log.Printf("Updating instance %s/%s readiness to: %t", instance.Namespace, instance.Name, ready)
 	if err != nil {
 		return err
@@ -375,33 +525,6 @@ func updateInstance(instance *kudoapi.Instance, oldInstance *kudoapi.Instance, c
 	return nil
 }
 
-func preparePlanExecution(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, activePlanStatus *kudoapi.PlanStatus, meta *engine.Metadata) (*workflow.ActivePlan, error) {
-	planSpec, ok := ov.Spec.Plans[activePlanStatus.Name]
-	if !ok {
-		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not find required plan: '%v'", engine.ErrFatalExecution, activePlanStatus.Name), EventName: "InvalidPlan"}
-	}
-
-	params, err := ParamsMap(instance, ov)
-	if err != nil {
-		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not parse parameters: %v", engine.ErrFatalExecution, err), EventName: "InvalidParams"}
-	}
-
-	pipes, err := PipesMap(activePlanStatus.Name, &planSpec, ov.Spec.Tasks, meta)
-	if err != nil {
-		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not make task pipes: %v", engine.ErrFatalExecution, err), EventName: "InvalidPlan"}
-	}
-
-	return &workflow.ActivePlan{
-		Name:       activePlanStatus.Name,
-		Spec:       &planSpec,
-		PlanStatus: activePlanStatus,
-		Tasks:      ov.Spec.Tasks,
-		Templates:  ov.Spec.Templates,
-		Params:     params,
-		Pipes:      pipes,
-	}, nil
-}
-
 // handleError handles execution error by logging, updating the plan status and optionally publishing an event
 // specify eventReason as nil if you don't wish to publish a warning event
 // returns err if this err should be retried, nil otherwise
@@ -440,67 +563,207 @@ func (r *Reconciler) getInstance(request ctrl.Request) (instance *kudoapi.Instan
 	return instance, nil
 }
 
-// ParamsMap generates {{ Params.* }} map of keys and values which is later used during template rendering.
-func ParamsMap(instance *kudoapi.Instance, operatorVersion *kudoapi.OperatorVersion) (map[string]interface{}, error) {
-	params := make(map[string]interface{}, len(operatorVersion.Spec.Parameters))
+// snapshotOf captures the current OperatorVersion and the effective parameter values (including defaults) of the instance.
+func snapshotOf(instance *kudoapi.Instance, operatorVersion *kudoapi.OperatorVersion) *kudoapi.InstanceSnapshot {
+	params := make(map[string]string, len(operatorVersion.Spec.Parameters))
 
 	for _, param := range operatorVersion.Spec.Parameters {
-		var value *string
-
 		if v, ok := instance.Spec.Parameters[param.Name]; ok {
-			value = &v
-		} else {
-			value = param.Default
+			params[param.Name] = v
+		} else if param.Default != nil {
+			params[param.Name] = *param.Default
 		}
+	}
 
-		var err error
+	return &kudoapi.InstanceSnapshot{
+		OperatorVersion: corev1.ObjectReference{Name: operatorVersion.Name, Namespace: operatorVersion.Namespace},
+		Version:         operatorVersion.Spec.Version,
+		AppVersion:      operatorVersion.Spec.AppVersion,
+		Parameters:      params,
+	}
+}
 
-		params[param.Name], err = convert.UnwrapParamValue(value, param.Type)
-		if err != nil {
-			return nil, err
+// recordPlanExecution adds the plan execution to the instance plan history once it is terminal. Parameter changes are
+// computed against the parameters of the last successfully finished plan, so this has to be called before the
+// Status.AppliedSnapshot is updated.
+func recordPlanExecution(i *kudoapi.Instance, ov *kudoapi.OperatorVersion, ps *kudoapi.PlanStatus) {
+	if !ps.Status.IsTerminal() {
+		return
+	}
+
+	var previous map[string]string
+	if i.Status.AppliedSnapshot != nil {
+		previous = i.Status.AppliedSnapshot.Parameters
+	}
+
+	i.RecordPlanExecution(kudoapi.PlanExecutionRecord{
+		Name:              ps.Name,
+		UID:               ps.UID,
+		Trigger:           i.Spec.PlanExecution.Trigger,
+		Status:            ps.Status,
+		Message:           planMessage(ps),
+		OperatorVersion:   ov.Name,
+		StartedTimestamp:  ps.StartedTimestamp(),
+		FinishedTimestamp: ps.LastUpdatedTimestamp,
+		ParameterChanges:  parameterChanges(previous, snapshotOf(i, ov).Parameters),
+	})
+}
+
+// planMessage returns the message of the plan status or, if there is none, the message of the first failed step
+func planMessage(ps *kudoapi.PlanStatus) string {
+	if ps.Message != "" {
+		return ps.Message
+	}
+	for _, ph := range ps.Phases {
+		for _, st := range ph.Steps {
+			if st.Status == kudoapi.ExecutionFatalError && st.Message != "" {
+				return st.Message
+			}
 		}
 	}
+	return ""
+}
 
-	return params, nil
+// parameterChanges returns the changed, added and removed parameters sorted by name
+func parameterChanges(old, new map[string]string) []kudoapi.ParameterChange {
+	changed, removed := kudoapi.RichParameterDiff(old, new)
+
+	changes := make([]kudoapi.ParameterChange, 0, len(changed)+len(removed))
+	for name, value := range changed {
+		change := kudoapi.ParameterChange{Name: name, To: convert.StringPtr(value)}
+		if v, ok := old[name]; ok {
+			change.From = convert.StringPtr(v)
+		}
+		changes = append(changes, change)
+	}
+	for name, value := range removed {
+		changes = append(changes, kudoapi.ParameterChange{Name: name, From: convert.StringPtr(value)})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes
 }
 
-// PipesMap generates {{ Pipes.* }} map of keys and values which is later used during template rendering.
-func PipesMap(planName string, plan *kudoapi.Plan, tasks []kudoapi.Task, emeta *engine.Metadata) (map[string]string, error) {
-	taskByName := func(name string) (*kudoapi.Task, bool) {
-		for _, t := range tasks {
-			if t.Name == name {
-				return &t, true
-			}
+// isOnFailurePlan returns true if the plan is referenced by any other plan as its Plan.OnFailure plan
+func isOnFailurePlan(plan string, ov *kudoapi.OperatorVersion) bool {
+	for _, p := range ov.Spec.Plans {
+		if p.OnFailure == plan {
+			return true
 		}
-		return nil, false
 	}
+	return false
+}
 
-	pipes := make(map[string]string)
+// scheduleOnFailurePlan schedules the Plan.OnFailure plan if the currently scheduled plan failed with a fatal error.
+// A plan that is itself an OnFailure plan never schedules another one, so that failures can not loop. Returns the
+// name of the scheduled plan or an empty string if no plan was scheduled.
+func scheduleOnFailurePlan(i *kudoapi.Instance, ov *kudoapi.OperatorVersion) string {
+	failed := i.Spec.PlanExecution.PlanName
+	if i.Spec.PlanExecution.Status != kudoapi.ExecutionFatalError || i.IsDeleting() || isOnFailurePlan(failed, ov) {
+		return ""
+	}
 
-	for _, ph := range plan.Phases {
-		for _, st := range ph.Steps {
-			for _, tn := range st.Tasks {
-				rmeta := renderer.Metadata{
-					Metadata:  *emeta,
-					PlanName:  planName,
-					PhaseName: ph.Name,
-					StepName:  st.Name,
-					TaskName:  tn,
-				}
+	plan, ok := ov.Spec.Plans[failed]
+	if !ok || plan.OnFailure == "" || !kudoapi.PlanExists(plan.OnFailure, ov) {
+		return ""
+	}
 
-				if t, ok := taskByName(tn); ok && t.Kind == task.PipeTaskKind {
-					for _, pipe := range t.Spec.PipeTaskSpec.Pipe {
-						if _, ok := pipes[pipe.Key]; ok {
-							return nil, fmt.Errorf("duplicated pipe key %s", pipe.Key)
-						}
-						pipes[pipe.Key] = task.PipeArtifactName(rmeta, pipe.Key)
-					}
-				}
-			}
+	log.Printf("InstanceController: Plan '%s' on instance %s/%s failed. Scheduling '%s' plan.", failed, i.Namespace, i.Name, plan.OnFailure)
+
+	i.Spec.PlanExecution.PlanName = plan.OnFailure
+	i.Spec.PlanExecution.UID = uuid.NewUUID()
+	i.Spec.PlanExecution.Status = kudoapi.ExecutionNeverRun
+	i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerOnFailure
+	return plan.OnFailure
+}
+
+// processSchedules updates the Status.Schedules of the instance and triggers the plan of a due schedule if no other
+// plan is scheduled or running. Due schedules that can not be triggered are either queued or skipped, depending on
+// their ConcurrencyPolicy. At most one plan is triggered at a time, other due schedules are handled on the next
+// reconciliation. Returns the schedule whose plan was triggered (if any) and the skipped schedules.
+func processSchedules(i *kudoapi.Instance, ov *kudoapi.OperatorVersion, now time.Time) (*kudoapi.Schedule, []kudoapi.Schedule) {
+	var triggered *kudoapi.Schedule
+	var skipped []kudoapi.Schedule
+	var statuses []kudoapi.ScheduleStatus
+
+	canTrigger := i.Spec.PlanExecution.PlanName == "" && !i.IsDeleting()
+
+	for _, s := range i.Spec.Schedules {
+		s := s
+		schedule, err := cron.ParseStandard(s.Cron)
+		if err != nil {
+			log.Printf("InstanceController: Ignoring schedule '%s' of instance %s/%s with invalid cron expression '%s': %v", s.Name, i.Namespace, i.Name, s.Cron, err)
+			continue
+		}
+		if !kudoapi.PlanExists(s.Plan, ov) {
+			log.Printf("InstanceController: Ignoring schedule '%s' of instance %s/%s: plan '%s' does not exist", s.Name, i.Namespace, i.Name, s.Plan)
+			continue
+		}
+
+		status := kudoapi.ScheduleStatus{Name: s.Name}
+		if st := scheduleStatus(i, s.Name); st != nil {
+			status = *st.DeepCopy()
+		}
+
+		next := schedule.Next(now)
+		due := status.Queued && s.ConcurrencyPolicy == kudoapi.ConcurrencyPolicyQueue
+		switch {
+		case status.NextScheduleTime == nil || next.Before(status.NextScheduleTime.Time):
+			// the schedule is new or was changed to an earlier time
+			status.NextScheduleTime = &metav1.Time{Time: next}
+		case !status.NextScheduleTime.After(now):
+			// missed runs are collapsed into a single one
+			due = true
+			status.NextScheduleTime = &metav1.Time{Time: next}
+		}
+
+		status.Queued = false
+		switch {
+		case !due:
+		case canTrigger:
+			log.Printf("InstanceController: Schedule '%s' of instance %s/%s is due. Triggering '%s' plan.", s.Name, i.Namespace, i.Name, s.Plan)
+			i.Spec.PlanExecution.PlanName = s.Plan
+			i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerSchedule
+			status.LastScheduleTime = &metav1.Time{Time: now}
+			triggered = &s
+			canTrigger = false
+		case s.ConcurrencyPolicy == kudoapi.ConcurrencyPolicyQueue:
+			status.Queued = true
+		default:
+			skipped = append(skipped, s)
 		}
+
+		statuses = append(statuses, status)
 	}
 
-	return pipes, nil
+	i.Status.Schedules = statuses
+	return triggered, skipped
+}
+
+func scheduleStatus(i *kudoapi.Instance, name string) *kudoapi.ScheduleStatus {
+	for k := range i.Status.Schedules {
+		if i.Status.Schedules[k].Name == name {
+			return &i.Status.Schedules[k]
+		}
+	}
+	return nil
+}
+
+// computeScheduleResult requeues the reconciliation at the next time a schedule of the instance is due
+func computeScheduleResult(instance *kudoapi.Instance, now time.Time) reconcile.Result {
+	var next *time.Time
+	for _, s := range instance.Status.Schedules {
+		if s.NextScheduleTime != nil && (next == nil || s.NextScheduleTime.Time.Before(*next)) {
+			next = &s.NextScheduleTime.Time
+		}
+	}
+	if next == nil {
+		return reconcile.Result{}
+	}
+	return reconcile.Result{RequeueAfter: next.Sub(now)}
 }
 
 // resetPlanStatusIfPlanIsNew method resets a PlanStatus for a passed plan name and instance *IF* this is a newly
@@ -598,6 +861,7 @@ func scheduledPlan(i *kudoapi.Instance, ov *kudoapi.OperatorVersion) (string, ty
 		i.Spec.PlanExecution.PlanName = kudoapi.CleanupPlanName
 		i.Spec.PlanExecution.UID = uuid.NewUUID()
 		i.Spec.PlanExecution.Status = kudoapi.ExecutionNeverRun
+		i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerDeletion
 	}
 
 	return i.Spec.PlanExecution.PlanName, i.Spec.PlanExecution.UID
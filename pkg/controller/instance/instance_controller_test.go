@@ -1,6 +1,7 @@
 package instance
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -12,204 +13,16 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
-	"github.com/kudobuilder/kudo/pkg/engine"
 	"github.com/kudobuilder/kudo/pkg/engine/task"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
 )
 
-func Test_makePipes(t *testing.T) {
-	meta := &engine.Metadata{
-		InstanceName:        "first-operator-instance",
-		InstanceNamespace:   "default",
-		OperatorName:        "first-operator",
-		OperatorVersionName: "first-operator-1.0",
-		OperatorVersion:     "1.0",
-	}
-
-	tests := []struct {
-		name     string
-		planName string
-		plan     *kudoapi.Plan
-		tasks    []kudoapi.Task
-		emeta    *engine.Metadata
-		want     map[string]string
-		wantErr  bool
-	}{
-		{
-			name:     "no tasks, no pipes",
-			planName: "deploy",
-			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
-				{
-					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
-						{
-							Name: "step", Tasks: []string{}},
-					}},
-			}},
-			tasks: []kudoapi.Task{},
-			emeta: meta,
-			want:  map[string]string{},
-		},
-		{
-			name:     "no pipe tasks, no pipes",
-			planName: "deploy",
-			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
-				{
-					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
-						{
-							Name: "step", Tasks: []string{"task"}},
-					}},
-			}},
-			tasks: []kudoapi.Task{
-				{
-					Name: "task",
-					Kind: "Dummy",
-					Spec: kudoapi.TaskSpec{
-						DummyTaskSpec: kudoapi.DummyTaskSpec{Done: false},
-					},
-				},
-			},
-			emeta: meta,
-			want:  map[string]string{},
-		},
-		{
-			name:     "one pipe task, one pipes element",
-			planName: "deploy",
-			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
-				{
-					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
-						{
-							Name: "step", Tasks: []string{"task"}},
-					}},
-			}},
-			tasks: []kudoapi.Task{
-				{
-					Name: "task",
-					Kind: "Pipe",
-					Spec: kudoapi.TaskSpec{
-						PipeTaskSpec: kudoapi.PipeTaskSpec{
-							Pod: "pipe-pod.yaml",
-							Pipe: []kudoapi.PipeSpec{
-								{
-									File: "foo.txt",
-									Kind: "Secret",
-									Key:  "Foo",
-								},
-							},
-						},
-					},
-				},
-			},
-			emeta: meta,
-			want:  map[string]string{"Foo": "firstoperatorinstance.deploy.phase.step.task.foo"},
-		},
-		{
-			name:     "two pipe tasks, two pipes element",
-			planName: "deploy",
-			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
-				{
-					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
-						{Name: "stepOne", Tasks: []string{"task-one"}},
-						{Name: "stepTwo", Tasks: []string{"task-two"}},
-					}},
-			}},
-			tasks: []kudoapi.Task{
-				{
-					Name: "task-one",
-					Kind: "Pipe",
-					Spec: kudoapi.TaskSpec{
-						PipeTaskSpec: kudoapi.PipeTaskSpec{
-							Pod: "pipe-pod.yaml",
-							Pipe: []kudoapi.PipeSpec{
-								{
-									File: "foo.txt",
-									Kind: "Secret",
-									Key:  "Foo",
-								},
-							},
-						},
-					},
-				},
-				{
-					Name: "task-two",
-					Kind: "Pipe",
-					Spec: kudoapi.TaskSpec{
-						PipeTaskSpec: kudoapi.PipeTaskSpec{
-							Pod: "pipe-pod.yaml",
-							Pipe: []kudoapi.PipeSpec{
-								{
-									File: "bar.txt",
-									Kind: "ConfigMap",
-									Key:  "Bar",
-								},
-							},
-						},
-					},
-				},
-			},
-			emeta: meta,
-			want: map[string]string{
-				"Foo": "firstoperatorinstance.deploy.phase.stepone.taskone.foo",
-				"Bar": "firstoperatorinstance.deploy.phase.steptwo.tasktwo.bar",
-			},
-		},
-		{
-			name:     "one pipe task, duplicated pipe keys",
-			planName: "deploy",
-			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
-				{
-					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
-						{
-							Name: "step", Tasks: []string{"task"}},
-					}},
-			}},
-			tasks: []kudoapi.Task{
-				{
-					Name: "task",
-					Kind: "Pipe",
-					Spec: kudoapi.TaskSpec{
-						PipeTaskSpec: kudoapi.PipeTaskSpec{
-							Pod: "pipe-pod.yaml",
-							Pipe: []kudoapi.PipeSpec{
-								{
-									File: "foo.txt",
-									Kind: "Secret",
-									Key:  "Foo",
-								},
-								{
-									File: "bar.txt",
-									Kind: "ConfigMap",
-									Key:  "Foo",
-								},
-							},
-						},
-					},
-				},
-			},
-			emeta:   meta,
-			want:    nil,
-			wantErr: true,
-		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := PipesMap(tt.planName, tt.plan, tt.tasks, tt.emeta)
-			if err != nil {
-				if !tt.wantErr {
-					t.Fatalf("PipesMap() error = %v, wantErr %v", err, tt.wantErr)
-				}
-				return
-			}
-
-			assert.Equal(t, tt.want, got)
-		})
-	}
-}
-
 func TestParameterDiff(t *testing.T) {
 	var (
 		tests = []struct {
@@ -294,6 +107,41 @@ func TestEventFilterForDelete(t *testing.T) {
 	}
 }
 
+func Test_deletePipePods(t *testing.T) {
+	instance := &kudoapi.Instance{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}}
+
+	pod := func(name, instance, plan string, pipe bool) *v1.Pod {
+		p := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Labels:      map[string]string{kudo.InstanceLabel: instance},
+			Annotations: map[string]string{kudo.PlanAnnotation: plan},
+		}}
+		if pipe {
+			p.Annotations[task.PipePodAnnotation] = "true"
+		}
+		return p
+	}
+
+	c := fake.NewFakeClientWithScheme(scheme.Scheme,
+		pod("pipe-pod", "test", "deploy", true),
+		pod("other-plan-pipe-pod", "test", "backup", true),
+		pod("other-instance-pipe-pod", "other", "deploy", true),
+		pod("app-pod", "test", "deploy", false),
+	)
+
+	assert.NoError(t, deletePipePods(instance, "deploy", c))
+
+	pods := &v1.PodList{}
+	assert.NoError(t, c.List(context.TODO(), pods))
+
+	names := []string{}
+	for _, p := range pods.Items {
+		names = append(names, p.Name)
+	}
+	assert.ElementsMatch(t, []string{"other-plan-pipe-pod", "other-instance-pipe-pod", "app-pod"}, names)
+}
+
 func Test_scheduledPlan(t *testing.T) {
 	ov := &kudoapi.OperatorVersion{
 		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "default"},
@@ -378,6 +226,275 @@ func Test_scheduledPlan(t *testing.T) {
 	}
 }
 
+func Test_scheduleOnFailurePlan(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "default"},
+		TypeMeta:   metav1.TypeMeta{Kind: "OperatorVersion", APIVersion: "kudo.dev/v1beta1"},
+		Spec: kudoapi.OperatorVersionSpec{Plans: map[string]kudoapi.Plan{
+			"deploy":   {OnFailure: "rollback"},
+			"rollback": {},
+			"backup":   {},
+		}},
+	}
+
+	failed := &kudoapi.Instance{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "kudo.dev/v1beta1", Kind: "Instance"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			PlanExecution: kudoapi.PlanExecution{
+				PlanName: "deploy",
+				UID:      "111-222-333-444",
+				Status:   kudoapi.ExecutionFatalError,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		i        *kudoapi.Instance
+		wantPlan string
+	}{
+		{
+			name:     "failed plan with an onFailure plan schedules it",
+			i:        failed,
+			wantPlan: "rollback",
+		},
+		{
+			name: "failed plan without an onFailure plan schedules nothing",
+			i: func() *kudoapi.Instance {
+				i := failed.DeepCopy()
+				i.Spec.PlanExecution.PlanName = "backup"
+				return i
+			}(),
+		},
+		{
+			name: "failed onFailure plan schedules nothing",
+			i: func() *kudoapi.Instance {
+				i := failed.DeepCopy()
+				i.Spec.PlanExecution.PlanName = "rollback"
+				return i
+			}(),
+		},
+		{
+			name: "plan that is still in progress schedules nothing",
+			i: func() *kudoapi.Instance {
+				i := failed.DeepCopy()
+				i.Spec.PlanExecution.Status = kudoapi.ExecutionInProgress
+				return i
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			i := tt.i.DeepCopy()
+			plan := scheduleOnFailurePlan(i, ov)
+
+			assert.Equal(t, tt.wantPlan, plan)
+			if tt.wantPlan != "" {
+				assert.Equal(t, tt.wantPlan, i.Spec.PlanExecution.PlanName)
+				assert.Equal(t, kudoapi.ExecutionNeverRun, i.Spec.PlanExecution.Status)
+				assert.NotEqual(t, tt.i.Spec.PlanExecution.UID, i.Spec.PlanExecution.UID)
+			} else {
+				assert.Equal(t, tt.i.Spec.PlanExecution, i.Spec.PlanExecution)
+			}
+		})
+	}
+}
+
+func Test_processSchedules(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "default"},
+		TypeMeta:   metav1.TypeMeta{Kind: "OperatorVersion", APIVersion: "kudo.dev/v1beta1"},
+		Spec:       kudoapi.OperatorVersionSpec{Plans: map[string]kudoapi.Plan{"deploy": {}, "backup": {}}},
+	}
+
+	now := time.Date(2020, 10, 17, 2, 30, 0, 0, time.UTC)
+	at := func(hour, min int) *metav1.Time {
+		return &metav1.Time{Time: time.Date(2020, 10, 17, hour, min, 0, 0, time.UTC)}
+	}
+	withSchedule := func(policy kudoapi.ConcurrencyPolicy, status *kudoapi.ScheduleStatus, planName string) *kudoapi.Instance {
+		i := &kudoapi.Instance{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "kudo.dev/v1beta1", Kind: "Instance"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+			Spec: kudoapi.InstanceSpec{
+				Schedules:     []kudoapi.Schedule{{Name: "hourly", Cron: "0 * * * *", Plan: "backup", ConcurrencyPolicy: policy}},
+				PlanExecution: kudoapi.PlanExecution{PlanName: planName},
+			},
+		}
+		if status != nil {
+			i.Status.Schedules = []kudoapi.ScheduleStatus{*status}
+		}
+		return i
+	}
+
+	tests := []struct {
+		name          string
+		i             *kudoapi.Instance
+		wantTriggered bool
+		wantSkipped   bool
+		wantPlan      string
+		wantStatus    []kudoapi.ScheduleStatus
+	}{
+		{
+			name:       "new schedule computes the next run",
+			i:          withSchedule("", nil, ""),
+			wantStatus: []kudoapi.ScheduleStatus{{Name: "hourly", NextScheduleTime: at(3, 0)}},
+		},
+		{
+			name:       "schedule that is not due does nothing",
+			i:          withSchedule("", &kudoapi.ScheduleStatus{Name: "hourly", NextScheduleTime: at(3, 0)}, ""),
+			wantStatus: []kudoapi.ScheduleStatus{{Name: "hourly", NextScheduleTime: at(3, 0)}},
+		},
+		{
+			name:          "due schedule triggers the plan",
+			i:             withSchedule("", &kudoapi.ScheduleStatus{Name: "hourly", NextScheduleTime: at(2, 0)}, ""),
+			wantTriggered: true,
+			wantPlan:      "backup",
+			wantStatus:    []kudoapi.ScheduleStatus{{Name: "hourly", LastScheduleTime: &metav1.Time{Time: now}, NextScheduleTime: at(3, 0)}},
+		},
+		{
+			name:        "due schedule is skipped while another plan is running",
+			i:           withSchedule(kudoapi.ConcurrencyPolicySkip, &kudoapi.ScheduleStatus{Name: "hourly", NextScheduleTime: at(2, 0)}, "deploy"),
+			wantSkipped: true,
+			wantPlan:    "deploy",
+			wantStatus:  []kudoapi.ScheduleStatus{{Name: "hourly", NextScheduleTime: at(3, 0)}},
+		},
+		{
+			name:       "due schedule is queued while another plan is running",
+			i:          withSchedule(kudoapi.ConcurrencyPolicyQueue, &kudoapi.ScheduleStatus{Name: "hourly", NextScheduleTime: at(2, 0)}, "deploy"),
+			wantPlan:   "deploy",
+			wantStatus: []kudoapi.ScheduleStatus{{Name: "hourly", NextScheduleTime: at(3, 0), Queued: true}},
+		},
+		{
+			name:          "queued schedule triggers the plan once no other plan is running",
+			i:             withSchedule(kudoapi.ConcurrencyPolicyQueue, &kudoapi.ScheduleStatus{Name: "hourly", NextScheduleTime: at(3, 0), Queued: true}, ""),
+			wantTriggered: true,
+			wantPlan:      "backup",
+			wantStatus:    []kudoapi.ScheduleStatus{{Name: "hourly", LastScheduleTime: &metav1.Time{Time: now}, NextScheduleTime: at(3, 0)}},
+		},
+		{
+			name: "status of a removed schedule is dropped",
+			i: func() *kudoapi.Instance {
+				i := withSchedule("", &kudoapi.ScheduleStatus{Name: "hourly", NextScheduleTime: at(3, 0)}, "")
+				i.Spec.Schedules = nil
+				return i
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			i := tt.i.DeepCopy()
+			triggered, skipped := processSchedules(i, ov, now)
+
+			assert.Equal(t, tt.wantTriggered, triggered != nil)
+			assert.Equal(t, tt.wantSkipped, len(skipped) > 0)
+			assert.Equal(t, tt.wantPlan, i.Spec.PlanExecution.PlanName)
+			assert.Equal(t, tt.wantStatus, i.Status.Schedules)
+		})
+	}
+}
+
+func Test_computeScheduleResult(t *testing.T) {
+	now := time.Now()
+	i := &kudoapi.Instance{}
+	assert.Equal(t, reconcile.Result{}, computeScheduleResult(i, now))
+
+	i.Status.Schedules = []kudoapi.ScheduleStatus{
+		{Name: "daily", NextScheduleTime: &metav1.Time{Time: now.Add(24 * time.Hour)}},
+		{Name: "hourly", NextScheduleTime: &metav1.Time{Time: now.Add(time.Hour)}},
+	}
+	assert.Equal(t, reconcile.Result{RequeueAfter: time.Hour}, computeScheduleResult(i, now))
+}
+
+func Test_recordPlanExecution(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator-1.0", Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{
+				{Name: "replicas", Default: convert.StringPtr("1")},
+				{Name: "image"},
+				{Name: "added", Default: convert.StringPtr("new")},
+			},
+		},
+	}
+	started := metav1.Time{Time: time.Date(2020, 10, 17, 1, 0, 0, 0, time.UTC)}
+	finished := metav1.Time{Time: time.Date(2020, 10, 17, 1, 5, 0, 0, time.UTC)}
+
+	i := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			Parameters:    map[string]string{"replicas": "3"},
+			PlanExecution: kudoapi.PlanExecution{PlanName: "deploy", UID: "111", Trigger: kudoapi.PlanTriggerParameterUpdate},
+		},
+		Status: kudoapi.InstanceStatus{
+			AppliedSnapshot: &kudoapi.InstanceSnapshot{Parameters: map[string]string{"replicas": "1", "image": "nginx"}},
+		},
+	}
+	ps := &kudoapi.PlanStatus{
+		Name:                 "deploy",
+		UID:                  "111",
+		Status:               kudoapi.ExecutionInProgress,
+		LastUpdatedTimestamp: &finished,
+		Phases: []kudoapi.PhaseStatus{{
+			Name:             "main",
+			StartedTimestamp: &started,
+			Steps:            []kudoapi.StepStatus{{Name: "app", Status: kudoapi.ExecutionFatalError, Message: "step failed"}},
+		}},
+	}
+
+	recordPlanExecution(i, ov, ps)
+	assert.Empty(t, i.Status.PlanHistory, "a running plan should not be recorded")
+
+	ps.Status = kudoapi.ExecutionFatalError
+	recordPlanExecution(i, ov, ps)
+	recordPlanExecution(i, ov, ps)
+
+	assert.Equal(t, []kudoapi.PlanExecutionRecord{{
+		Name:              "deploy",
+		UID:               "111",
+		Trigger:           kudoapi.PlanTriggerParameterUpdate,
+		Status:            kudoapi.ExecutionFatalError,
+		Message:           "step failed",
+		OperatorVersion:   "foo-operator-1.0",
+		StartedTimestamp:  &started,
+		FinishedTimestamp: &finished,
+		ParameterChanges: []kudoapi.ParameterChange{
+			{Name: "added", To: convert.StringPtr("new")},
+			{Name: "image", From: convert.StringPtr("nginx")},
+			{Name: "replicas", From: convert.StringPtr("1"), To: convert.StringPtr("3")},
+		},
+	}}, i.Status.PlanHistory)
+}
+
+func Test_snapshotOf(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator-1.0", Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Version:    "1.0",
+			AppVersion: "3.2.1",
+			Parameters: []kudoapi.Parameter{
+				{Name: "replicas", Default: convert.StringPtr("1")},
+				{Name: "labels", Type: kudoapi.MapValueType},
+				{Name: "unset"},
+			},
+		},
+	}
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			Parameters: map[string]string{"labels": "foo: bar"},
+		},
+	}
+
+	snapshot := snapshotOf(instance, ov)
+	assert.Equal(t, v1.ObjectReference{Name: "foo-operator-1.0", Namespace: "default"}, snapshot.OperatorVersion)
+	assert.Equal(t, "1.0", snapshot.Version)
+	assert.Equal(t, "3.2.1", snapshot.AppVersion)
+	assert.Equal(t, map[string]string{"replicas": "1", "labels": "foo: bar"}, snapshot.Parameters)
+}
+
 func Test_resetPlanStatusIfPlanIsNew(t *testing.T) {
 	status := kudoapi.PlanStatus{
 		Name:   "deploy",
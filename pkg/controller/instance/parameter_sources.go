@@ -0,0 +1,198 @@
+package instance
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+const (
+	// field indexes of the instances by the names of the Secrets and ConfigMaps they source parameters from
+	secretParameterSourceIndex    = "spec.parametersFrom.secretKeyRef.name"
+	configMapParameterSourceIndex = "spec.parametersFrom.configMapKeyRef.name"
+)
+
+// indexParameterSources indexes the instances by the names of the Secrets and ConfigMaps referenced in their
+// InstanceSpec.ParametersFrom
+func indexParameterSources(indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(context.TODO(), &kudoapi.Instance{}, secretParameterSourceIndex, referencedSecrets); err != nil {
+		return fmt.Errorf("failed to index the secret parameter sources of instances: %w", err)
+	}
+	if err := indexer.IndexField(context.TODO(), &kudoapi.Instance{}, configMapParameterSourceIndex, referencedConfigMaps); err != nil {
+		return fmt.Errorf("failed to index the configmap parameter sources of instances: %w", err)
+	}
+	return nil
+}
+
+// parameterSourceInformers returns informers that only cache the metadata of all Secrets and ConfigMaps. That is
+// enough to map their events to the instances that reference them, the values are read uncached when an instance is
+// reconciled. The informers are started with the manager.
+func parameterSourceInformers(mgr ctrl.Manager) (secrets, configMaps toolscache.SharedIndexInformer, err error) {
+	mc, err := metadata.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the metadata client for parameter sources: %w", err)
+	}
+
+	factory := metadatainformer.NewSharedInformerFactory(mc, 0)
+	secrets = factory.ForResource(corev1.SchemeGroupVersion.WithResource("secrets")).Informer()
+	configMaps = factory.ForResource(corev1.SchemeGroupVersion.WithResource("configmaps")).Informer()
+
+	err = mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+		factory.Start(stop)
+		<-stop
+		return nil
+	}))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to add the parameter source informers to the manager: %w", err)
+	}
+	return secrets, configMaps, nil
+}
+
+func referencedSecrets(obj runtime.Object) []string {
+	names := []string{}
+	for _, source := range obj.(*kudoapi.Instance).Spec.ParametersFrom {
+		if source.SecretKeyRef != nil && !funk.ContainsString(names, source.SecretKeyRef.Name) {
+			names = append(names, source.SecretKeyRef.Name)
+		}
+	}
+	return names
+}
+
+func referencedConfigMaps(obj runtime.Object) []string {
+	names := []string{}
+	for _, source := range obj.(*kudoapi.Instance).Spec.ParametersFrom {
+		if source.ConfigMapKeyRef != nil && !funk.ContainsString(names, source.ConfigMapKeyRef.Name) {
+			names = append(names, source.ConfigMapKeyRef.Name)
+		}
+	}
+	return names
+}
+
+// referencingInstances returns the instances that source parameters from the object, using the given field index
+func referencingInstances(c client.Reader, index string, obj metav1.Object) []kudoapi.Instance {
+	instances := &kudoapi.InstanceList{}
+	if err := c.List(context.TODO(), instances, client.InNamespace(obj.GetNamespace()), client.MatchingFields{index: obj.GetName()}); err != nil {
+		log.Printf("InstanceController: Error fetching instances referencing %s/%s: %v", obj.GetNamespace(), obj.GetName(), err)
+		return nil
+	}
+	return instances.Items
+}
+
+// parameterSourceInstancesToReconcile maps a Secret or ConfigMap to the instances that source parameters from it
+// (see InstanceSpec.ParametersFrom), so that a changed value can trigger a plan
+func parameterSourceInstancesToReconcile(c client.Reader, index string) handler.ToRequestsFunc {
+	return func(obj handler.MapObject) []reconcile.Request {
+		instances := referencingInstances(c, index, obj.Meta)
+		requests := make([]reconcile.Request, 0, len(instances))
+		for _, instance := range instances {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      instance.Name,
+					Namespace: instance.Namespace,
+				},
+			})
+		}
+		return requests
+	}
+}
+
+// parameterSourcePredicate passes the events of Secrets and ConfigMaps that are referenced by an instance. Only their
+// metadata is watched, updates that don't change the resource version (resyncs) are dropped.
+func parameterSourcePredicate(c client.Reader, index string) predicate.Funcs {
+	isReferenced := func(obj metav1.Object) bool {
+		return len(referencingInstances(c, index, obj)) > 0
+	}
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return isReferenced(e.Meta) },
+		DeleteFunc: func(e event.DeleteEvent) bool { return isReferenced(e.Meta) },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.MetaOld.GetResourceVersion() != e.MetaNew.GetResourceVersion() && isReferenced(e.MetaNew)
+		},
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// processParameterSources compares the current values of the parameters in InstanceSpec.ParametersFrom with the hashes
+// of the last observed values in InstanceStatus.ParameterSources. When values changed, the plan that an update of these
+// parameters would trigger is scheduled. If another plan is scheduled (or running), the old hashes are kept and the plan
+// is triggered once the other plan is done. The values of newly referenced sources are only recorded, as changing
+// InstanceSpec.ParametersFrom already triggers a plan. Returns the triggered plan and the changed parameters.
+func processParameterSources(i *kudoapi.Instance, ov *kudoapi.OperatorVersion, c client.Reader) (*string, []string, error) {
+	if len(i.Spec.ParametersFrom) == 0 {
+		i.Status.ParameterSources = nil
+		return nil, nil, nil
+	}
+
+	values, err := i.ResolveParametersFrom(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hashes := make(map[string]string, len(i.Spec.ParametersFrom))
+	changed := map[string]string{}
+	for name := range i.Spec.ParametersFrom {
+		hash := "" // an optional source that doesn't exist
+		if v, ok := values[name]; ok {
+			hash = parameterSourceHash(v)
+		}
+		hashes[name] = hash
+		if old, ok := i.Status.ParameterSources[name]; ok && old != hash {
+			changed[name] = hash
+		}
+	}
+
+	canTrigger := i.Spec.PlanExecution.PlanName == "" && !i.IsDeleting()
+	if len(changed) == 0 || !canTrigger {
+		for name := range changed {
+			hashes[name] = i.Status.ParameterSources[name]
+		}
+		i.Status.ParameterSources = hashes
+		return nil, nil, nil
+	}
+
+	defs, err := kudoapi.GetParamDefinitions(changed, ov)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := kudoapi.TriggeredByParameterUpdate(defs, ov)
+	if err != nil || plan == nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(changed))
+	for name := range changed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	log.Printf("InstanceController: Parameter sources of %v of instance %s/%s changed. Triggering '%s' plan.", names, i.Namespace, i.Name, *plan)
+	i.Spec.PlanExecution.PlanName = *plan
+	i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerParameterSource
+	i.Status.ParameterSources = hashes
+	return plan, names, nil
+}
+
+// parameterSourceHash returns the SHA-256 hash of a parameter source value
+func parameterSourceHash(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))
+}
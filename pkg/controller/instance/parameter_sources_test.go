@@ -0,0 +1,128 @@
+package instance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+func Test_processParameterSources(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "test"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{{Name: "password", Trigger: "rotate"}},
+			Plans:      map[string]kudoapi.Plan{"deploy": {}, "rotate": {}},
+		},
+	}
+	secret := func(value string) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "test"},
+			Data:       map[string][]byte{"password": []byte(value)},
+		}
+	}
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", UID: "uid"},
+		Spec: kudoapi.InstanceSpec{
+			ParametersFrom: map[string]kudoapi.ParameterSource{
+				"password": {SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "credentials"}, Key: "password"}},
+			},
+		},
+	}
+
+	// the first observed value is only recorded
+	plan, changed, err := processParameterSources(instance, ov, fake.NewFakeClientWithScheme(scheme.Scheme, secret("s3cr3t")))
+	assert.NoError(t, err)
+	assert.Nil(t, plan)
+	assert.Nil(t, changed)
+	assert.Equal(t, parameterSourceHash("s3cr3t"), instance.Status.ParameterSources["password"])
+
+	// a changed value is not triggered while another plan is scheduled
+	instance.Spec.PlanExecution.PlanName = "deploy"
+	plan, _, err = processParameterSources(instance, ov, fake.NewFakeClientWithScheme(scheme.Scheme, secret("n3w-s3cr3t")))
+	assert.NoError(t, err)
+	assert.Nil(t, plan)
+	assert.Equal(t, parameterSourceHash("s3cr3t"), instance.Status.ParameterSources["password"], "the old value must be kept until the plan is triggered")
+
+	// and triggers the plan of the parameter once the other plan is done
+	instance.Spec.PlanExecution.PlanName = ""
+	plan, changed, err = processParameterSources(instance, ov, fake.NewFakeClientWithScheme(scheme.Scheme, secret("n3w-s3cr3t")))
+	assert.NoError(t, err)
+	assert.Equal(t, "rotate", *plan)
+	assert.Equal(t, []string{"password"}, changed)
+	assert.Equal(t, "rotate", instance.Spec.PlanExecution.PlanName)
+	assert.Equal(t, kudoapi.PlanTriggerParameterSource, instance.Spec.PlanExecution.Trigger)
+	assert.Equal(t, parameterSourceHash("n3w-s3cr3t"), instance.Status.ParameterSources["password"])
+
+	// a missing source is an error
+	instance.Spec.PlanExecution.PlanName = ""
+	_, _, err = processParameterSources(instance, ov, fake.NewFakeClientWithScheme(scheme.Scheme))
+	assert.EqualError(t, err, "secret credentials/password of parameter password in namespace test does not exist")
+}
+
+func Test_referencedParameterSources(t *testing.T) {
+	instance := &kudoapi.Instance{
+		Spec: kudoapi.InstanceSpec{
+			ParametersFrom: map[string]kudoapi.ParameterSource{
+				"user":     {SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "credentials"}, Key: "user"}},
+				"password": {SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "credentials"}, Key: "password"}},
+				"nodes":    {ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}, Key: "nodes"}},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"credentials"}, referencedSecrets(instance))
+	assert.Equal(t, []string{"settings"}, referencedConfigMaps(instance))
+	assert.Empty(t, referencedSecrets(&kudoapi.Instance{}))
+}
+
+func Test_parameterSourcePredicate(t *testing.T) {
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			ParametersFrom: map[string]kudoapi.ParameterSource{
+				"nodes": {ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}, Key: "nodes"}},
+			},
+		},
+	}
+	cm := func(namespace, value string) *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: namespace, ResourceVersion: value},
+			Data:       map[string]string{"nodes": value},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		old     *v1.ConfigMap
+		new     *v1.ConfigMap
+		allowed bool
+	}{
+		{name: "changed data of a referenced configmap", old: cm("test", "1"), new: cm("test", "3"), allowed: true},
+		{name: "unchanged data of a referenced configmap", old: cm("test", "1"), new: cm("test", "1"), allowed: false},
+		{name: "changed data of an unreferenced configmap", old: cm("other", "1"), new: cm("other", "3"), allowed: false},
+	}
+
+	testScheme := runtime.NewScheme()
+	assert.NoError(t, scheme.AddToScheme(testScheme))
+	assert.NoError(t, kudoapi.AddToScheme(testScheme))
+
+	// the fake client ignores field selectors, all instances in the namespace of the configmap reference it
+	p := parameterSourcePredicate(fake.NewFakeClientWithScheme(testScheme, instance), configMapParameterSourceIndex)
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, p.Update(event.UpdateEvent{MetaOld: tt.old, ObjectOld: tt.old, MetaNew: tt.new, ObjectNew: tt.new}))
+		})
+	}
+
+	assert.True(t, p.Create(event.CreateEvent{Meta: cm("test", "1"), Object: cm("test", "1")}))
+	assert.False(t, p.Create(event.CreateEvent{Meta: cm("other", "1"), Object: cm("other", "1")}))
+}
@@ -0,0 +1,106 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+	"github.com/kudobuilder/kudo/pkg/engine/workflow"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
+)
+
+// recordAppliedObjects adds the objects applied during a plan execution to the InstanceStatus.AppliedObjects. The
+// list is kept sorted, so that recording the same objects again doesn't change the status.
+func recordAppliedObjects(i *kudoapi.Instance, applied task.AppliedObjects) {
+	if len(applied) == 0 {
+		return
+	}
+
+	for _, o := range i.Status.AppliedObjects {
+		applied[o] = struct{}{}
+	}
+	objs := make([]kudoapi.AppliedObject, 0, len(applied))
+	for o := range applied {
+		objs = append(objs, o)
+	}
+	sortAppliedObjects(objs)
+	i.Status.AppliedObjects = objs
+}
+
+func sortAppliedObjects(objs []kudoapi.AppliedObject) {
+	sort.Slice(objs, func(a, b int) bool {
+		if objs[a].Namespace != objs[b].Namespace {
+			return objs[a].Namespace < objs[b].Namespace
+		}
+		if objs[a].Kind != objs[b].Kind {
+			return objs[a].Kind < objs[b].Kind
+		}
+		if objs[a].Name != objs[b].Name {
+			return objs[a].Name < objs[b].Name
+		}
+		return objs[a].APIVersion < objs[b].APIVersion
+	})
+}
+
+// pruneOrphanedObjects deletes the orphaned objects after the passed plan finished successfully. An object is desired
+// if it was applied by this plan execution, objects of steps skipped by this plan execution are kept. Objects that are
+// deleted or don't exist anymore are removed from the InstanceStatus.AppliedObjects. Returns the descriptions of the
+// deleted objects.
+func pruneOrphanedObjects(i *kudoapi.Instance, ov *kudoapi.OperatorVersion, planStatus *kudoapi.PlanStatus, c client.Client) ([]string, error) {
+	appliedByPlan := func(live *unstructured.Unstructured) bool {
+		return live.GetAnnotations()[kudo.PlanUIDAnnotation] == string(planStatus.UID)
+	}
+	skippedSteps := map[string]bool{}
+	for _, ph := range planStatus.Phases {
+		for _, st := range ph.Steps {
+			if ph.Status == kudoapi.ExecutionSkipped || st.Status == kudoapi.ExecutionSkipped {
+				skippedSteps[ph.Name+"."+st.Name] = true
+			}
+		}
+	}
+	skipped := func(phase, step string) bool { return skippedSteps[phase+"."+step] }
+
+	orphans, err := workflow.OrphanedObjects(i, ov, appliedByPlan, skipped, c)
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := make([]string, 0, len(orphans))
+	deleted := map[kudoapi.AppliedObject]bool{}
+	for _, obj := range orphans {
+		log.Printf("InstanceController: Plan '%s' of instance %s/%s prunes %s", planStatus.Name, i.Namespace, i.Name, task.ObjectName(obj))
+		err := c.Delete(context.TODO(), obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !apierrors.IsNotFound(err) {
+			return pruned, fmt.Errorf("failed to prune %s: %v", task.ObjectName(obj), err)
+		}
+		deleted[appliedObjectOf(obj)] = true
+		pruned = append(pruned, task.ObjectName(obj))
+	}
+
+	// objects deleted in the meantime, e.g. by a Delete task, are dropped as well
+	live, err := workflow.LiveAppliedObjects(i, c)
+	if err != nil {
+		return pruned, err
+	}
+	remaining := []kudoapi.AppliedObject{}
+	for _, o := range i.Status.AppliedObjects {
+		if _, ok := live[o]; ok && !deleted[o] {
+			remaining = append(remaining, o)
+		}
+	}
+	i.Status.AppliedObjects = remaining
+	return pruned, nil
+}
+
+func appliedObjectOf(obj *unstructured.Unstructured) kudoapi.AppliedObject {
+	apiVersion, kind := obj.GroupVersionKind().ToAPIVersionAndKind()
+	return kudoapi.AppliedObject{APIVersion: apiVersion, Kind: kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+}
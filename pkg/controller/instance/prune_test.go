@@ -0,0 +1,88 @@
+package instance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+)
+
+func Test_recordAppliedObjects(t *testing.T) {
+	config := kudoapi.AppliedObject{APIVersion: "v1", Kind: "ConfigMap", Namespace: "test", Name: "config"}
+	svc := kudoapi.AppliedObject{APIVersion: "v1", Kind: "Service", Namespace: "test", Name: "svc"}
+	deploy := kudoapi.AppliedObject{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "test", Name: "app"}
+
+	i := &kudoapi.Instance{}
+	recordAppliedObjects(i, task.AppliedObjects{svc: {}})
+	recordAppliedObjects(i, task.AppliedObjects{config: {}, svc: {}, deploy: {}})
+	assert.Equal(t, []kudoapi.AppliedObject{config, deploy, svc}, i.Status.AppliedObjects)
+
+	recordAppliedObjects(i, nil)
+	assert.Equal(t, []kudoapi.AppliedObject{config, deploy, svc}, i.Status.AppliedObjects)
+}
+
+func Test_pruneOrphanedObjects(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		Spec: kudoapi.OperatorVersionSpec{
+			Plans: map[string]kudoapi.Plan{"deploy": {Prune: true}, "upgrade": {Prune: true}, "backup": {}},
+		},
+	}
+	instance := &kudoapi.Instance{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"}}
+	configMap := func(name, plan, step, planUID, instanceName string) *v1.ConfigMap {
+		instance.Status.AppliedObjects = append(instance.Status.AppliedObjects, kudoapi.AppliedObject{APIVersion: "v1", Kind: "ConfigMap", Namespace: "test", Name: name})
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "test",
+				Labels:      map[string]string{"heritage": "kudo", "kudo.dev/instance": instanceName},
+				Annotations: map[string]string{"kudo.dev/plan": plan, "kudo.dev/phase": "main", "kudo.dev/step": step, "kudo.dev/last-plan-execution-uid": planUID},
+			},
+		}
+	}
+
+	c := fake.NewFakeClientWithScheme(scheme.Scheme,
+		configMap("current", "upgrade", "app", "new-uid", "test"),
+		configMap("orphan-deploy", "deploy", "app", "old-uid", "test"),
+		configMap("orphan-upgrade", "upgrade", "app", "older-uid", "test"),
+		configMap("skipped", "deploy", "monitoring", "old-uid", "test"),
+		configMap("backup", "backup", "app", "old-uid", "test"),
+		configMap("adopted", "deploy", "app", "old-uid", "other"),
+	)
+	instance.Status.AppliedObjects = append(instance.Status.AppliedObjects, kudoapi.AppliedObject{APIVersion: "v1", Kind: "ConfigMap", Namespace: "test", Name: "gone"})
+
+	planStatus := &kudoapi.PlanStatus{
+		Name: "upgrade",
+		UID:  "new-uid",
+		Phases: []kudoapi.PhaseStatus{{
+			Name:   "main",
+			Status: kudoapi.ExecutionComplete,
+			Steps: []kudoapi.StepStatus{
+				{Name: "app", Status: kudoapi.ExecutionComplete},
+				{Name: "monitoring", Status: kudoapi.ExecutionSkipped},
+			},
+		}},
+	}
+	pruned, err := pruneOrphanedObjects(instance, ov, planStatus, c)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ConfigMap test/orphan-deploy", "ConfigMap test/orphan-upgrade"}, pruned)
+
+	for name, exists := range map[string]bool{"current": true, "orphan-deploy": false, "orphan-upgrade": false, "skipped": true, "backup": true, "adopted": true} {
+		err := c.Get(context.TODO(), types.NamespacedName{Namespace: "test", Name: name}, &v1.ConfigMap{})
+		assert.Equal(t, !exists, apierrors.IsNotFound(err), "configmap %s", name)
+	}
+
+	names := []string{}
+	for _, o := range instance.Status.AppliedObjects {
+		names = append(names, o.Name)
+	}
+	assert.Equal(t, []string{"current", "skipped", "backup", "adopted"}, names)
+}
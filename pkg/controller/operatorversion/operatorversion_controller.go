@@ -17,19 +17,29 @@ package operatorversion
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/convert"
+	pkgverifier "github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
 )
 
+// maxConditionMessageLength is the maximum length of a condition message accepted by the API server
+const maxConditionMessageLength = 32768
+
 // Reconciler reconciles an OperatorVersion object
 type Reconciler struct {
 	client.Client
+	Recorder record.EventRecorder
 }
 
 // SetupWithManager registers this reconciler with the controller manager
@@ -43,7 +53,9 @@ func (r *Reconciler) SetupWithManager(
 // Reconcile reads that state of the cluster for an OperatorVersion object and makes changes based on the state read
 // and what is in the OperatorVersion.Spec.
 //
-// Automatically generate RBAC rules to allow the Controller to read and write Deployments
+// Each generation of an OperatorVersion is validated once, the result is written to the "Valid" condition of the
+// OperatorVersionStatus and reported as an event. This way, broken OperatorVersions that were not created by the CLI
+// are discovered before an Instance uses them.
 func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 	// Fetch the operator version
 	operatorVersion := &kudoapi.OperatorVersion{}
@@ -60,6 +72,45 @@ func (r *Reconciler) Reconcile(request ctrl.Request) (ctrl.Result, error) {
 
 	log.Printf("OperatorVersionController: Received Reconcile request for an operatorVersion named: %v", request.Name)
 
-	// TODO: Validate OperatorVersion is appropriate.
+	if operatorVersion.IsValidated() {
+		return reconcile.Result{}, nil
+	}
+
+	res := Validate(operatorVersion)
+	if res.IsValid() {
+		msg := "OperatorVersion is valid"
+		if len(res.Warnings) > 0 {
+			msg = fmt.Sprintf("%s, warnings: %s", msg, strings.Join(res.Warnings, ", "))
+		}
+		operatorVersion.SetValidation(kudoapi.ValidationSucceeded, conditionMessage(msg))
+		r.Recorder.Event(operatorVersion, "Normal", string(kudoapi.ValidationSucceeded), msg)
+	} else {
+		msg := fmt.Sprintf("OperatorVersion is invalid: %s", strings.Join(res.Errors, ", "))
+		log.Printf("OperatorVersionController: %s/%s: %s", operatorVersion.Namespace, operatorVersion.Name, msg)
+		operatorVersion.SetValidation(kudoapi.ValidationFailed, conditionMessage(msg))
+		r.Recorder.Event(operatorVersion, "Warning", string(kudoapi.ValidationFailed), msg)
+	}
+
+	if err := r.Status().Update(context.TODO(), operatorVersion); err != nil {
+		log.Printf("OperatorVersionController: Error when updating the status of operatorVersion %s/%s: %v", operatorVersion.Namespace, operatorVersion.Name, err)
+		return reconcile.Result{}, err
+	}
 	return reconcile.Result{}, nil
 }
+
+// Validate runs the shared package verifiers on an OperatorVersion
+func Validate(ov *kudoapi.OperatorVersion) verifier.Result {
+	pf, err := convert.OperatorVersionToFiles(ov)
+	if err != nil {
+		return verifier.NewError(err.Error())
+	}
+
+	return pkgverifier.Verify(pf)
+}
+
+func conditionMessage(msg string) string {
+	if len(msg) > maxConditionMessageLength {
+		return msg[:maxConditionMessageLength-3] + "..."
+	}
+	return msg
+}
@@ -0,0 +1,152 @@
+package operatorversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/apis"
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+func operatorVersion(mutate func(ov *kudoapi.OperatorVersion)) *kudoapi.OperatorVersion {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1.0", Namespace: "default", Generation: 1},
+		Spec: kudoapi.OperatorVersionSpec{
+			Operator:   corev1.ObjectReference{Name: "test"},
+			Version:    "1.0",
+			Parameters: []kudoapi.Parameter{{Name: "REPLICAS", Default: convert.StringPtr("1")}},
+			Templates: map[string]string{
+				"deployment.yaml": "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: app\nspec:\n  replicas: {{ .Params.REPLICAS }}\n",
+			},
+			Tasks: []kudoapi.Task{{
+				Name: "app",
+				Kind: "Apply",
+				Spec: kudoapi.TaskSpec{ResourceTaskSpec: kudoapi.ResourceTaskSpec{Resources: []string{"deployment.yaml"}}},
+			}},
+			Plans: map[string]kudoapi.Plan{
+				"deploy": {Phases: []kudoapi.Phase{{Name: "app", Steps: []kudoapi.Step{{Name: "app", Tasks: []string{"app"}}}}}},
+			},
+		},
+	}
+	if mutate != nil {
+		mutate(ov)
+	}
+	return ov
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		ov       *kudoapi.OperatorVersion
+		valid    bool
+		errors   []string
+		warnings []string
+	}{
+		{name: "valid operator version", ov: operatorVersion(nil), valid: true},
+		{
+			name: "undefined task and missing deploy plan",
+			ov: operatorVersion(func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Plans = map[string]kudoapi.Plan{
+					"update": {Phases: []kudoapi.Phase{{Name: "app", Steps: []kudoapi.Step{{Name: "app", Tasks: []string{"missing"}}}}}},
+				}
+			}),
+			errors:   []string{`task "missing" in plan "update" is not defined`, "an operator is required to have 'deploy' plan"},
+			warnings: []string{`task "app" defined but not used`},
+		},
+		{
+			name: "undefined parameter in a template",
+			ov: operatorVersion(func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Parameters = nil
+			}),
+			errors: []string{`parameter "REPLICAS" in template deployment.yaml is not defined`},
+		},
+		{
+			name: "template that doesn't parse",
+			ov: operatorVersion(func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Templates["deployment.yaml"] = "replicas: {{ .Params.REPLICAS"
+			}),
+			warnings: []string{`parameter "REPLICAS" defined but not used.`},
+		},
+		{
+			name: "duplicate parameter",
+			ov: operatorVersion(func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Parameters = append(ov.Spec.Parameters, kudoapi.Parameter{Name: "replicas"})
+			}),
+			errors:   []string{`parameter "replicas" has a duplicate`},
+			warnings: []string{`parameter "replicas" defined but not used.`},
+		},
+		{
+			name: "duplicate health check",
+			ov: operatorVersion(func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.HealthChecks = []kudoapi.HealthCheck{{APIVersion: "example.com/v1", Kind: "Cluster"}, {APIVersion: "example.com/v1", Kind: "Cluster"}}
+			}),
+			errors: []string{"health check for example.com/v1 Cluster has a duplicate"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			res := Validate(tt.ov)
+			assert.Equal(t, tt.valid, res.IsValid(), "errors: %v", res.Errors)
+			assert.Subset(t, res.Errors, tt.errors)
+			assert.ElementsMatch(t, tt.warnings, res.Warnings)
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name   string
+		ov     *kudoapi.OperatorVersion
+		status metav1.ConditionStatus
+		reason kudoapi.ValidationType
+		event  string
+	}{
+		{name: "valid", ov: operatorVersion(nil), status: metav1.ConditionTrue, reason: kudoapi.ValidationSucceeded, event: "Normal ValidationSucceeded OperatorVersion is valid"},
+		{
+			name: "invalid",
+			ov: operatorVersion(func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Tasks[0].Spec.Resources = []string{"service.yaml"}
+			}),
+			status: metav1.ConditionFalse,
+			reason: kudoapi.ValidationFailed,
+			event:  `Warning ValidationFailed OperatorVersion is invalid: template "service.yaml" required by app but is not defined`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, apis.AddToScheme(scheme.Scheme))
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{Client: fake.NewFakeClientWithScheme(scheme.Scheme, tt.ov), Recorder: recorder}
+			key := types.NamespacedName{Namespace: tt.ov.Namespace, Name: tt.ov.Name}
+
+			_, err := r.Reconcile(ctrl.Request{NamespacedName: key})
+			assert.NoError(t, err)
+
+			ov := &kudoapi.OperatorVersion{}
+			assert.NoError(t, r.Get(context.TODO(), key, ov))
+			assert.True(t, ov.IsValidated())
+			assert.Equal(t, tt.status, ov.Validation().Status)
+			assert.Equal(t, string(tt.reason), ov.Validation().Reason)
+			assert.Contains(t, <-recorder.Events, tt.event)
+
+			// the same generation is validated only once
+			_, err = r.Reconcile(ctrl.Request{NamespacedName: key})
+			assert.NoError(t, err)
+			assert.Empty(t, recorder.Events)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package renderer
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	helmengine "helm.sh/helm/v3/pkg/engine"
+)
+
+const (
+	// ChartFileName marks a directory in the templates folder of a package as a Helm chart
+	ChartFileName = "Chart.yaml"
+	// ChartValuesFileName contains the default values of a chart
+	ChartValuesFileName = "values.yaml"
+
+	// chartNotesFileSuffix is the suffix of the usage notes of a chart, which are not rendered
+	chartNotesFileSuffix = "NOTES.txt"
+)
+
+// Release describes the installation of a chart, available in chart templates as {{ .Release.* }}
+type Release struct {
+	Name      string
+	Namespace string
+	Service   string
+}
+
+// IsChartFile returns true if the passed template belongs to a chart, i.e. one of its parent directories contains
+// a Chart.yaml
+func IsChartFile(name string, templates map[string]string) bool {
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if _, ok := templates[path.Join(dir, ChartFileName)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadChart loads the chart in the passed directory from the package templates with the chart loader of Helm.
+// Subcharts have to be unpacked in the charts directory of the chart, packaged subcharts are not supported.
+func LoadChart(dir string, templates map[string]string) (*chart.Chart, error) {
+	dir = path.Clean(dir)
+	if _, ok := templates[path.Join(dir, ChartFileName)]; !ok {
+		return nil, fmt.Errorf("chart %s is missing %s", dir, ChartFileName)
+	}
+
+	files := []*loader.BufferedFile{}
+	for name, t := range templates {
+		if strings.HasPrefix(name, dir+"/") {
+			files = append(files, &loader.BufferedFile{Name: strings.TrimPrefix(name, dir+"/"), Data: []byte(t)})
+		}
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	c, err := loader.LoadFiles(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart %s: %v", dir, err)
+	}
+	if c.Metadata.Type == "library" {
+		return nil, fmt.Errorf("chart %s is a library chart and can not be rendered", dir)
+	}
+	return c, nil
+}
+
+// RenderChart renders a chart with the template engine of Helm, like `helm template` does. The passed values override
+// the default values of the chart. The rendered templates are keyed by their path in the chart, e.g.
+// "redis/templates/deployment.yaml". Partials, usage notes and templates that render to an empty document are
+// omitted. Templates can't look up cluster objects, the Helm lookup function always returns an empty result.
+func RenderChart(c *chart.Chart, release Release, values map[string]interface{}) (map[string]string, error) {
+	return renderChart(helmengine.Engine{}, c, release, values)
+}
+
+// VerifyChart renders a chart with its default values in the lint mode of Helm, in which missing required values are
+// no errors. It returns an error for templates that can't be parsed or rendered.
+func VerifyChart(c *chart.Chart) error {
+	_, err := renderChart(helmengine.Engine{LintMode: true}, c, Release{Name: "verify", Namespace: "default"}, nil)
+	return err
+}
+
+func renderChart(e helmengine.Engine, c *chart.Chart, release Release, values map[string]interface{}) (map[string]string, error) {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if err := chartutil.ProcessDependencies(c, values); err != nil {
+		return nil, err
+	}
+
+	options := chartutil.ReleaseOptions{Name: release.Name, Namespace: release.Namespace, Revision: 1, IsInstall: true}
+	vals, err := chartutil.ToRenderValues(c, values, options, nil)
+	if err != nil {
+		return nil, err
+	}
+	if release.Service != "" {
+		vals["Release"].(map[string]interface{})["Service"] = release.Service
+	}
+
+	out, err := e.Render(c, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := map[string]string{}
+	for name, t := range out {
+		if strings.HasSuffix(name, chartNotesFileSuffix) || strings.TrimSpace(t) == "" {
+			continue
+		}
+		rendered[name] = t
+	}
+	return rendered, nil
+}
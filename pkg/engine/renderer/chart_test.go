@@ -0,0 +1,126 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+)
+
+var chartTemplates = map[string]string{
+	"redis/Chart.yaml":  "apiVersion: v2\nname: redis\nversion: 1.2.3\nappVersion: 6.0.9\n",
+	"redis/values.yaml": "replicas: 1\nimage:\n  repository: redis\n  tag: 6.0.9\n",
+	"redis/templates/_helpers.tpl": `{{- define "redis.fullname" -}}
+{{ .Release.Name }}-{{ .Chart.Name }}
+{{- end -}}`,
+	"redis/templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "redis.fullname" . }}
+  namespace: {{ .Release.Namespace }}
+  labels:
+    app.kubernetes.io/managed-by: {{ .Release.Service }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - image: {{ .Values.image.repository }}:{{ .Values.image.tag }}
+        args: [{{ .Values.args }}]
+`,
+	"redis/templates/service.yaml": `{{ if .Values.service }}
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ required "service.name is required" .Values.service.name }}
+{{ end }}`,
+	"redis-values.yaml": "replicas: {{ .Params.REPLICAS }}",
+}
+
+func TestIsChartFile(t *testing.T) {
+	assert.True(t, IsChartFile("redis/Chart.yaml", chartTemplates))
+	assert.True(t, IsChartFile("redis/templates/deployment.yaml", chartTemplates))
+	assert.False(t, IsChartFile("redis-values.yaml", chartTemplates))
+	assert.False(t, IsChartFile("other/deployment.yaml", chartTemplates))
+}
+
+func TestLoadChart(t *testing.T) {
+	c, err := LoadChart("redis", chartTemplates)
+	assert.NoError(t, err)
+	assert.Equal(t, &chart.Metadata{APIVersion: "v2", Name: "redis", Version: "1.2.3", AppVersion: "6.0.9"}, c.Metadata)
+	assert.Equal(t, float64(1), c.Values["replicas"])
+	assert.Len(t, c.Templates, 3)
+
+	_, err = LoadChart("missing", chartTemplates)
+	assert.EqualError(t, err, "chart missing is missing Chart.yaml")
+
+	_, err = LoadChart("invalid", map[string]string{"invalid/Chart.yaml": "name: invalid"})
+	assert.EqualError(t, err, "failed to load chart invalid: validation: chart.metadata.version is required")
+
+	_, err = LoadChart("library", map[string]string{"library/Chart.yaml": "apiVersion: v2\nname: library\nversion: 0.1.0\ntype: library"})
+	assert.EqualError(t, err, "chart library is a library chart and can not be rendered")
+}
+
+func TestRenderChart(t *testing.T) {
+	c, err := LoadChart("redis", chartTemplates)
+	assert.NoError(t, err)
+
+	release := Release{Name: "test", Namespace: "default", Service: "KUDO"}
+	rendered, err := RenderChart(c, release, map[string]interface{}{
+		"replicas": 3,
+		"image":    map[string]interface{}{"tag": "6.2.0"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"redis/templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test-redis
+  namespace: default
+  labels:
+    app.kubernetes.io/managed-by: KUDO
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - image: redis:6.2.0
+        args: []
+`,
+	}, rendered)
+
+	_, err = RenderChart(c, release, map[string]interface{}{"service": map[string]interface{}{"port": 6379}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "service.name is required")
+}
+
+func TestRenderChart_subcharts(t *testing.T) {
+	c, err := LoadChart("app", map[string]string{
+		"app/Chart.yaml":                       "apiVersion: v2\nname: app\nversion: 0.1.0\n",
+		"app/values.yaml":                      "cache:\n  size: 1Gi\n",
+		"app/templates/NOTES.txt":              "Thank you for installing {{ .Chart.Name }}",
+		"app/charts/cache/Chart.yaml":          "apiVersion: v2\nname: cache\nversion: 0.1.0\n",
+		"app/charts/cache/templates/pvc.yaml":  "apiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  name: {{ .Release.Name }}-cache\nspec:\n  resources:\n    requests:\n      storage: {{ .Values.size }}\n",
+		"app/charts/cache/templates/_util.tpl": "{{ define \"cache.unused\" }}{{ end }}",
+	})
+	assert.NoError(t, err)
+
+	rendered, err := RenderChart(c, Release{Name: "test", Namespace: "default"}, map[string]interface{}{"cache": map[string]interface{}{"size": "2Gi"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"app/charts/cache/templates/pvc.yaml": "apiVersion: v1\nkind: PersistentVolumeClaim\nmetadata:\n  name: test-cache\nspec:\n  resources:\n    requests:\n      storage: 2Gi\n",
+	}, rendered)
+}
+
+func TestVerifyChart(t *testing.T) {
+	c, err := LoadChart("redis", chartTemplates)
+	assert.NoError(t, err)
+	assert.NoError(t, VerifyChart(c), "missing required values are no errors")
+
+	c, err = LoadChart("broken", map[string]string{
+		"broken/Chart.yaml":             "apiVersion: v2\nname: broken\nversion: 0.1.0\n",
+		"broken/templates/service.yaml": "{{ if .Values.service }}",
+	})
+	assert.NoError(t, err)
+	assert.Error(t, VerifyChart(c))
+}
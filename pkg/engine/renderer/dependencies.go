@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -15,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kudobuilder/kudo/pkg/engine"
 	"github.com/kudobuilder/kudo/pkg/util/kudo"
 )
 
@@ -108,6 +110,79 @@ func (rd *resourceDependencies) addFromEmbeddedPodTemplateSpec(obj *unstructured
 		if v.Secret != nil {
 			*rd = append(*rd, resourceDependency{gvk: typeSecret, name: v.Secret.SecretName, namespace: ns})
 		}
+		if v.Projected != nil {
+			for _, ps := range v.Projected.Sources {
+				if ps.ConfigMap != nil {
+					*rd = append(*rd, resourceDependency{gvk: typeConfigMap, name: ps.ConfigMap.Name, namespace: ns})
+				}
+				if ps.Secret != nil {
+					*rd = append(*rd, resourceDependency{gvk: typeSecret, name: ps.Secret.Name, namespace: ns})
+				}
+			}
+		}
+	}
+
+	for _, c := range podTemplate.Spec.InitContainers {
+		rd.addFromContainerEnv(c, ns)
+	}
+	for _, c := range podTemplate.Spec.Containers {
+		rd.addFromContainerEnv(c, ns)
+	}
+	return nil
+}
+
+// addFromContainerEnv adds all ConfigMaps and Secrets a container references in its environment
+func (rd *resourceDependencies) addFromContainerEnv(c corev1.Container, ns string) {
+	for _, e := range c.EnvFrom {
+		if e.ConfigMapRef != nil {
+			*rd = append(*rd, resourceDependency{gvk: typeConfigMap, name: e.ConfigMapRef.Name, namespace: ns})
+		}
+		if e.SecretRef != nil {
+			*rd = append(*rd, resourceDependency{gvk: typeSecret, name: e.SecretRef.Name, namespace: ns})
+		}
+	}
+	for _, e := range c.Env {
+		if e.ValueFrom == nil {
+			continue
+		}
+		if e.ValueFrom.ConfigMapKeyRef != nil {
+			*rd = append(*rd, resourceDependency{gvk: typeConfigMap, name: e.ValueFrom.ConfigMapKeyRef.Name, namespace: ns})
+		}
+		if e.ValueFrom.SecretKeyRef != nil {
+			*rd = append(*rd, resourceDependency{gvk: typeSecret, name: e.ValueFrom.SecretKeyRef.Name, namespace: ns})
+		}
+	}
+}
+
+// addFromDependsOnAnnotation adds the dependencies that are explicitly declared by the DependsOnAnnotation of an
+// object, e.g. `kudo.dev/depends-on: configmap/foo,secret/bar`. The dependencies are in the namespace of the object.
+func (rd *resourceDependencies) addFromDependsOnAnnotation(obj *unstructured.Unstructured) error {
+	value, ok := obj.GetAnnotations()[kudo.DependsOnAnnotation]
+	if !ok {
+		return nil
+	}
+
+	for _, ref := range strings.Split(value, ",") {
+		ref = strings.TrimSpace(ref)
+		if ref == "" {
+			continue
+		}
+
+		parts := strings.Split(ref, "/")
+		if len(parts) != 2 || parts[1] == "" {
+			return fmt.Errorf("%winvalid dependency %q in annotation %s, expected <kind>/<name>", engine.ErrFatalExecution, ref, kudo.DependsOnAnnotation)
+		}
+
+		var gvk schema.GroupVersionKind
+		switch strings.ToLower(parts[0]) {
+		case "configmap":
+			gvk = typeConfigMap
+		case "secret":
+			gvk = typeSecret
+		default:
+			return fmt.Errorf("%winvalid dependency %q in annotation %s, only configmap and secret are supported", engine.ErrFatalExecution, ref, kudo.DependsOnAnnotation)
+		}
+		*rd = append(*rd, resourceDependency{gvk: gvk, name: parts[1], namespace: obj.GetNamespace()})
 	}
 	return nil
 }
@@ -127,6 +202,11 @@ func (de *dependencyCalculator) calculateAndSetHash(obj *unstructured.Unstructur
 
 	hashStr := fmt.Sprintf("%x", depHash.Sum([]byte{}))
 
+	if !hasPodTemplate(obj.GroupVersionKind()) {
+		// Objects without a pod template, e.g. custom resources that declare their dependencies explicitly, carry the
+		// hash themselves, so that they are updated when one of their dependencies changes
+		return addMapValues(obj.UnstructuredContent(), map[string]string{kudo.DependenciesHashAnnotation: hashStr}, "metadata", "annotations")
+	}
 	return setTemplateHash(obj, hashStr)
 }
 
@@ -255,10 +335,23 @@ func calculateResourceDependencies(obj *unstructured.Unstructured) (resourceDepe
 	if err := deps.addFromEmbeddedPodTemplateSpec(obj, "spec", "jobTemplate", "spec", "template"); err != nil {
 		return nil, err
 	}
+	if err := deps.addFromDependsOnAnnotation(obj); err != nil {
+		return nil, err
+	}
 
 	return deps, nil
 }
 
+// hasPodTemplate returns true for the kinds that embed a pod template, which the dependencies hash is added to
+func hasPodTemplate(gvk schema.GroupVersionKind) bool {
+	for _, lp := range TemplateAnnotationPaths {
+		if lp.matches(gvk) {
+			return true
+		}
+	}
+	return false
+}
+
 // setTemplateHash adds the given hash in the pod template spec of the obj
 func setTemplateHash(obj *unstructured.Unstructured, hashStr string) error {
 	fieldsToAdd := map[string]string{
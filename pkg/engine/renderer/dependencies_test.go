@@ -1,6 +1,7 @@
 package renderer
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -15,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/kudobuilder/kudo/pkg/engine"
 	"github.com/kudobuilder/kudo/pkg/util/kudo"
 )
 
@@ -103,6 +105,12 @@ func TestSetDependenciesHash(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, "fancyHash", c.Spec.JobTemplate.Spec.Template.Annotations[kudo.DependenciesHashAnnotation])
 		}},
+		{name: "daemonset", obj: daemonSet("somename", "namespace"), assert: func(us *unstructured.Unstructured) {
+			ds := &v1.DaemonSet{}
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(us.UnstructuredContent(), &ds)
+			assert.NoError(t, err)
+			assert.Equal(t, "fancyHash", ds.Spec.Template.Annotations[kudo.DependenciesHashAnnotation])
+		}},
 		{name: "no change in pod", obj: pod("somename", "namespace"), assert: func(us *unstructured.Unstructured) {
 			p := &corev1.Pod{}
 			err := runtime.DefaultUnstructuredConverter.FromUnstructured(us.UnstructuredContent(), &p)
@@ -155,6 +163,56 @@ func TestCalculateDependencies(t *testing.T) {
 				namespace: namespace,
 			},
 		}},
+		{name: "projected", modify: func(sts *v1.StatefulSet) {
+			sts.Spec.Template.Spec.Volumes = append(sts.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: "projected",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "configmap"}}},
+							{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "secret"}}},
+						},
+					},
+				},
+			})
+		}, expected: resourceDependencies{
+			resourceDependency{gvk: typeConfigMap, name: "configmap", namespace: namespace},
+			resourceDependency{gvk: typeSecret, name: "secret", namespace: namespace},
+		}},
+		{name: "container environment", modify: func(sts *v1.StatefulSet) {
+			sts.Spec.Template.Spec.InitContainers = []corev1.Container{{
+				Name: "init",
+				EnvFrom: []corev1.EnvFromSource{
+					{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "init-secret"}}},
+				},
+			}}
+			sts.Spec.Template.Spec.Containers = []corev1.Container{{
+				Name: "main",
+				EnvFrom: []corev1.EnvFromSource{
+					{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "env-configmap"}}},
+				},
+				Env: []corev1.EnvVar{
+					{Name: "PLAIN", Value: "value"},
+					{Name: "FROM_CONFIGMAP", ValueFrom: &corev1.EnvVarSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "key-configmap"}, Key: "key"},
+					}},
+					{Name: "FROM_SECRET", ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "key-secret"}, Key: "key"},
+					}},
+				},
+			}}
+		}, expected: resourceDependencies{
+			resourceDependency{gvk: typeSecret, name: "init-secret", namespace: namespace},
+			resourceDependency{gvk: typeConfigMap, name: "env-configmap", namespace: namespace},
+			resourceDependency{gvk: typeConfigMap, name: "key-configmap", namespace: namespace},
+			resourceDependency{gvk: typeSecret, name: "key-secret", namespace: namespace},
+		}},
+		{name: "depends-on annotation", modify: func(sts *v1.StatefulSet) {
+			sts.Annotations = map[string]string{kudo.DependsOnAnnotation: "configmap/foo, Secret/bar"}
+		}, expected: resourceDependencies{
+			resourceDependency{gvk: typeConfigMap, name: "foo", namespace: namespace},
+			resourceDependency{gvk: typeSecret, name: "bar", namespace: namespace},
+		}},
 		{name: "pullSecret", modify: func(sts *v1.StatefulSet) {
 			sts.Spec.Template.Spec.ImagePullSecrets = append(sts.Spec.Template.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: "pullsecret"})
 		}, expected: resourceDependencies{
@@ -180,3 +238,63 @@ func TestCalculateDependencies(t *testing.T) {
 		assert.Equal(t, test.expected, deps, cmp.AllowUnexported(resourceDependency{}))
 	}
 }
+
+func TestCalculateDependencies_dependsOnAnnotation(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		expected   resourceDependencies
+		wantErr    string
+	}{
+		{name: "configmap and secret", annotation: "configmap/foo,secret/bar", expected: resourceDependencies{
+			resourceDependency{gvk: typeConfigMap, name: "foo", namespace: "namespace"},
+			resourceDependency{gvk: typeSecret, name: "bar", namespace: "namespace"},
+		}},
+		{name: "empty entries are ignored", annotation: "configmap/foo,", expected: resourceDependencies{
+			resourceDependency{gvk: typeConfigMap, name: "foo", namespace: "namespace"},
+		}},
+		{name: "unsupported kind", annotation: "deployment/foo", wantErr: `invalid dependency "deployment/foo" in annotation kudo.dev/depends-on, only configmap and secret are supported`},
+		{name: "missing name", annotation: "configmap", wantErr: `invalid dependency "configmap" in annotation kudo.dev/depends-on, expected <kind>/<name>`},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			obj := unstructuredCrd("crd", "namespace").(*unstructured.Unstructured)
+			obj.SetAnnotations(map[string]string{kudo.DependsOnAnnotation: tt.annotation})
+
+			deps, err := calculateResourceDependencies(obj)
+			if tt.wantErr != "" {
+				assert.True(t, errors.Is(err, engine.ErrFatalExecution))
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, deps)
+		})
+	}
+}
+
+func TestCalculateAndSetHash_withoutPodTemplate(t *testing.T) {
+	obj := unstructuredCrd("crd", "namespace").(*unstructured.Unstructured)
+	obj.SetAnnotations(map[string]string{kudo.DependsOnAnnotation: "configmap/configmap"})
+
+	dc := newDependencyCalculator(fake.NewFakeClientWithScheme(scheme.Scheme, configMap("configmap", "namespace")), nil)
+	deps, err := calculateResourceDependencies(obj)
+	assert.NoError(t, err)
+	assert.NoError(t, dc.calculateAndSetHash(obj, deps))
+
+	assert.NotEmpty(t, obj.GetAnnotations()[kudo.DependenciesHashAnnotation])
+	_, found, _ := unstructured.NestedMap(obj.UnstructuredContent(), "spec", "template")
+	assert.False(t, found, "no pod template must be added to the object")
+}
+
+func daemonSet(name string, namespace string) *v1.DaemonSet {
+	return &v1.DaemonSet{
+		TypeMeta:   metav1.TypeMeta{Kind: "DaemonSet", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: v1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "app-type"}}},
+		},
+	}
+}
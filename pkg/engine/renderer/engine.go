@@ -3,6 +3,8 @@ package renderer
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig"
@@ -24,6 +26,15 @@ type Metadata struct {
 	TaskName  string
 }
 
+// Previous contains the state of the instance after the last successfully finished plan. It is available in
+// templates as {{ .Previous.* }}, e.g. to restore previous parameter values in a plan scheduled by Plan.OnFailure.
+type Previous struct {
+	OperatorVersionName string
+	OperatorVersion     string
+	AppVersion          string
+	Params              map[string]interface{}
+}
+
 // Engine is the control struct for parsing and templating Kubernetes resources in an ordered fashion
 type Engine struct {
 	FuncMap template.FuncMap
@@ -39,6 +50,7 @@ func New() *Engine {
 	f := sprig.TxtFuncMap()
 
 	f["toYaml"] = ToYaml
+	f["lookup"] = LookupFunc(noLookup)
 
 	// Prevent environment access inside the running KUDO Controller
 	funcs := []string{"env", "expandenv", "base", "dir", "clean", "ext", "isAbs"}
@@ -52,6 +64,12 @@ func New() *Engine {
 	}
 }
 
+// WithLookup sets the function that templates use to look up live cluster objects. Without it, every lookup fails.
+func (e *Engine) WithLookup(lookup LookupFunc) *Engine {
+	e.FuncMap["lookup"] = lookup
+	return e
+}
+
 // Template provides access to the engines template engine.
 func (e Engine) Template(name string) *template.Template {
 	t := template.New("gotpl")
@@ -71,12 +89,28 @@ func (e *Engine) Render(tplName string, tpl string, vals map[string]interface{})
 	}
 
 	if err := t.ExecuteTemplate(&buf, tplName, vals); err != nil {
-		return "", fmt.Errorf("error rendering template: %s", err)
+		return "", fmt.Errorf("error rendering template: %w", err)
 	}
 
 	return buf.String(), nil
 }
 
+// RenderCondition renders a template that is expected to produce a boolean value, e.g. a 'when' expression like
+// `{{ gt (atoi .Params.NODE_COUNT) 3 }}`. Surrounding whitespace of the rendered value is ignored.
+func (e *Engine) RenderCondition(tplName string, tpl string, vals map[string]interface{}) (bool, error) {
+	rendered, err := e.Render(tplName, tpl, vals)
+	if err != nil {
+		return false, err
+	}
+
+	value := strings.TrimSpace(rendered)
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("expected a boolean value but got %q", value)
+	}
+	return b, nil
+}
+
 // NewVariableMap creates variable map necessary for template rendering
 // it uses a builder pattern to create the desired map of variables
 // for a map of default values `renderer.NewVariableMap().WithDefaults()`
@@ -93,6 +127,12 @@ func (m VariableMap) WithDefaults() VariableMap {
 	m["PlanName"] = "PlanName"
 	m["PhaseName"] = "PhaseName"
 	m["StepName"] = "StepName"
+	m["Previous"] = Previous{
+		OperatorVersionName: "OperatorVersionName",
+		OperatorVersion:     "OperatorVersion",
+		AppVersion:          "AppVersion",
+		Params:              map[string]interface{}{},
+	}
 	return m
 }
 
@@ -142,6 +182,12 @@ func (m VariableMap) WithParameterStrings(parameters map[string]string) Variable
 	return m
 }
 
+// WithPrevious overrides the map with the previous state of the instance
+func (m VariableMap) WithPrevious(previous Previous) VariableMap {
+	m["Previous"] = previous
+	return m
+}
+
 // WithPipes overrides the map with a pipe map
 func (m VariableMap) WithPipes(pipes map[string]string) VariableMap {
 	m["Pipes"] = pipes
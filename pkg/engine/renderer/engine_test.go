@@ -70,3 +70,40 @@ func TestUnsafeFuncs(t *testing.T) {
 	}
 
 }
+
+func TestRenderPrevious(t *testing.T) {
+	engine := New()
+
+	vals := NewVariableMap().
+		WithDefaults().
+		WithPrevious(Previous{OperatorVersion: "1.0", Params: map[string]interface{}{"Replicas": "3"}})
+
+	rendered, err := engine.Render("previous", "{{ .Previous.OperatorVersion }}: {{ .Previous.Params.Replicas }}", vals)
+	if err != nil {
+		t.Errorf("error rendering template: %s", err)
+	}
+
+	if rendered != "1.0: 3" {
+		t.Errorf("template mismatch, expected: %+v, got: %+v", "1.0: 3", rendered)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tpl := `{{ (lookup "v1" "ConfigMap" "default" "config").data.foo }}`
+
+	_, err := New().Render("tpl", tpl, nil)
+	if err == nil {
+		t.Errorf("expected error for lookup without a cluster, got none")
+	}
+
+	engine := New().WithLookup(func(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+		return map[string]interface{}{"data": map[string]interface{}{"foo": kind + " " + namespace + "/" + name}}, nil
+	})
+	rendered, err := engine.Render("tpl", tpl, nil)
+	if err != nil {
+		t.Errorf("error rendering template: %s", err)
+	}
+	if rendered != "ConfigMap default/config" {
+		t.Errorf("template mismatch, expected: ConfigMap default/config, got: %+v", rendered)
+	}
+}
@@ -69,7 +69,7 @@ func (de *DefaultEnhancer) Apply(sourceObjs []runtime.Object, metadata Metadata)
 	}
 
 	if err := de.addDependenciesHashes(unstructuredObjs); err != nil {
-		return nil, fmt.Errorf("failed to add dependencies hash: %v", err)
+		return nil, fmt.Errorf("failed to add dependencies hash: %w", err)
 	}
 
 	// This is pretty important, if we don't convert it to the actual type everything will be Unstructured.
@@ -87,7 +87,7 @@ func (de *DefaultEnhancer) addDependenciesHashes(unstructuredObjs []*unstructure
 	for _, uo := range unstructuredObjs {
 		deps, err := calculateResourceDependencies(uo)
 		if err != nil {
-			return fmt.Errorf("failed to calculate resource dependencies for %s/%s: %v", uo.GetNamespace(), uo.GetName(), err)
+			return fmt.Errorf("failed to calculate resource dependencies for %s/%s: %w", uo.GetNamespace(), uo.GetName(), err)
 		}
 		if !deps.empty() {
 			err = dc.calculateAndSetHash(uo, deps)
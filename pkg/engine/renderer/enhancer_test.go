@@ -184,6 +184,24 @@ func TestEnhancerApply_dependencyHash_noDependencies(t *testing.T) {
 	assert.Nil(t, hash, "Pod template spec annotations contains a dependency hash but no dependencies")
 }
 
+func TestEnhancerApply_dependencyHash_noDependenciesDaemonSet(t *testing.T) {
+	// DaemonSets without dependencies must keep their pod template, otherwise they would roll on a KUDO upgrade
+	ds := daemonSet("daemonset", "default")
+
+	e := &DefaultEnhancer{
+		Scheme:    utils.Scheme(),
+		Discovery: fake.CachedDiscoveryClient(),
+	}
+
+	objs, err := e.Apply([]runtime.Object{ds}, metadata())
+	assert.NoError(t, err)
+	assert.Len(t, objs, 1)
+
+	dsApplied, ok := objs[0].(*appsv1.DaemonSet)
+	assert.True(t, ok, "applied object is no DaemonSet")
+	assert.Equal(t, map[string]string{kudo.OperatorVersionAnnotation: metadata().OperatorVersion}, dsApplied.Spec.Template.Annotations)
+}
+
 func TestEnhancerApply_dependencyHash_unavailableResource(t *testing.T) {
 	// Test that the dependency calculation does not error out on a resource that is NotAvailable at the moment
 
@@ -1,6 +1,27 @@
 package renderer
 
-import "gopkg.in/yaml.v2"
+import (
+	"errors"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LookupFunc fetches a live object from the cluster and returns its content, e.g. in templates
+// `{{ (lookup "v1" "ConfigMap" .Namespace "settings").data.url }}`. An empty name lists all objects of the
+// kind in the namespace, the result then contains the objects as "items". An object that does not exist results in an
+// empty map.
+type LookupFunc func(apiVersion, kind, namespace, name string) (map[string]interface{}, error)
+
+// noLookup is the lookup function of engines that have no access to a cluster
+func noLookup(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+	return nil, errors.New("lookup of cluster objects is not available here")
+}
+
+// EmptyLookup is a lookup function that never finds an object. It can be used to render templates without a
+// cluster, e.g. during package verification.
+func EmptyLookup(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+	return map[string]interface{}{}, nil
+}
 
 // ToYaml takes any value, and returns its YAML representation as a string.
 func ToYaml(v interface{}) (string, error) {
@@ -0,0 +1,87 @@
+package renderer
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"sigs.k8s.io/kustomize/api/filesys"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// KustomizationFileName marks a directory in the templates folder of a package as a kustomization
+	KustomizationFileName = "kustomization.yaml"
+
+	kustomizeTemplatesDir = "/templates"
+	kustomizePatchesDir   = "/patches"
+)
+
+// IsKustomizationFile returns true if the passed template belongs to a kustomization, i.e. one of its parent
+// directories contains a kustomization.yaml
+func IsKustomizationFile(name string, templates map[string]string) bool {
+	for dir := path.Dir(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if _, ok := templates[path.Join(dir, KustomizationFileName)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Kustomize builds the kustomization in the passed directory of the package templates in-process with the kustomize
+// API on an in-memory file system and returns the resulting resources as a multi-document YAML. Only the builtin
+// generators and transformers of kustomize are available. The templates are used as they are, kustomizations can
+// refer to other directories in the templates folder as resources. The patches, usually rendered templates, are
+// applied as strategic merge patches on top of the kustomization.
+func Kustomize(dir string, templates map[string]string, patches map[string]string) (string, error) {
+	dir = path.Clean(dir)
+	if _, ok := templates[path.Join(dir, KustomizationFileName)]; !ok {
+		return "", fmt.Errorf("kustomization %s is missing %s", dir, KustomizationFileName)
+	}
+
+	fSys := filesys.MakeFsInMemory()
+	for name, t := range templates {
+		if err := fSys.WriteFile(path.Join(kustomizeTemplatesDir, name), []byte(t)); err != nil {
+			return "", err
+		}
+	}
+
+	target := path.Join(kustomizeTemplatesDir, dir)
+	if len(patches) > 0 {
+		names := make([]string, 0, len(patches))
+		for name := range patches {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		overlay := map[string]interface{}{
+			"resources":             []string{path.Join("..", target)},
+			"patchesStrategicMerge": names,
+		}
+		for _, name := range names {
+			if err := fSys.WriteFile(path.Join(kustomizePatchesDir, name), []byte(patches[name])); err != nil {
+				return "", err
+			}
+		}
+
+		kustomization, err := yaml.Marshal(overlay)
+		if err != nil {
+			return "", err
+		}
+		if err := fSys.WriteFile(path.Join(kustomizePatchesDir, KustomizationFileName), kustomization); err != nil {
+			return "", err
+		}
+		target = kustomizePatchesDir
+	}
+
+	resources, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fSys, target)
+	if err != nil {
+		return "", fmt.Errorf("error building kustomization %s: %v", dir, err)
+	}
+	out, err := resources.AsYaml()
+	if err != nil {
+		return "", fmt.Errorf("error building kustomization %s: %v", dir, err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,52 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var kustomizeTemplates = map[string]string{
+	"base/kustomization.yaml": "resources:\n- deployment.yaml\n",
+	"base/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  replicas: 1
+`,
+	"overlay/kustomization.yaml": "bases:\n- ../base\nnamePrefix: prod-\ncommonLabels:\n  env: prod\n",
+	"replicas.yaml":              "does not matter",
+}
+
+func TestIsKustomizationFile(t *testing.T) {
+	assert.True(t, IsKustomizationFile("base/kustomization.yaml", kustomizeTemplates))
+	assert.True(t, IsKustomizationFile("base/deployment.yaml", kustomizeTemplates))
+	assert.False(t, IsKustomizationFile("replicas.yaml", kustomizeTemplates))
+}
+
+func TestKustomize(t *testing.T) {
+	out, err := Kustomize("overlay", kustomizeTemplates, map[string]string{
+		"replicas.yaml": "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: app\nspec:\n  replicas: 3\n",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  labels:
+    env: prod
+  name: prod-app
+spec:
+  replicas: 3
+  selector:
+    matchLabels:
+      env: prod
+  template:
+    metadata:
+      labels:
+        env: prod
+`, out)
+
+	_, err = Kustomize("missing", kustomizeTemplates, nil)
+	assert.EqualError(t, err, "kustomization missing is missing kustomization.yaml")
+}
@@ -0,0 +1,29 @@
+package task
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+// AppliedObjects collects the namespaced objects applied by the tasks of a plan execution. They are recorded in the
+// InstanceStatus.AppliedObjects, so that plans with Plan.Prune enabled find the objects they did not apply anymore.
+type AppliedObjects map[kudoapi.AppliedObject]struct{}
+
+// record adds the passed objects. Cluster-scoped objects are not recorded, they can't be attributed to an instance
+// unambiguously and are never pruned. Recording into a nil AppliedObjects is a noop.
+func (a AppliedObjects) record(objs []runtime.Object) {
+	if a == nil {
+		return
+	}
+	for _, obj := range objs {
+		namespace, _ := metadataAccessor.Namespace(obj)
+		name, _ := metadataAccessor.Name(obj)
+		if namespace == "" || name == "" {
+			continue
+		}
+
+		apiVersion, kind := obj.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+		a[kudoapi.AppliedObject{APIVersion: apiVersion, Kind: kind, Namespace: namespace, Name: name}] = struct{}{}
+	}
+}
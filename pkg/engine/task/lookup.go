@@ -0,0 +1,107 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/feature"
+)
+
+// LookupAllowedGVKs are the kinds that templates may look up outside of the instance namespace, e.g. cluster-scoped
+// kinds. Namespaced objects of all other kinds can only be looked up in the namespace of the instance. The manager
+// replaces them with the kinds of the KUDO_LOOKUP_ALLOWED_KINDS environment variable, see ParseLookupAllowedGVKs.
+var LookupAllowedGVKs = map[schema.GroupVersionKind]bool{
+	{Version: "v1", Kind: "Namespace"}:                             true,
+	{Version: "v1", Kind: "Node"}:                                  true,
+	{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}: true,
+}
+
+var secretGroupKind = schema.GroupKind{Kind: "Secret"}
+
+// errLookupFailed is wrapped by the errors of lookups that failed because of the API server. In contrast to other
+// rendering errors these are transient and the task is retried.
+var errLookupFailed = errors.New("lookup failed: ")
+
+// ParseLookupAllowedGVKs parses a comma-separated list of kinds with their API version, e.g.
+// "v1/Namespace,storage.k8s.io/v1/StorageClass". An empty list allows no kinds outside of the instance namespace.
+func ParseLookupAllowedGVKs(kinds string) (map[schema.GroupVersionKind]bool, error) {
+	gvks := map[schema.GroupVersionKind]bool{}
+	for _, k := range strings.Split(kinds, ",") {
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+		i := strings.LastIndex(k, "/")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid kind %q, expected <apiVersion>/<kind>", k)
+		}
+		gv, err := schema.ParseGroupVersion(k[:i])
+		if err != nil || gv.Version == "" || k[i+1:] == "" {
+			return nil, fmt.Errorf("invalid kind %q, expected <apiVersion>/<kind>", k)
+		}
+		gvks[gv.WithKind(k[i+1:])] = true
+	}
+	return gvks, nil
+}
+
+// lookup returns the template function that looks up live objects with the uncached reader of the task context.
+// Lookups are restricted to the instance namespace and the kinds in LookupAllowedGVKs. Secrets can only be looked up
+// if the LookupSecrets feature gate is enabled, as their values would end up in rendered objects and plan diffs.
+func lookup(ctx Context) renderer.LookupFunc {
+	reader := ctx.Reader
+	if reader == nil {
+		reader = ctx.Client
+	}
+
+	return func(apiVersion, kind, namespace, name string) (map[string]interface{}, error) {
+		gv, err := schema.ParseGroupVersion(apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid apiVersion %q: %v", apiVersion, err)
+		}
+		gvk := gv.WithKind(kind)
+
+		if gvk.GroupKind() == secretGroupKind && !feature.DefaultFeatureGate.Enabled(feature.LookupSecrets) {
+			return nil, fmt.Errorf("lookup of %s is not allowed, enable the %s feature gate to look up Secrets", gvk, feature.LookupSecrets)
+		}
+		if namespace != ctx.Meta.InstanceNamespace && !LookupAllowedGVKs[gvk] {
+			return nil, fmt.Errorf("lookup of %s in namespace %q is not allowed, only objects in the instance namespace %q can be looked up", gvk, namespace, ctx.Meta.InstanceNamespace)
+		}
+
+		if name == "" {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gv.WithKind(kind + "List"))
+			if err := reader.List(context.TODO(), list, client.InNamespace(namespace)); err != nil {
+				return nil, fmt.Errorf("%wlisting %s in namespace %q: %v", errLookupFailed, gvk, namespace, err)
+			}
+			return list.UnstructuredContent(), nil
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		err = reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, obj)
+		switch {
+		case apierrors.IsNotFound(err):
+			return map[string]interface{}{}, nil
+		case err != nil:
+			return nil, fmt.Errorf("%wgetting %s %s/%s: %v", errLookupFailed, gvk, namespace, name, err)
+		}
+		return obj.UnstructuredContent(), nil
+	}
+}
+
+// renderingError returns the error of a failed template rendering as a fatal error, unless a lookup failed because of
+// the API server. These errors are returned as they are, so that the task is retried.
+func renderingError(err error, ctx Context) error {
+	if errors.Is(err, errLookupFailed) {
+		return err
+	}
+	return fatalExecutionError(err, taskRenderingError, ctx.Meta)
+}
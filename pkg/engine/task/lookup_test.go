@@ -0,0 +1,125 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/feature"
+)
+
+func TestLookup(t *testing.T) {
+	other := configMap("other", map[string]string{"foo": "other"})
+	other.Namespace = "kube-system"
+	node := &corev1.Node{
+		TypeMeta:   metav1.TypeMeta{Kind: "Node", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"zone": "a"}},
+	}
+
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+		StringData: map[string]string{"password": "secret"},
+	}
+
+	// lookups only use the uncached reader
+	ctx := Context{
+		Client: fake.NewFakeClientWithScheme(scheme.Scheme),
+		Reader: fake.NewFakeClientWithScheme(scheme.Scheme, configMap("config", map[string]string{"foo": "bar"}), other, node, secret),
+		Meta:   renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+		Templates: map[string]string{
+			"instance-namespace": `{{ (lookup "v1" "ConfigMap" .Namespace "config").data.foo }}`,
+			"missing":            `{{ if not (lookup "v1" "ConfigMap" .Namespace "missing") }}missing{{ end }}`,
+			"list":               `{{ range (lookup "v1" "ConfigMap" .Namespace "").items }}{{ .metadata.name }}{{ end }}`,
+			"allowed-kind":       `{{ (lookup "v1" "Node" "" "node-1").metadata.labels.zone }}`,
+			"other-namespace":    `{{ (lookup "v1" "ConfigMap" "kube-system" "other").data.foo }}`,
+			"invalid-apiversion": `{{ lookup "a/b/c" "ConfigMap" .Namespace "config" }}`,
+			"secret":             `{{ (lookup "v1" "Secret" .Namespace "credentials").stringData.password }}`,
+			"secrets":            `{{ range (lookup "v1" "Secret" .Namespace "").items }}{{ .metadata.name }}{{ end }}`,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "instance-namespace", want: "bar"},
+		{name: "missing", want: "missing"},
+		{name: "list", want: "config"},
+		{name: "allowed-kind", want: "a"},
+		{name: "other-namespace", wantErr: true},
+		{name: "invalid-apiversion", wantErr: true},
+		{name: "secret", wantErr: true},
+		{name: "secrets", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := render([]string{tt.name}, ctx)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.False(t, errors.Is(err, errLookupFailed), "a disallowed lookup must not be retried")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got[tt.name])
+		})
+	}
+}
+
+func TestLookup_secrets(t *testing.T) {
+	defer func() {
+		assert.NoError(t, feature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%s=false", feature.LookupSecrets)))
+	}()
+	assert.NoError(t, feature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%s=true", feature.LookupSecrets)))
+
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+		StringData: map[string]string{"password": "secret"},
+	}
+	ctx := Context{
+		Reader:    fake.NewFakeClientWithScheme(scheme.Scheme, secret),
+		Meta:      renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+		Templates: map[string]string{"secret": `{{ (lookup "v1" "Secret" .Namespace "credentials").stringData.password }}`},
+	}
+
+	got, err := render([]string{"secret"}, ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", got["secret"])
+}
+
+func TestParseLookupAllowedGVKs(t *testing.T) {
+	tests := []struct {
+		name    string
+		kinds   string
+		want    map[schema.GroupVersionKind]bool
+		wantErr bool
+	}{
+		{name: "empty", kinds: "", want: map[schema.GroupVersionKind]bool{}},
+		{name: "core and grouped kinds", kinds: "v1/Namespace, storage.k8s.io/v1/StorageClass", want: map[schema.GroupVersionKind]bool{
+			{Version: "v1", Kind: "Namespace"}:                             true,
+			{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}: true,
+		}},
+		{name: "missing api version", kinds: "Namespace", wantErr: true},
+		{name: "missing kind", kinds: "v1/", wantErr: true},
+		{name: "invalid api version", kinds: "a/b/c/Namespace", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLookupAllowedGVKs(tt.kinds)
+		assert.Equal(t, tt.wantErr, err != nil, tt.name)
+		assert.Equal(t, tt.want, got, tt.name)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
@@ -163,6 +164,34 @@ func DownloadFile(fs afero.Fs, file string, pod *v1.Pod, ctrName string, restCfg
 	return nil
 }
 
+// Command runs the passed command in a remote pod and returns its stdout. A command that exits with a non-zero exit
+// code returns an ErrCommandFailed error containing the exit code and the stderr of the command. All other errors
+// (e.g. the API server not being reachable) are returned as they are.
+func Command(args []string, pod *v1.Pod, ctrName string, restCfg *rest.Config) (string, error) {
+	stdout := strings.Builder{}
+	stderr := strings.Builder{}
+
+	pe := PodExec{
+		RestCfg:       restCfg,
+		PodName:       pod.Name,
+		PodNamespace:  pod.Namespace,
+		ContainerName: ctrName,
+		Args:          args,
+		In:            nil,
+		Out:           &stdout,
+		Err:           &stderr,
+	}
+	if err := pe.Run(); err != nil {
+		var exitErr utilexec.ExitError
+		if errors.As(err, &exitErr) && exitErr.Exited() {
+			return stdout.String(), fmt.Errorf("%w%v exited with code %d, stderr: %s", ErrCommandFailed, args, exitErr.ExitStatus(), stderr.String())
+		}
+		return stdout.String(), fmt.Errorf("failed to execute %v in pod %s/%s: %v", args, pod.Namespace, pod.Name, err)
+	}
+
+	return stdout.String(), nil
+}
+
 // untarFile extracts a tar file from the passed reader using passed file name.
 func untarFile(fs afero.Fs, r io.Reader, fileName string) error {
 	tr := tar.NewReader(r)
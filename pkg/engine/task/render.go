@@ -11,15 +11,13 @@ import (
 )
 
 // render method takes resource names and Instance parameters and then renders passed templates using kudo engine.
+// Templates can look up live objects of the cluster with the lookup function.
 func render(resourceNames []string, ctx Context) (map[string]string, error) {
 
-	configs := renderer.NewVariableMap().
-		WithMetadata(ctx.Meta).
-		WithParameters(ctx.Parameters).
-		WithPipes(ctx.Pipes)
+	configs := variables(ctx)
 
 	resources := map[string]string{}
-	engine := renderer.New()
+	engine := renderer.New().WithLookup(lookup(ctx))
 
 	for _, rn := range resourceNames {
 		resource, ok := ctx.Templates[rn]
@@ -38,6 +36,31 @@ func render(resourceNames []string, ctx Context) (map[string]string, error) {
 	return resources, nil
 }
 
+// renderStrings renders the passed templated strings, e.g. the command of an Exec task, in the same way as templates.
+func renderStrings(name string, values []string, ctx Context) ([]string, error) {
+	configs := variables(ctx)
+	engine := renderer.New()
+
+	rendered := make([]string, 0, len(values))
+	for _, v := range values {
+		r, err := engine.Render(name, v, configs)
+		if err != nil {
+			return nil, fmt.Errorf("error expanding %s: %w", name, err)
+		}
+		rendered = append(rendered, r)
+	}
+	return rendered, nil
+}
+
+// variables returns the variables available in task templates
+func variables(ctx Context) renderer.VariableMap {
+	return renderer.NewVariableMap().
+		WithMetadata(ctx.Meta).
+		WithParameters(ctx.Parameters).
+		WithPipes(ctx.Pipes).
+		WithPrevious(ctx.Previous)
+}
+
 // convert takes a map of rendered yaml templates and converts them to k8s objects
 func convert(rendered map[string]string) ([]runtime.Object, error) {
 	objs := make([]runtime.Object, 0, len(rendered))
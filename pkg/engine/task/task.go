@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
@@ -17,7 +18,9 @@ import (
 
 // Context is a engine.task execution context containing k8s client, templates parameters etc.
 type Context struct {
-	Client     client.Client
+	Client client.Client
+	// Reader reads objects without the cache of Client, e.g. for lookups in templates
+	Reader     client.Reader
 	Discovery  discovery.CachedDiscoveryInterface
 	Config     *rest.Config
 	Scheme     *runtime.Scheme
@@ -26,6 +29,13 @@ type Context struct {
 	Templates  map[string]string      // Raw templates
 	Parameters map[string]interface{} // Instance and OperatorVersion parameters merged
 	Pipes      map[string]string      // Pipe artifacts
+	Previous   renderer.Previous      // State of the instance after the last successful plan
+	// SensitiveParameters are the names of the sensitive parameters of the OperatorVersion
+	SensitiveParameters map[string]bool
+	// HealthChecks of the OperatorVersion used to check the health of custom resources
+	HealthChecks []kudoapi.HealthCheck
+	// Applied collects the objects applied by the task, nil if they are not recorded
+	Applied AppliedObjects
 }
 
 // Tasker is an interface that represents any runnable task for an operator. This method is treated
@@ -48,6 +58,11 @@ const (
 	PipeTaskKind         = "Pipe"
 	ToggleTaskKind       = "Toggle"
 	KudoOperatorTaskKind = "KudoOperator"
+	WaitTaskKind         = "Wait"
+	ExecTaskKind         = "Exec"
+	HelmTaskKind         = "Helm"
+	KustomizeTaskKind    = "Kustomize"
+	ExternalTaskKind     = "External"
 )
 
 var (
@@ -57,26 +72,86 @@ var (
 	resourceUnmarshalError  = "ResourceUnmarshalError"
 	resourceValidationError = "ResourceValidationError"
 	failedTerminalState     = "FailedTerminalStateError"
+	serverSideApplyConflict = "ServerSideApplyConflict"
 )
 
+// Factory builds the Tasker of a task, validating the task spec
+type Factory func(task *kudoapi.Task) (Tasker, error)
+
+var (
+	builtins = map[string]Factory{
+		ApplyTaskKind:        newApply,
+		DeleteTaskKind:       newDelete,
+		DummyTaskKind:        newDummy,
+		PipeTaskKind:         newPipe,
+		ToggleTaskKind:       newToggle,
+		KudoOperatorTaskKind: newKudoOperator,
+		WaitTaskKind:         newWait,
+		ExecTaskKind:         newExec,
+		HelmTaskKind:         newHelm,
+		KustomizeTaskKind:    newKustomize,
+		ExternalTaskKind:     newExternal,
+	}
+
+	registryMu sync.RWMutex
+	registry   = map[string]Factory{}
+)
+
+// Register makes a custom task kind available to Build, and thus to the plan execution engine and the package
+// verifiers of the same process. It is meant for projects embedding KUDO and is usually called from an init
+// function. Built-in kinds can't be overridden and a kind can only be registered once. The Factory should validate
+// the task spec (custom kinds can use ExternalTaskSpec.Config for their configuration), as its errors are reported
+// by the package verifiers.
+func Register(kind string, factory Factory) error {
+	if kind == "" {
+		return errors.New("task kind must not be empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("task kind %s has no factory", kind)
+	}
+	if _, ok := builtins[kind]; ok {
+		return fmt.Errorf("task kind %s is a built-in kind", kind)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := registry[kind]; ok {
+		return fmt.Errorf("task kind %s is already registered", kind)
+	}
+	registry[kind] = factory
+	return nil
+}
+
+// Unregister removes a task kind registered with Register, e.g. in the cleanup of a test. Built-in kinds and kinds
+// that are not registered are ignored.
+func Unregister(kind string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	delete(registry, kind)
+}
+
+// IsRegistered returns true if the kind was registered with Register
+func IsRegistered(kind string) bool {
+	_, ok := registered(kind)
+	return ok
+}
+
+func registered(kind string) (Factory, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	factory, ok := registry[kind]
+	return factory, ok
+}
+
 // Build factory method takes an kudoapi.Task and returns a corresponding Tasker object
 func Build(task *kudoapi.Task) (Tasker, error) {
-	switch task.Kind {
-	case ApplyTaskKind:
-		return newApply(task)
-	case DeleteTaskKind:
-		return newDelete(task)
-	case DummyTaskKind:
-		return newDummy(task)
-	case PipeTaskKind:
-		return newPipe(task)
-	case ToggleTaskKind:
-		return newToggle(task)
-	case KudoOperatorTaskKind:
-		return newKudoOperator(task)
-	default:
-		return nil, fmt.Errorf("unknown task kind %s", task.Kind)
+	if factory, ok := builtins[task.Kind]; ok {
+		return factory(task)
 	}
+	if factory, ok := registered(task.Kind); ok {
+		return factory(task)
+	}
+	return nil, fmt.Errorf("unknown task kind %s", task.Kind)
 }
 
 func newApply(task *kudoapi.Task) (Tasker, error) {
@@ -86,8 +161,9 @@ func newApply(task *kudoapi.Task) (Tasker, error) {
 	}
 
 	return ApplyTask{
-		Name:      task.Name,
-		Resources: task.Spec.ResourceTaskSpec.Resources,
+		Name:            task.Name,
+		Resources:       task.Spec.ResourceTaskSpec.Resources,
+		ServerSideApply: task.Spec.ApplyTaskSpec.ServerSideApply,
 	}, nil
 }
 
@@ -206,3 +282,99 @@ func newKudoOperator(task *kudoapi.Task) (Tasker, error) {
 		ParameterFile:   task.Spec.KudoOperatorTaskSpec.ParameterFile,
 	}, nil
 }
+
+func newWait(task *kudoapi.Task) (Tasker, error) {
+	// validate WaitTask
+	if len(task.Spec.ResourceTaskSpec.Resources) == 0 {
+		return nil, fmt.Errorf("task validation error: wait task '%s' has an empty resource list", task.Name)
+	}
+
+	condition, err := ParseWaitCondition(task.Spec.WaitTaskSpec.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("task validation error: wait task '%s' has an invalid condition: %v", task.Name, err)
+	}
+
+	return WaitTask{
+		Name:      task.Name,
+		Resources: task.Spec.ResourceTaskSpec.Resources,
+		Condition: condition,
+	}, nil
+}
+
+func newExec(task *kudoapi.Task) (Tasker, error) {
+	// validate ExecTask
+	spec := task.Spec.ExecTaskSpec
+	if spec.PodSelector == "" {
+		return nil, fmt.Errorf("task validation error: exec task '%s' has an empty pod selector", task.Name)
+	}
+
+	if len(spec.Command) == 0 {
+		return nil, fmt.Errorf("task validation error: exec task '%s' has an empty command", task.Name)
+	}
+
+	switch spec.FailurePolicy {
+	case "", kudoapi.ExecFailurePolicyFatal, kudoapi.ExecFailurePolicyRetry:
+	default:
+		return nil, fmt.Errorf("task validation error: exec task '%s' has an invalid failure policy %q (must be %s or %s)", task.Name, spec.FailurePolicy, kudoapi.ExecFailurePolicyFatal, kudoapi.ExecFailurePolicyRetry)
+	}
+
+	var output *PipeFile
+	if spec.Output != nil {
+		output = &PipeFile{File: execOutputFile, Kind: PipeFileKind(spec.Output.Kind), Key: spec.Output.Key}
+		if err := validPipeFile(*output); err != nil {
+			return nil, err
+		}
+	}
+
+	return ExecTask{
+		Name:          task.Name,
+		PodSelector:   spec.PodSelector,
+		Container:     spec.Container,
+		Command:       spec.Command,
+		FailurePolicy: spec.FailurePolicy,
+		Output:        output,
+	}, nil
+}
+
+func newHelm(task *kudoapi.Task) (Tasker, error) {
+	// validate HelmTask
+	if task.Spec.HelmTaskSpec.Chart == "" {
+		return nil, fmt.Errorf("task validation error: helm task '%s' has no chart", task.Name)
+	}
+
+	return HelmTask{
+		Name:       task.Name,
+		Chart:      task.Spec.HelmTaskSpec.Chart,
+		ValuesFile: task.Spec.HelmTaskSpec.ValuesFile,
+	}, nil
+}
+
+func newKustomize(task *kudoapi.Task) (Tasker, error) {
+	// validate KustomizeTask
+	if task.Spec.KustomizeTaskSpec.Kustomization == "" {
+		return nil, fmt.Errorf("task validation error: kustomize task '%s' has no kustomization", task.Name)
+	}
+
+	return KustomizeTask{
+		Name:          task.Name,
+		Kustomization: task.Spec.KustomizeTaskSpec.Kustomization,
+		Patches:       task.Spec.KustomizeTaskSpec.Patches,
+	}, nil
+}
+
+func newExternal(task *kudoapi.Task) (Tasker, error) {
+	// validate ExternalTask
+	if task.Spec.ExternalTaskSpec.URL == "" {
+		return nil, fmt.Errorf("task validation error: external task '%s' has no url", task.Name)
+	}
+	if err := validatePluginURL(task.Spec.ExternalTaskSpec.URL); err != nil {
+		return nil, fmt.Errorf("task validation error: external task '%s' %v", task.Name, err)
+	}
+
+	return ExternalTask{
+		Name:                task.Name,
+		URL:                 task.Spec.ExternalTaskSpec.URL,
+		Config:              task.Spec.ExternalTaskSpec.Config,
+		SensitiveParameters: task.Spec.ExternalTaskSpec.SensitiveParameters,
+	}, nil
+}
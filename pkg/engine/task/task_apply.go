@@ -23,14 +23,16 @@ import (
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/engine"
 	"github.com/kudobuilder/kudo/pkg/engine/resource"
+	"github.com/kudobuilder/kudo/pkg/feature"
 	"github.com/kudobuilder/kudo/pkg/kubernetes/status"
 	"github.com/kudobuilder/kudo/pkg/util/kudo"
 )
 
 // ApplyTask will apply a set of given resources to the cluster. See Run method for more details.
 type ApplyTask struct {
-	Name      string
-	Resources []string
+	Name            string
+	Resources       []string
+	ServerSideApply *bool
 }
 
 var (
@@ -41,32 +43,32 @@ var (
 // creates runtime objects and enhances them, and applies them using the controller client. Finally,
 // resources are checked for health.
 func (at ApplyTask) Run(ctx Context) (bool, error) {
-	// 1. - Render task templates -
-	rendered, err := render(at.Resources, ctx)
-	if err != nil {
-		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
-	}
-
-	// 2. - Convert to objects
-	objs, err := convert(rendered)
-	if err != nil {
-		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
-	}
-
-	// 2. - Enhance them with metadata -
-	enhanced, err := enhance(objs, ctx.Meta, ctx.Enhancer)
+	// 1. - Render task templates, convert and enhance them with metadata -
+	enhanced, err := at.enhancedResources(ctx)
 	if err != nil {
 		return false, err
 	}
 
 	// 3. - Apply them using the client -
-	applied, err := applyResources(enhanced, ctx)
+	var applied []runtime.Object
+	if at.useServerSideApply() {
+		applied, err = serverSideApplyResources(enhanced, ctx)
+	} else {
+		applied, err = applyResources(enhanced, ctx)
+	}
 	if err != nil {
 		return false, err
 	}
+	ctx.Applied.record(applied)
 
 	// 4. - Check health for all resources -
-	err = isHealthy(applied)
+	return checkHealth(applied, ctx)
+}
+
+// checkHealth returns true if all applied resources are healthy. Resources that failed terminally result in a
+// fatal error, all other health check errors are not treated as task execution errors.
+func checkHealth(applied []runtime.Object, ctx Context) (bool, error) {
+	err := isHealthy(applied, ctx.HealthChecks)
 	if err != nil {
 		if errors.Is(err, engine.ErrFatalExecution) {
 			return false, fatalExecutionError(err, failedTerminalState, ctx.Meta)
@@ -78,6 +80,31 @@ func (at ApplyTask) Run(ctx Context) (bool, error) {
 	return true, nil
 }
 
+// enhancedResources renders the task templates using context parameters, converts them to runtime objects and
+// enhances them with KUDO metadata.
+func (at ApplyTask) enhancedResources(ctx Context) ([]runtime.Object, error) {
+	rendered, err := render(at.Resources, ctx)
+	if err != nil {
+		return nil, renderingError(err, ctx)
+	}
+
+	objs, err := convert(rendered)
+	if err != nil {
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	return enhance(objs, ctx.Meta, ctx.Enhancer)
+}
+
+// useServerSideApply returns whether the task applies its resources server-side. Tasks without an explicit
+// setting follow the ServerSideApply feature gate.
+func (at ApplyTask) useServerSideApply() bool {
+	if at.ServerSideApply != nil {
+		return *at.ServerSideApply
+	}
+	return feature.DefaultFeatureGate.Enabled(feature.ServerSideApply)
+}
+
 func addLastAppliedConfigAnnotation(r runtime.Object) error {
 	// Serialize object
 	rSer, err := json.Marshal(r)
@@ -148,24 +175,108 @@ func applyResources(rr []runtime.Object, ctx Context) ([]runtime.Object, error)
 	return applied, nil
 }
 
+// serverSideApplyResources applies a slice of k8s objects using server-side apply with KUDO's field manager. Objects
+// that were previously applied client-side are migrated by removing the last applied configuration annotation first.
+// The fields of these objects are owned by the field manager of the client-side patches, so the first server-side
+// apply forces their ownership. All other conflicts with other field managers are not forced but returned as fatal
+// errors.
+func serverSideApplyResources(rr []runtime.Object, ctx Context) ([]runtime.Object, error) {
+	applied := make([]runtime.Object, 0)
+
+	for _, r := range rr {
+		key, err := resource.ObjectKeyFromObject(r, ctx.Discovery)
+		if err != nil {
+			return nil, err
+		}
+		gvk := r.GetObjectKind().GroupVersionKind()
+		opts := []client.PatchOption{client.FieldOwner(kudo.FieldManager)}
+
+		existing := r.DeepCopyObject()
+		err = ctx.Client.Get(context.TODO(), key, existing)
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return nil, err
+		case hasLastAppliedConfigAnnotation(existing):
+			if err := removeLastAppliedConfigAnnotation(existing, ctx); err != nil {
+				return nil, fmt.Errorf("failed to migrate a %s %s to server-side apply: %w", gvk, key, err)
+			}
+			opts = append(opts, client.ForceOwnership)
+		}
+
+		err = ctx.Client.Patch(context.TODO(), r, client.Apply, opts...)
+		// see applyResources on why the GVK is re-set here
+		r.GetObjectKind().SetGroupVersionKind(gvk)
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				return nil, fatalExecutionError(
+					fmt.Errorf("failed to apply a %s %s: fields are owned by another field manager than %s: %v", gvk, key, kudo.FieldManager, err),
+					serverSideApplyConflict, ctx.Meta)
+			}
+			return nil, fmt.Errorf("failed to apply a %s %s: %w", gvk, key, err)
+		}
+		applied = append(applied, r)
+	}
+
+	return applied, nil
+}
+
+// removeLastAppliedConfigAnnotation removes the annotation used for client-side three-way merges from an existing
+// object, if it is set. The annotation may otherwise exceed the size limit of annotations for big objects and is
+// stale as soon as an object is applied server-side.
+func removeLastAppliedConfigAnnotation(obj runtime.Object, ctx Context) error {
+	if !hasLastAppliedConfigAnnotation(obj) {
+		return nil
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, kudo.LastAppliedConfigAnnotation)
+	return ctx.Client.Patch(context.TODO(), obj, client.RawPatch(types.MergePatchType, []byte(patch)))
+}
+
+// hasLastAppliedConfigAnnotation returns true if an object was applied client-side
+func hasLastAppliedConfigAnnotation(obj runtime.Object) bool {
+	annotations, err := metadataAccessor.Annotations(obj)
+	if err != nil {
+		return false
+	}
+	_, ok := annotations[kudo.LastAppliedConfigAnnotation]
+	return ok
+}
+
 func patchResource(modifiedObj, currentObj runtime.Object, ctx Context) error {
+	patchType, patchData, err := threeWayMergePatch(modifiedObj, currentObj, ctx)
+	if err != nil {
+		return err
+	}
+
+	// Execute the patchResource
+	err = ctx.Client.Patch(context.TODO(), modifiedObj, client.RawPatch(patchType, patchData))
+	if err != nil {
+		return fmt.Errorf("failed to execute patch: %v", err)
+	}
+	return nil
+}
+
+// threeWayMergePatch creates the patch that updates the current object to the modified one, taking the last applied
+// configuration of the current object into account.
+func threeWayMergePatch(modifiedObj, currentObj runtime.Object, ctx Context) (types.PatchType, []byte, error) {
 
 	// Serialize current configuration
 	current, err := json.Marshal(currentObj)
 	if err != nil {
-		return fatalExecutionError(fmt.Errorf("failed to marshal current %v", err), taskRenderingError, ctx.Meta)
+		return "", nil, fatalExecutionError(fmt.Errorf("failed to marshal current %v", err), taskRenderingError, ctx.Meta)
 	}
 
 	// Get previous configuration from currentObjs annotation
 	original, err := getOriginalConfiguration(currentObj)
 	if err != nil {
-		return fatalExecutionError(fmt.Errorf("failed to get original configuration %v", err), taskRenderingError, ctx.Meta)
+		return "", nil, fatalExecutionError(fmt.Errorf("failed to get original configuration %v", err), taskRenderingError, ctx.Meta)
 	}
 
 	// Get new (modified) configuration
 	modified, err := getModifiedConfiguration(modifiedObj, true, unstructured.UnstructuredJSONScheme)
 	if err != nil {
-		return fatalExecutionError(fmt.Errorf("failed to get modified config %v", err), taskRenderingError, ctx.Meta)
+		return "", nil, fatalExecutionError(fmt.Errorf("failed to get modified config %v", err), taskRenderingError, ctx.Meta)
 	}
 
 	// Create the actual patchResource
@@ -181,15 +292,9 @@ func patchResource(modifiedObj, currentObj runtime.Object, ctx Context) error {
 
 	if err != nil {
 		// TODO: We could try to delete/create here, but that would be different behavior from before
-		return fatalExecutionError(fmt.Errorf("failed to create patch: %v", err), taskRenderingError, ctx.Meta)
+		return "", nil, fatalExecutionError(fmt.Errorf("failed to create patch: %v", err), taskRenderingError, ctx.Meta)
 	}
-
-	// Execute the patchResource
-	err = ctx.Client.Patch(context.TODO(), modifiedObj, client.RawPatch(patchType, patchData))
-	if err != nil {
-		return fmt.Errorf("failed to execute patch: %v", err)
-	}
-	return nil
+	return patchType, patchData, nil
 }
 
 func useSimpleThreeWayMerge(newObj runtime.Object) bool {
@@ -239,9 +344,9 @@ func strategicThreeWayMergePatch(r runtime.Object, original, modified, current [
 	return patchData, nil
 }
 
-func isHealthy(ro []runtime.Object) error {
+func isHealthy(ro []runtime.Object, checks []kudoapi.HealthCheck) error {
 	for _, r := range ro {
-		err := isResourceHealthy(r)
+		err := isResourceHealthy(r, checks)
 		if err != nil {
 			key, _ := client.ObjectKeyFromObject(r) // err not possible as all runtime.Objects have metadata
 			return fmt.Errorf("object %s/%s is NOT healthy: %w", key.Namespace, key.Name, err)
@@ -250,8 +355,8 @@ func isHealthy(ro []runtime.Object) error {
 	return nil
 }
 
-func isResourceHealthy(obj runtime.Object) error {
-	healthy, msg, err := status.IsHealthy(obj)
+func isResourceHealthy(obj runtime.Object, checks []kudoapi.HealthCheck) error {
+	healthy, msg, err := status.IsHealthyWithChecks(obj, checks)
 	if err != nil {
 		return err
 	}
@@ -277,7 +382,8 @@ func isResourceHealthy(obj runtime.Object) error {
 // copy from k8s.io/kubectl@v0.16.6/pkg/util/apply.go, with adjustments
 // GetOriginalConfiguration retrieves the original configuration of the object
 // from the annotation.
-// returns an error if the annotation is nil or invalid JSON
+// returns an error if the annotation is invalid JSON. Objects without the annotation,
+// e.g. objects previously applied server-side, have no original configuration.
 func getOriginalConfiguration(obj runtime.Object) ([]byte, error) {
 	annots, err := metadataAccessor.Annotations(obj)
 	if err != nil {
@@ -290,7 +396,7 @@ func getOriginalConfiguration(obj runtime.Object) ([]byte, error) {
 
 	original, ok := annots[kudo.LastAppliedConfigAnnotation]
 	if !ok {
-		return nil, fmt.Errorf("LastAppliedConfigAnnotation is not set")
+		return nil, nil
 	}
 
 	originalBytes := []byte(original)
@@ -1,6 +1,7 @@
 package task
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -8,15 +9,22 @@ import (
 	"github.com/stretchr/testify/assert"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/yaml"
 
 	"github.com/kudobuilder/kudo/pkg/engine"
 	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/feature"
 	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+	utilconvert "github.com/kudobuilder/kudo/pkg/util/convert"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
 )
 
 func TestApplyTask_Run(t *testing.T) {
@@ -158,6 +166,153 @@ func TestApplyTask_Run(t *testing.T) {
 	}
 }
 
+func TestApplyTask_useServerSideApply(t *testing.T) {
+	defer func() {
+		assert.NoError(t, feature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%s=false", feature.ServerSideApply)))
+	}()
+
+	tests := []struct {
+		name            string
+		gate            bool
+		serverSideApply *bool
+		want            bool
+	}{
+		{name: "defaults to the disabled feature gate", gate: false, want: false},
+		{name: "defaults to the enabled feature gate", gate: true, want: true},
+		{name: "task opts in", gate: false, serverSideApply: utilconvert.BoolPtr(true), want: true},
+		{name: "task opts out", gate: true, serverSideApply: utilconvert.BoolPtr(false), want: false},
+	}
+
+	for _, tt := range tests {
+		assert.NoError(t, feature.DefaultMutableFeatureGate.Set(fmt.Sprintf("%s=%t", feature.ServerSideApply, tt.gate)))
+		at := ApplyTask{Name: "task", ServerSideApply: tt.serverSideApply}
+		assert.Equal(t, tt.want, at.useServerSideApply(), tt.name)
+	}
+}
+
+func TestRemoveLastAppliedConfigAnnotation(t *testing.T) {
+	withAnnotation := pod("pod1", "default")
+	withAnnotation.Annotations = map[string]string{kudo.LastAppliedConfigAnnotation: "{}", "foo": "bar"}
+	withoutAnnotation := pod("pod2", "default")
+
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, withAnnotation, withoutAnnotation)
+	ctx := Context{Client: c}
+
+	for _, p := range []*corev1.Pod{withAnnotation, withoutAnnotation} {
+		assert.NoError(t, removeLastAppliedConfigAnnotation(p.DeepCopy(), ctx))
+
+		got := &corev1.Pod{}
+		assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Namespace: p.Namespace, Name: p.Name}, got))
+		assert.NotContains(t, got.Annotations, kudo.LastAppliedConfigAnnotation)
+		assert.Equal(t, p.Annotations["foo"], got.Annotations["foo"])
+	}
+}
+
+func TestApplyTask_Run_withoutLastAppliedConfiguration(t *testing.T) {
+	// objects previously applied server-side have no last applied configuration and can still be patched client-side
+	existing := pod("pod1", "default")
+	existing.Labels = map[string]string{"foo": "baz"}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, existing)
+
+	updated := pod("pod1", "default")
+	updated.Labels = map[string]string{"foo": "bar"}
+
+	at := ApplyTask{Name: "task", Resources: []string{"pod"}, ServerSideApply: utilconvert.BoolPtr(false)}
+	done, err := at.Run(Context{
+		Client:    c,
+		Discovery: kudofake.CachedDiscoveryClient(),
+		Enhancer:  &testEnhancer{},
+		Meta:      renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+		Templates: map[string]string{"pod": resourceAsString(updated)},
+	})
+	assert.NoError(t, err)
+	assert.True(t, done)
+
+	got := &corev1.Pod{}
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pod1"}, got))
+	assert.Equal(t, "bar", got.Labels["foo"])
+	assert.Contains(t, got.Annotations, kudo.LastAppliedConfigAnnotation)
+}
+
+func TestServerSideApplyResources(t *testing.T) {
+	migrated := pod("pod1", "default")
+	migrated.Annotations = map[string]string{kudo.LastAppliedConfigAnnotation: "{}"}
+
+	tests := []struct {
+		name       string
+		existing   []runtime.Object
+		conflict   bool
+		wantForced []bool
+		wantErr    bool
+	}{
+		{name: "creates an object", wantForced: []bool{false}},
+		{name: "updates an object applied server-side", existing: []runtime.Object{pod("pod1", "default")}, wantForced: []bool{false}},
+		{name: "fails on conflicts of an object applied server-side", existing: []runtime.Object{pod("pod1", "default")}, conflict: true, wantForced: []bool{false}, wantErr: true},
+		{name: "forces the ownership of an object applied client-side", existing: []runtime.Object{migrated}, conflict: true, wantForced: []bool{true}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := &serverSideApplyClient{Client: fake.NewFakeClientWithScheme(scheme.Scheme, tt.existing...), conflict: tt.conflict}
+			desired := pod("pod1", "default")
+			desired.Labels = map[string]string{"foo": "bar"}
+
+			_, err := serverSideApplyResources([]runtime.Object{desired}, Context{Client: c, Discovery: kudofake.CachedDiscoveryClient()})
+			assert.Equal(t, tt.wantForced, c.forced)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, engine.ErrFatalExecution))
+				return
+			}
+			assert.NoError(t, err)
+
+			got := &corev1.Pod{}
+			assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "pod1"}, got))
+			assert.Equal(t, "bar", got.Labels["foo"])
+			assert.NotContains(t, got.Annotations, kudo.LastAppliedConfigAnnotation)
+		})
+	}
+}
+
+func TestGetOriginalConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []byte
+		wantErr     bool
+	}{
+		{name: "no annotations"},
+		{name: "applied server-side", annotations: map[string]string{"foo": "bar"}},
+		{name: "applied client-side", annotations: map[string]string{kudo.LastAppliedConfigAnnotation: `{"foo":"bar"}`}, want: []byte(`{"foo":"bar"}`)},
+		{name: "invalid annotation", annotations: map[string]string{kudo.LastAppliedConfigAnnotation: "{"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		p := pod("pod1", "default")
+		p.Annotations = tt.annotations
+
+		got, err := getOriginalConfiguration(p)
+		assert.Equal(t, tt.want, got, tt.name)
+		assert.Equal(t, tt.wantErr, err != nil, tt.name)
+	}
+}
+
+func TestApplyTask_Run_recordsAppliedObjects(t *testing.T) {
+	applied := AppliedObjects{}
+	at := ApplyTask{Name: "task", Resources: []string{"pod"}}
+	_, err := at.Run(Context{
+		Client:    fake.NewFakeClientWithScheme(scheme.Scheme),
+		Discovery: kudofake.CachedDiscoveryClient(),
+		Enhancer:  &testEnhancer{},
+		Meta:      renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+		Templates: map[string]string{"pod": resourceAsString(pod("pod1", "default"))},
+		Applied:   applied,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, AppliedObjects{{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod1"}: {}}, applied)
+}
+
 func pod(name string, namespace string) *corev1.Pod { //nolint:unparam
 	pod := &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
@@ -199,6 +354,53 @@ func resourceAsString(resource metav1.Object) string {
 	return string(bytes)
 }
 
+// serverSideApplyClient handles apply patches, which the fake client doesn't support, by creating or replacing the
+// object. Conflicts are simulated for all existing objects if conflict is set and the patch is not forced.
+type serverSideApplyClient struct {
+	client.Client
+	conflict bool
+	forced   []bool
+}
+
+func (c *serverSideApplyClient) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+
+	po := (&client.PatchOptions{}).ApplyOptions(opts)
+	force := po.Force != nil && *po.Force
+	c.forced = append(c.forced, force)
+
+	key, err := client.ObjectKeyFromObject(obj)
+	if err != nil {
+		return err
+	}
+	existing := obj.DeepCopyObject()
+	err = c.Client.Get(ctx, key, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		if len(po.DryRun) > 0 {
+			return nil
+		}
+		return c.Client.Create(ctx, obj)
+	case err != nil:
+		return err
+	case c.conflict && !force:
+		return apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, key.Name, errors.New("conflict with \"manager\""))
+	case len(po.DryRun) > 0:
+		return nil
+	}
+
+	rv, err := metadataAccessor.ResourceVersion(existing)
+	if err != nil {
+		return err
+	}
+	if err := metadataAccessor.SetResourceVersion(obj, rv); err != nil {
+		return err
+	}
+	return c.Client.Update(ctx, obj)
+}
+
 type testEnhancer struct{}
 
 func (k *testEnhancer) Apply(objs []runtime.Object, metadata renderer.Metadata) ([]runtime.Object, error) {
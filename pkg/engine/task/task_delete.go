@@ -24,38 +24,41 @@ type DeleteTask struct {
 // Run method for the DeleteTask. Given the task context, it renders the templates using context parameters
 // creates runtime objects and enhances them, and finally removes them using the controller client.
 func (dt DeleteTask) Run(ctx Context) (bool, error) {
-	// 1. - Render task templates -
-	rendered, err := render(dt.Resources, ctx)
+	// 1. - Render task templates, convert them, filter unknown objects and enhance them -
+	objs, err := dt.enhancedResources(ctx)
 	if err != nil {
-		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+		return false, err
 	}
 
-	// 2. - Convert to objs
-	objs, err := convert(rendered)
+	// 2. - Delete them using the client -
+	err = deleteResource(objs, ctx.Client)
 	if err != nil {
-		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+		return false, err
 	}
 
-	// 3. - Filter unknown objects
-	objs, err = filterUnknownObjectTypes(objs, ctx)
+	// 3. - Check health - wait for object deletion
+	return allObjsDeleted(ctx, objs)
+}
+
+// enhancedResources renders the task templates using context parameters and converts them to runtime objects.
+// Objects of unknown types are filtered out and the remaining ones are enhanced, which is required for namespaces.
+func (dt DeleteTask) enhancedResources(ctx Context) ([]runtime.Object, error) {
+	rendered, err := render(dt.Resources, ctx)
 	if err != nil {
-		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+		return nil, renderingError(err, ctx)
 	}
 
-	// 4. - Enhance objects, required for namespaces
-	objs, err = ctx.Enhancer.Apply(objs, ctx.Meta)
+	objs, err := convert(rendered)
 	if err != nil {
-		return false, err
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
 	}
 
-	// 5. - Delete them using the client -
-	err = deleteResource(objs, ctx.Client)
+	objs, err = filterUnknownObjectTypes(objs, ctx)
 	if err != nil {
-		return false, err
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
 	}
 
-	// 6. - Check health - wait for object deletion
-	return allObjsDeleted(ctx, objs)
+	return ctx.Enhancer.Apply(objs, ctx.Meta)
 }
 
 func allObjsDeleted(ctx Context, objs []runtime.Object) (bool, error) {
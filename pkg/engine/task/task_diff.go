@@ -0,0 +1,270 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kudobuilder/kudo/pkg/engine/resource"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
+)
+
+// DiffAction describes what executing a task would do with a single object
+type DiffAction string
+
+const (
+	DiffCreate    DiffAction = "create"
+	DiffPatch     DiffAction = "patch"
+	DiffDelete    DiffAction = "delete"
+	DiffUnchanged DiffAction = "unchanged"
+)
+
+// ObjectDiff is the change a task would make to a single object. Live and Desired are the YAML representations of the
+// object before and after the change. They are empty if the object does not exist before or after the change.
+type ObjectDiff struct {
+	Action  DiffAction
+	Object  string
+	Live    string
+	Desired string
+}
+
+// Differ is implemented by tasks that can compute the changes they would make to the cluster without making them
+type Differ interface {
+	Diff(ctx Context) ([]ObjectDiff, error)
+}
+
+var (
+	_ Differ = ApplyTask{}
+	_ Differ = DeleteTask{}
+	_ Differ = ToggleTask{}
+	_ Differ = HelmTask{}
+	_ Differ = KustomizeTask{}
+)
+
+// Diff method for the ApplyTask. It renders and enhances the resources the same way Run does and computes the patch
+// Run would send for each of them against the live object. The patch is applied locally, objects applied server-side
+// are applied in dry-run mode. The cluster is not modified.
+func (at ApplyTask) Diff(ctx Context) ([]ObjectDiff, error) {
+	enhanced, err := at.enhancedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return diffResources(enhanced, at.useServerSideApply(), ctx)
+}
+
+// Diff method for the HelmTask. It renders the chart the same way Run does and diffs the resulting resources like an
+// ApplyTask.
+func (ht HelmTask) Diff(ctx Context) ([]ObjectDiff, error) {
+	enhanced, err := ht.enhancedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return diffResources(enhanced, false, ctx)
+}
+
+// Diff method for the KustomizeTask. It builds the kustomization the same way Run does and diffs the resulting
+// resources like an ApplyTask.
+func (kt KustomizeTask) Diff(ctx Context) ([]ObjectDiff, error) {
+	enhanced, err := kt.enhancedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return diffResources(enhanced, false, ctx)
+}
+
+// diffResources computes the patch that applying the enhanced resources would send for each of them against the
+// live object. The patch is applied locally, or in dry-run mode when the resources are applied server-side.
+func diffResources(enhanced []runtime.Object, serverSideApply bool, ctx Context) ([]ObjectDiff, error) {
+	diffs := make([]ObjectDiff, 0, len(enhanced))
+	for _, r := range enhanced {
+		live, name, err := liveObject(r, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if live == nil {
+			u, err := asUnstructured(r)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, ObjectDiff{Action: DiffCreate, Object: name, Desired: diffYAML(u)})
+			continue
+		}
+
+		var patched []byte
+		if serverSideApply {
+			patched, err = dryRunServerSideApply(r, hasLastAppliedConfigAnnotation(live), ctx)
+		} else {
+			patched, err = locallyPatched(r, live, ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute the patch of a %s: %w", name, err)
+		}
+
+		desired := &unstructured.Unstructured{}
+		if err := desired.UnmarshalJSON(patched); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the patched %s: %w", name, err)
+		}
+
+		d := ObjectDiff{Action: DiffPatch, Object: name, Live: diffYAML(live), Desired: diffYAML(desired)}
+		if d.Live == d.Desired {
+			d.Action = DiffUnchanged
+		}
+		diffs = append(diffs, d)
+	}
+	sortByObject(diffs)
+	return diffs, nil
+}
+
+// Diff method for the DeleteTask. It returns a deletion for every rendered object that exists in the cluster.
+func (dt DeleteTask) Diff(ctx Context) ([]ObjectDiff, error) {
+	objs, err := dt.enhancedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]ObjectDiff, 0, len(objs))
+	for _, r := range objs {
+		live, name, err := liveObject(r, ctx)
+		if err != nil {
+			return nil, err
+		}
+		if live == nil {
+			continue
+		}
+
+		diffs = append(diffs, ObjectDiff{Action: DiffDelete, Object: name, Live: diffYAML(live)})
+	}
+	sortByObject(diffs)
+	return diffs, nil
+}
+
+// Diff method for the ToggleTask. It diffs the Apply or Delete task the ToggleTask delegates to.
+func (tt ToggleTask) Diff(ctx Context) ([]ObjectDiff, error) {
+	task, err := tt.delegateTask(ctx)
+	if err != nil {
+		return nil, fatalExecutionError(err, toggleTaskError, ctx.Meta)
+	}
+	return task.(Differ).Diff(ctx) // Apply and Delete tasks are always Differs
+}
+
+// liveObject returns the live version of the passed object and a description of it. If the object doesn't exist,
+// nil is returned. The live object is always unstructured, so that no fields of the passed object leak into it.
+func liveObject(r runtime.Object, ctx Context) (*unstructured.Unstructured, string, error) {
+	key, err := resource.ObjectKeyFromObject(r, ctx.Discovery)
+	if err != nil {
+		return nil, "", err
+	}
+
+	gvk := r.GetObjectKind().GroupVersionKind()
+	name := objectName(gvk.Kind, key.Namespace, key.Name)
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(gvk)
+	err = ctx.Client.Get(context.TODO(), key, live)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, name, nil
+	case err != nil:
+		return nil, name, fmt.Errorf("failed to get a %s: %w", name, err)
+	}
+	return live, name, nil
+}
+
+// DeletionDiff returns the deletion of a live object, e.g. of an object pruned by a plan
+func DeletionDiff(live *unstructured.Unstructured) ObjectDiff {
+	return ObjectDiff{Action: DiffDelete, Object: ObjectName(live), Live: diffYAML(live)}
+}
+
+// ObjectName describes an object the same way as the Object of an ObjectDiff, e.g. "Deployment default/app"
+func ObjectName(obj *unstructured.Unstructured) string {
+	return objectName(obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+func objectName(kind, namespace, name string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s %s", kind, name)
+	}
+	return fmt.Sprintf("%s %s/%s", kind, namespace, name)
+}
+
+// locallyPatched creates the same three-way merge patch as patchResource and applies it to the live object
+func locallyPatched(modifiedObj runtime.Object, live *unstructured.Unstructured, ctx Context) ([]byte, error) {
+	// getModifiedConfiguration temporarily modifies the object annotations, the original object is left untouched
+	patchType, patchData, err := threeWayMergePatch(modifiedObj.DeepCopyObject(), live, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := json.Marshal(live)
+	if err != nil {
+		return nil, err
+	}
+
+	if patchType == types.MergePatchType {
+		return jsonpatch.MergePatch(current, patchData)
+	}
+	return strategicpatch.StrategicMergePatch(current, patchData, modifiedObj)
+}
+
+// dryRunServerSideApply applies the object server-side with the dry-run option and returns the result. Objects that
+// are migrated from client-side apply force the ownership of their fields, like serverSideApplyResources does.
+func dryRunServerSideApply(r runtime.Object, migrate bool, ctx Context) ([]byte, error) {
+	obj := r.DeepCopyObject()
+	opts := []client.PatchOption{client.FieldOwner(kudo.FieldManager), client.DryRunAll}
+	if migrate {
+		opts = append(opts, client.ForceOwnership)
+	}
+	if err := ctx.Client.Patch(context.TODO(), obj, client.Apply, opts...); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// sortByObject sorts diffs by their object, as the order of rendered templates is random
+func sortByObject(diffs []ObjectDiff) {
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Object < diffs[j].Object })
+}
+
+func asUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to unstructured: %v", obj.GetObjectKind().GroupVersionKind(), err)
+	}
+	return &unstructured.Unstructured{Object: m}, nil
+}
+
+// diffYAML returns the YAML of an object without the fields that are only noise in a diff: managed fields, the last
+// applied configuration, the plan execution UID that changes with every execution and empty timestamps and status.
+func diffYAML(obj *unstructured.Unstructured) string {
+	u := obj.DeepCopy()
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+	if ts, found, _ := unstructured.NestedFieldNoCopy(u.Object, "metadata", "creationTimestamp"); found && ts == nil {
+		unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	}
+	if status, found, _ := unstructured.NestedMap(u.Object, "status"); found && len(status) == 0 {
+		unstructured.RemoveNestedField(u.Object, "status")
+	}
+	if annotations := u.GetAnnotations(); annotations != nil {
+		delete(annotations, kudo.LastAppliedConfigAnnotation)
+		delete(annotations, kudo.PlanUIDAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		u.SetAnnotations(annotations)
+	}
+
+	// an unstructured object can always be marshalled
+	b, _ := yaml.Marshal(u.Object)
+	return string(b)
+}
@@ -0,0 +1,163 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+	utilconvert "github.com/kudobuilder/kudo/pkg/util/convert"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
+)
+
+func TestTask_Diff(t *testing.T) {
+	patched := configMap("patched", map[string]string{"foo": "bar", "other": "value"})
+	patched.Annotations = map[string]string{kudo.LastAppliedConfigAnnotation: `{"data":{"foo":"bar","other":"value"}}`}
+	unchanged := configMap("unchanged", map[string]string{"foo": "bar"})
+
+	ctx := Context{
+		Client:    fake.NewFakeClientWithScheme(scheme.Scheme, patched, unchanged),
+		Discovery: kudofake.CachedDiscoveryClient(),
+		Enhancer:  &testEnhancer{},
+		Meta:      renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+		Templates: map[string]string{
+			"created":   resourceAsString(configMap("created", map[string]string{"foo": "bar"})),
+			"patched":   resourceAsString(configMap("patched", map[string]string{"foo": "baz"})),
+			"unchanged": resourceAsString(configMap("unchanged", map[string]string{"foo": "bar"})),
+
+			"chart/Chart.yaml":                 "name: chart\nversion: 0.1.0\n",
+			"chart/templates/patched.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: patched\n  namespace: {{ .Release.Namespace }}\ndata:\n  foo: {{ .Release.Name }}\n",
+			"kustomization/kustomization.yaml": "resources:\n- created.yaml\nnamePrefix: kustomized-\n",
+			"kustomization/created.yaml":       resourceAsString(configMap("created", map[string]string{"foo": "bar"})),
+		},
+		Parameters: map[string]interface{}{"enabled": "false"},
+	}
+
+	tests := []struct {
+		name string
+		task Differ
+		want []ObjectDiff
+	}{
+		{
+			name: "apply task creates, patches and leaves objects unchanged",
+			task: ApplyTask{Name: "apply", Resources: []string{"created", "patched", "unchanged"}},
+			want: []ObjectDiff{
+				{
+					Action:  DiffCreate,
+					Object:  "ConfigMap default/created",
+					Desired: "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: created\n  namespace: default\n",
+				},
+				{
+					Action:  DiffPatch,
+					Object:  "ConfigMap default/patched",
+					Live:    "apiVersion: v1\ndata:\n  foo: bar\n  other: value\nkind: ConfigMap\nmetadata:\n  name: patched\n  namespace: default\n",
+					Desired: "apiVersion: v1\ndata:\n  foo: baz\nkind: ConfigMap\nmetadata:\n  name: patched\n  namespace: default\n",
+				},
+				{
+					Action:  DiffUnchanged,
+					Object:  "ConfigMap default/unchanged",
+					Live:    "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: unchanged\n  namespace: default\n",
+					Desired: "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: unchanged\n  namespace: default\n",
+				},
+			},
+		},
+		{
+			name: "helm task diffs the rendered chart",
+			task: HelmTask{Name: "helm", Chart: "chart"},
+			want: []ObjectDiff{
+				{
+					Action:  DiffPatch,
+					Object:  "ConfigMap default/patched",
+					Live:    "apiVersion: v1\ndata:\n  foo: bar\n  other: value\nkind: ConfigMap\nmetadata:\n  name: patched\n  namespace: default\n",
+					Desired: "apiVersion: v1\ndata:\n  foo: test\nkind: ConfigMap\nmetadata:\n  name: patched\n  namespace: default\n",
+				},
+			},
+		},
+		{
+			name: "kustomize task diffs the built kustomization",
+			task: KustomizeTask{Name: "kustomize", Kustomization: "kustomization"},
+			want: []ObjectDiff{
+				{
+					Action:  DiffCreate,
+					Object:  "ConfigMap default/kustomized-created",
+					Desired: "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: kustomized-created\n  namespace: default\n",
+				},
+			},
+		},
+		{
+			name: "delete task deletes existing objects only",
+			task: DeleteTask{Name: "delete", Resources: []string{"created", "unchanged"}},
+			want: []ObjectDiff{
+				{
+					Action: DiffDelete,
+					Object: "ConfigMap default/unchanged",
+					Live:   "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: unchanged\n  namespace: default\n",
+				},
+			},
+		},
+		{
+			name: "toggle task diffs the task it delegates to",
+			task: ToggleTask{Name: "toggle", Parameter: "enabled", Resources: []string{"unchanged"}},
+			want: []ObjectDiff{
+				{
+					Action: DiffDelete,
+					Object: "ConfigMap default/unchanged",
+					Live:   "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: unchanged\n  namespace: default\n",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.task.Diff(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestApplyTask_Diff_serverSideApply(t *testing.T) {
+	// an object applied client-side is migrated, the dry-run forces the ownership of its fields like the apply does
+	migrated := configMap("migrated", map[string]string{"foo": "bar"})
+	migrated.Annotations = map[string]string{kudo.LastAppliedConfigAnnotation: `{"data":{"foo":"bar"}}`}
+	c := &serverSideApplyClient{Client: fake.NewFakeClientWithScheme(scheme.Scheme, migrated), conflict: true}
+
+	at := ApplyTask{Name: "apply", Resources: []string{"migrated"}, ServerSideApply: utilconvert.BoolPtr(true)}
+	got, err := at.Diff(Context{
+		Client:    c,
+		Discovery: kudofake.CachedDiscoveryClient(),
+		Enhancer:  &testEnhancer{},
+		Meta:      renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+		Templates: map[string]string{"migrated": resourceAsString(configMap("migrated", map[string]string{"foo": "baz"}))},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, c.forced)
+	assert.Equal(t, []ObjectDiff{{
+		Action:  DiffPatch,
+		Object:  "ConfigMap default/migrated",
+		Live:    "apiVersion: v1\ndata:\n  foo: bar\nkind: ConfigMap\nmetadata:\n  name: migrated\n  namespace: default\n",
+		Desired: "apiVersion: v1\ndata:\n  foo: baz\nkind: ConfigMap\nmetadata:\n  name: migrated\n  namespace: default\n",
+	}}, got)
+
+	live := &corev1.ConfigMap{}
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "migrated"}, live))
+	assert.Equal(t, "bar", live.Data["foo"], "the diff must not modify the object")
+}
+
+func configMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Data:       data,
+	}
+}
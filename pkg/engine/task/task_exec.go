@@ -0,0 +1,150 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubectl/pkg/util/podutils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task/podexec"
+)
+
+const (
+	execTaskError = "ExecTaskError"
+
+	// name of the file (and thus the ConfigMap/Secret key) the command output is stored in
+	execOutputFile = "stdout"
+)
+
+// ExecTask executes a command in a container of an existing pod. See Run method for more details.
+type ExecTask struct {
+	Name          string
+	PodSelector   string
+	Container     string
+	Command       []string
+	FailurePolicy kudoapi.ExecFailurePolicy
+	Output        *PipeFile
+}
+
+// Run method for the ExecTask. Given the task context, it renders the pod selector and the command using context
+// parameters, selects the first running and ready pod matching the selector and executes the command in it. As long
+// as no such pod exists, the task is not done. A command exiting with a non-zero exit code is either a fatal or a
+// transient error, depending on the FailurePolicy. If an Output is defined, the stdout of the command is stored in
+// a ConfigMap or Secret, the same way a PipeTask stores its pipe files.
+func (et ExecTask) Run(ctx Context) (bool, error) {
+	// 1. - Render pod selector and command -
+	rendered, err := renderStrings("podSelector", []string{et.PodSelector}, ctx)
+	if err != nil {
+		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+	selector, err := labels.Parse(rendered[0])
+	if err != nil {
+		return false, fatalExecutionError(fmt.Errorf("invalid pod selector %q: %v", rendered[0], err), resourceValidationError, ctx.Meta)
+	}
+
+	command, err := renderStrings("command", et.Command, ctx)
+	if err != nil {
+		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	// 2. - Select the pod -
+	pod, err := selectPod(selector, ctx)
+	if err != nil {
+		return false, err
+	}
+	if pod == nil {
+		log.Printf("TaskExecution: no running and ready pod matching %q in namespace %s found", selector, ctx.Meta.InstanceNamespace)
+		return false, nil
+	}
+
+	if err := validateContainer(pod, et.Container); err != nil {
+		return false, fatalExecutionError(err, resourceValidationError, ctx.Meta)
+	}
+
+	// 3. - Execute the command -
+	log.Printf("ExecTask: %s/%s executing %v in pod %s", ctx.Meta.InstanceNamespace, ctx.Meta.InstanceName, command, pod.Name)
+	stdout, err := podexec.Command(command, pod, et.Container, ctx.Config)
+	if err != nil {
+		if podexec.HasCommandFailed(err) && et.FailurePolicy != kudoapi.ExecFailurePolicyRetry {
+			return false, fatalExecutionError(err, execTaskError, ctx.Meta)
+		}
+		return false, err
+	}
+
+	if et.Output == nil {
+		return true, nil
+	}
+
+	// 4. - Create the output artifact (ConfigMap/Secret) from the stdout -
+	if len(stdout) > maxPipeFileSize {
+		return false, fatalExecutionError(fmt.Errorf("output of %v exceeds maximum size of %d bytes", command, maxPipeFileSize), execTaskError, ctx.Meta)
+	}
+
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, execOutputFile, []byte(stdout), 0644); err != nil {
+		return false, err
+	}
+
+	artStr, err := createArtifacts(fs, []PipeFile{*et.Output}, ctx.Meta)
+	if err != nil {
+		return false, err
+	}
+
+	// 5. - Convert to objs
+	artObjs, err := convert(artStr)
+	if err != nil {
+		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	// 6. - Enhance artifacts -
+	artObj, err := enhance(artObjs, ctx.Meta, ctx.Enhancer)
+	if err != nil {
+		return false, err
+	}
+
+	// 7. - Apply artifacts using the client -
+	_, err = applyResources(artObj, ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// selectPod returns the first running and ready pod, ordered by name, in the instance namespace that matches the
+// passed selector. If no such pod exists, nil is returned.
+func selectPod(selector labels.Selector, ctx Context) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := ctx.Client.List(context.TODO(), pods, client.InNamespace(ctx.Meta.InstanceNamespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list pods matching %q: %v", selector, err)
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning && podutils.IsPodReady(pod) {
+			return pod, nil
+		}
+	}
+	return nil, nil
+}
+
+func validateContainer(pod *corev1.Pod, container string) error {
+	if container == "" {
+		return nil
+	}
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			return nil
+		}
+	}
+	return fmt.Errorf("pod %s/%s has no container %s", pod.Namespace, pod.Name, container)
+}
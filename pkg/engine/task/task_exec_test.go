@@ -0,0 +1,125 @@
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+)
+
+func TestExecTask_Run(t *testing.T) {
+	meta := renderer.Metadata{
+		Metadata: engine.Metadata{
+			InstanceName:      "test",
+			InstanceNamespace: "default",
+		},
+		PlanName:  "plan",
+		PhaseName: "phase",
+		StepName:  "step",
+		TaskName:  "task",
+	}
+
+	tests := []struct {
+		name    string
+		task    ExecTask
+		objs    []runtime.Object
+		done    bool
+		wantErr bool
+		fatal   bool
+	}{
+		{
+			name:    "is not done when no pod matches the selector",
+			task:    ExecTask{Name: "task", PodSelector: "app={{ .Params.app }}", Command: []string{"ls"}},
+			objs:    []runtime.Object{execPod("pod1", "other", true)},
+			done:    false,
+			wantErr: false,
+		},
+		{
+			name:    "is not done when the matching pod is not ready",
+			task:    ExecTask{Name: "task", PodSelector: "app={{ .Params.app }}", Command: []string{"ls"}},
+			objs:    []runtime.Object{execPod("pod1", "db", false)},
+			done:    false,
+			wantErr: false,
+		},
+		{
+			name:    "fails when the selector is invalid",
+			task:    ExecTask{Name: "task", PodSelector: "app in db", Command: []string{"ls"}},
+			done:    false,
+			wantErr: true,
+			fatal:   true,
+		},
+		{
+			name:    "fails when the command can not be rendered",
+			task:    ExecTask{Name: "task", PodSelector: "app=db", Command: []string{"{{ .Params.missing.value }}"}},
+			done:    false,
+			wantErr: true,
+			fatal:   true,
+		},
+		{
+			name:    "fails when the container does not exist",
+			task:    ExecTask{Name: "task", PodSelector: "app={{ .Params.app }}", Container: "sidecar", Command: []string{"ls"}},
+			objs:    []runtime.Object{execPod("pod1", "db", true)},
+			done:    false,
+			wantErr: true,
+			fatal:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := Context{
+				Client:     fake.NewFakeClientWithScheme(scheme.Scheme, tt.objs...),
+				Meta:       meta,
+				Parameters: map[string]interface{}{"app": "db"},
+			}
+
+			got, err := tt.task.Run(ctx)
+			assert.Equal(t, tt.done, got)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.fatal, errors.Is(err, engine.ErrFatalExecution))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_selectPod(t *testing.T) {
+	ctx := Context{
+		Client: fake.NewFakeClientWithScheme(scheme.Scheme,
+			execPod("db-2", "db", true),
+			execPod("db-0", "db", false),
+			execPod("db-1", "db", true),
+			execPod("web-0", "web", true),
+		),
+		Meta: renderer.Metadata{Metadata: engine.Metadata{InstanceNamespace: "default"}},
+	}
+
+	pod, err := selectPod(labels.SelectorFromSet(labels.Set{"app": "db"}), ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "db-1", pod.Name)
+
+	pod, err = selectPod(labels.SelectorFromSet(labels.Set{"app": "cache"}), ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, pod)
+}
+
+func execPod(name, app string, ready bool) *corev1.Pod {
+	p := pod(name, "default")
+	p.Labels = map[string]string{"app": app}
+	p.Spec.Containers = []corev1.Container{{Name: "main"}}
+	if !ready {
+		p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}}
+	}
+	return p
+}
@@ -0,0 +1,240 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+)
+
+const (
+	externalTaskError = "ExternalTaskError"
+
+	// maximum duration of a single plugin call. The call blocks the reconciliation of the instance controller, so
+	// plugins must respond quickly and report long running work as InProgress instead.
+	externalTaskRequestTimeout = 5 * time.Second
+	// maximum size of a plugin response
+	externalTaskMaxResponseSize = 1 << 20
+)
+
+var externalTaskClient = &http.Client{Timeout: externalTaskRequestTimeout}
+
+// ExternalTaskAllowedURLs restricts the plugins that External tasks may call. A plugin URL is allowed if it is one of
+// these URLs, or if it starts with one of them that ends with a "/". All plugin URLs are allowed if it is nil. The
+// manager sets it from the KUDO_EXTERNAL_TASK_ALLOWED_URLS environment variable, see ParseExternalTaskAllowedURLs.
+var ExternalTaskAllowedURLs []string
+
+// ExternalTask delegates the task to an out-of-process plugin. See Run method for more details.
+type ExternalTask struct {
+	Name   string
+	URL    string
+	Config map[string]string
+	// SensitiveParameters sends the values of sensitive parameters to the plugin, they are omitted otherwise
+	SensitiveParameters bool
+}
+
+// ExternalTaskRequest is the JSON body POSTed to the plugin of an ExternalTask
+type ExternalTaskRequest struct {
+	Meta   ExternalTaskMetadata   `json:"meta"`
+	Config map[string]string      `json:"config,omitempty"`
+	Params map[string]interface{} `json:"params"`
+	Pipes  map[string]string      `json:"pipes"`
+}
+
+// ExternalTaskMetadata identifies the instance, plan execution and task a plugin is called for. The plan UID
+// changes with each plan execution and can be used to make a plugin idempotent.
+type ExternalTaskMetadata struct {
+	InstanceName        string `json:"instanceName"`
+	InstanceNamespace   string `json:"instanceNamespace"`
+	OperatorName        string `json:"operatorName"`
+	OperatorVersionName string `json:"operatorVersionName"`
+	OperatorVersion     string `json:"operatorVersion"`
+	AppVersion          string `json:"appVersion,omitempty"`
+	PlanName            string `json:"planName"`
+	PlanUID             string `json:"planUID"`
+	PhaseName           string `json:"phaseName"`
+	StepName            string `json:"stepName"`
+	TaskName            string `json:"taskName"`
+}
+
+// ExternalTaskResult is the outcome of a plugin call
+type ExternalTaskResult string
+
+const (
+	// ExternalTaskDone finishes the task successfully
+	ExternalTaskDone ExternalTaskResult = "Done"
+	// ExternalTaskInProgress makes the engine call the plugin again later
+	ExternalTaskInProgress ExternalTaskResult = "InProgress"
+	// ExternalTaskTransient is a transient error, the plugin is called again later
+	ExternalTaskTransient ExternalTaskResult = "Transient"
+	// ExternalTaskFatal is a fatal error that fails the plan
+	ExternalTaskFatal ExternalTaskResult = "Fatal"
+)
+
+// ExternalTaskResponse is the JSON body a plugin of an ExternalTask responds with
+type ExternalTaskResponse struct {
+	Result ExternalTaskResult `json:"result"`
+	// Message describes the error of a Transient or Fatal result
+	Message string `json:"message,omitempty"`
+}
+
+// Run method for the ExternalTask. Given the task context, it POSTs the task metadata and config, the instance
+// parameters and the pipe artifacts to the plugin URL and maps the result of the plugin: Done finishes the task,
+// InProgress and Transient make the engine call the plugin again on the next reconciliation, and Fatal fails the
+// plan. Like any task, the plugin is called multiple times and should therefore be idempotent. A plugin has to respond
+// within a few seconds (see externalTaskRequestTimeout): long running work must be started asynchronously and reported
+// as InProgress until it is done. An unreachable plugin, a timeout or a non-2xx response is a transient error, an
+// unparsable response or an unknown result is a fatal one. The values of sensitive parameters are only sent if the
+// task opts in with SensitiveParameters. Plugins outside of the cluster must be called with https, and only plugins
+// allowed by ExternalTaskAllowedURLs are called.
+func (et ExternalTask) Run(ctx Context) (bool, error) {
+	// 1. - Check the plugin URL -
+	if err := validatePluginURL(et.URL); err != nil {
+		return false, fatalExecutionError(fmt.Errorf("external task '%s' %v", et.Name, err), externalTaskError, ctx.Meta)
+	}
+
+	// 2. - Build the request -
+	params := ctx.Parameters
+	if !et.SensitiveParameters {
+		params = make(map[string]interface{}, len(ctx.Parameters))
+		for name, value := range ctx.Parameters {
+			if !ctx.SensitiveParameters[name] {
+				params[name] = value
+			}
+		}
+	}
+
+	body, err := json.Marshal(ExternalTaskRequest{
+		Meta: ExternalTaskMetadata{
+			InstanceName:        ctx.Meta.InstanceName,
+			InstanceNamespace:   ctx.Meta.InstanceNamespace,
+			OperatorName:        ctx.Meta.OperatorName,
+			OperatorVersionName: ctx.Meta.OperatorVersionName,
+			OperatorVersion:     ctx.Meta.OperatorVersion,
+			AppVersion:          ctx.Meta.AppVersion,
+			PlanName:            ctx.Meta.PlanName,
+			PlanUID:             string(ctx.Meta.PlanUID),
+			PhaseName:           ctx.Meta.PhaseName,
+			StepName:            ctx.Meta.StepName,
+			TaskName:            ctx.Meta.TaskName,
+		},
+		Config: et.Config,
+		Params: params,
+		Pipes:  ctx.Pipes,
+	})
+	if err != nil {
+		return false, fatalExecutionError(fmt.Errorf("failed to marshal the plugin request: %v", err), externalTaskError, ctx.Meta)
+	}
+
+	// 3. - Call the plugin -
+	res, err := callPlugin(et.URL, body, ctx.Meta)
+	if err != nil {
+		return false, err
+	}
+
+	// 4. - Map the result -
+	switch res.Result {
+	case ExternalTaskDone:
+		return true, nil
+	case ExternalTaskInProgress:
+		log.Printf("ExternalTask: %s/%s task %s is in progress", ctx.Meta.InstanceNamespace, ctx.Meta.InstanceName, et.Name)
+		return false, nil
+	case ExternalTaskTransient:
+		return false, fmt.Errorf("plugin %s failed: %s", et.URL, res.Message)
+	case ExternalTaskFatal:
+		return false, fatalExecutionError(fmt.Errorf("plugin %s failed: %s", et.URL, res.Message), externalTaskError, ctx.Meta)
+	default:
+		return false, fatalExecutionError(fmt.Errorf("plugin %s returned an unknown result %q", et.URL, res.Result), externalTaskError, ctx.Meta)
+	}
+}
+
+// ParseExternalTaskAllowedURLs parses a comma-separated list of plugin URLs, e.g.
+// "https://backup.example.com/,http://restore.kudo-system.svc/run". An empty list allows no plugins.
+func ParseExternalTaskAllowedURLs(urls string) ([]string, error) {
+	allowed := []string{}
+	for _, raw := range strings.Split(urls, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid plugin url %q, expected an absolute http or https url", raw)
+		}
+		allowed = append(allowed, raw)
+	}
+	return allowed, nil
+}
+
+// validatePluginURL returns an error if the URL is not an absolute http or https URL, if it uses plain http for a
+// plugin outside of the cluster or if it is not allowed by ExternalTaskAllowedURLs
+func validatePluginURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("has an invalid url %q (must be an absolute http or https url)", raw)
+	}
+	if u.Scheme == "http" && !isClusterLocalHost(u.Hostname()) {
+		return fmt.Errorf("has an http url %q, only plugins of cluster-local services can be called without https", raw)
+	}
+	if ExternalTaskAllowedURLs != nil && !pluginURLAllowed(raw) {
+		return fmt.Errorf("has a url %q that is not an allowed plugin url", raw)
+	}
+	return nil
+}
+
+// isClusterLocalHost returns true for the host names of services in the cluster, e.g. `plugin`,
+// `plugin.kudo-system.svc` or `plugin.kudo-system.svc.cluster.local`
+func isClusterLocalHost(host string) bool {
+	return !strings.Contains(host, ".") || strings.HasSuffix(host, ".svc") || strings.Contains(host, ".svc.")
+}
+
+func pluginURLAllowed(raw string) bool {
+	for _, allowed := range ExternalTaskAllowedURLs {
+		if raw == allowed || (strings.HasSuffix(allowed, "/") && strings.HasPrefix(raw, allowed)) {
+			return true
+		}
+	}
+	return false
+}
+
+// callPlugin POSTs the body to the plugin and decodes its response. Failed calls are transient errors, invalid
+// responses fatal ones.
+func callPlugin(url string, body []byte, meta renderer.Metadata) (*ExternalTaskResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), externalTaskRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fatalExecutionError(fmt.Errorf("invalid plugin request: %v", err), externalTaskError, meta)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := externalTaskClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call plugin %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, externalTaskMaxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of plugin %s: %v", url, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("plugin %s responded with %s: %s", url, resp.Status, data)
+	}
+
+	res := &ExternalTaskResponse{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, fatalExecutionError(fmt.Errorf("invalid response of plugin %s: %v", url, err), externalTaskError, meta)
+	}
+	return res, nil
+}
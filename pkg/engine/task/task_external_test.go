@@ -0,0 +1,243 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+)
+
+func TestExternalTask_Run(t *testing.T) {
+	meta := renderer.Metadata{
+		Metadata: engine.Metadata{
+			InstanceName:      "test",
+			InstanceNamespace: "default",
+			OperatorName:      "backup",
+		},
+		PlanName:  "plan",
+		PlanUID:   "uid",
+		PhaseName: "phase",
+		StepName:  "step",
+		TaskName:  "task",
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		done    bool
+		wantErr string
+		fatal   bool
+	}{
+		{
+			name:   "is done when the plugin is done",
+			status: http.StatusOK,
+			body:   `{"result": "Done"}`,
+			done:   true,
+		},
+		{
+			name:   "is not done when the plugin is in progress",
+			status: http.StatusOK,
+			body:   `{"result": "InProgress"}`,
+			done:   false,
+		},
+		{
+			name:    "fails with a transient error",
+			status:  http.StatusOK,
+			body:    `{"result": "Transient", "message": "bucket not reachable"}`,
+			wantErr: "bucket not reachable",
+		},
+		{
+			name:    "fails with a fatal error",
+			status:  http.StatusOK,
+			body:    `{"result": "Fatal", "message": "bucket does not exist"}`,
+			wantErr: "bucket does not exist",
+			fatal:   true,
+		},
+		{
+			name:    "fails with a transient error when the plugin responds with an error status",
+			status:  http.StatusServiceUnavailable,
+			body:    "try again later",
+			wantErr: "503 Service Unavailable: try again later",
+		},
+		{
+			name:    "fails with a fatal error when the response is invalid",
+			status:  http.StatusOK,
+			body:    "done",
+			wantErr: "invalid response of plugin",
+			fatal:   true,
+		},
+		{
+			name:    "fails with a fatal error when the result is unknown",
+			status:  http.StatusOK,
+			body:    `{"result": "Maybe"}`,
+			wantErr: `returned an unknown result "Maybe"`,
+			fatal:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var req ExternalTaskRequest
+			server := pluginServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+
+			task := ExternalTask{Name: "task", URL: server.URL, Config: map[string]string{"bucket": "backups"}}
+			ctx := Context{
+				Meta:                meta,
+				Parameters:          map[string]interface{}{"replicas": 3, "password": "secret"},
+				Pipes:               map[string]string{"token": "token-cm"},
+				SensitiveParameters: map[string]bool{"password": true},
+			}
+
+			done, err := task.Run(ctx)
+			assert.Equal(t, tt.done, done)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Equal(t, tt.fatal, errors.Is(err, engine.ErrFatalExecution))
+			}
+
+			assert.Equal(t, ExternalTaskRequest{
+				Meta: ExternalTaskMetadata{
+					InstanceName:      "test",
+					InstanceNamespace: "default",
+					OperatorName:      "backup",
+					PlanName:          "plan",
+					PlanUID:           "uid",
+					PhaseName:         "phase",
+					StepName:          "step",
+					TaskName:          "task",
+				},
+				Config: map[string]string{"bucket": "backups"},
+				Params: map[string]interface{}{"replicas": float64(3)},
+				Pipes:  map[string]string{"token": "token-cm"},
+			}, req)
+		})
+	}
+}
+
+// pluginServer starts a TLS server for the plugin and makes the External task trust its certificate
+func pluginServer(t *testing.T, handler http.Handler) *httptest.Server {
+	server := httptest.NewTLSServer(handler)
+	client := externalTaskClient
+	externalTaskClient = server.Client()
+	t.Cleanup(func() {
+		externalTaskClient = client
+		server.Close()
+	})
+	return server
+}
+
+func TestExternalTask_RunSensitiveParameters(t *testing.T) {
+	var req ExternalTaskRequest
+	server := pluginServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = w.Write([]byte(`{"result": "Done"}`))
+	}))
+
+	task := ExternalTask{Name: "task", URL: server.URL, SensitiveParameters: true}
+	ctx := Context{
+		Parameters:          map[string]interface{}{"replicas": 3, "password": "secret"},
+		SensitiveParameters: map[string]bool{"password": true},
+	}
+
+	done, err := task.Run(ctx)
+	assert.True(t, done)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"replicas": float64(3), "password": "secret"}, req.Params)
+}
+
+func TestExternalTask_RunPluginURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		allowed []string
+		wantErr string
+		fatal   bool
+	}{
+		{
+			name:    "plain http is rejected outside of the cluster",
+			url:     "http://plugin.example.com/run",
+			wantErr: `has an http url "http://plugin.example.com/run", only plugins of cluster-local services can be called without https`,
+			fatal:   true,
+		},
+		{
+			name:    "plain http is allowed for cluster-local services",
+			url:     "http://localhost:1/run",
+			wantErr: "failed to call plugin",
+		},
+		{
+			name:    "url is not in the allowed urls",
+			url:     "https://plugin.example.com/run",
+			allowed: []string{"https://plugin.example.com/backup", "https://plugin.example.co/"},
+			wantErr: `has a url "https://plugin.example.com/run" that is not an allowed plugin url`,
+			fatal:   true,
+		},
+		{
+			name:    "url starts with an allowed url",
+			url:     "https://localhost:1/run",
+			allowed: []string{"https://localhost:1/"},
+			wantErr: "failed to call plugin",
+		},
+		{
+			name:    "no plugins are allowed",
+			url:     "https://plugin.example.com/run",
+			allowed: []string{},
+			wantErr: "that is not an allowed plugin url",
+			fatal:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ExternalTaskAllowedURLs = tt.allowed
+			t.Cleanup(func() { ExternalTaskAllowedURLs = nil })
+
+			done, err := ExternalTask{Name: "task", URL: tt.url}.Run(Context{})
+			assert.False(t, done)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Equal(t, tt.fatal, errors.Is(err, engine.ErrFatalExecution))
+		})
+	}
+}
+
+func TestParseExternalTaskAllowedURLs(t *testing.T) {
+	urls, err := ParseExternalTaskAllowedURLs(" https://plugins.example.com/, http://backup.kudo-system.svc/run,")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://plugins.example.com/", "http://backup.kudo-system.svc/run"}, urls)
+
+	urls, err = ParseExternalTaskAllowedURLs("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, urls)
+
+	_, err = ParseExternalTaskAllowedURLs("plugins.example.com")
+	assert.EqualError(t, err, `invalid plugin url "plugins.example.com", expected an absolute http or https url`)
+}
+
+func TestExternalTask_RunUnreachablePlugin(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	done, err := ExternalTask{Name: "task", URL: url}.Run(Context{})
+	assert.False(t, done)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, engine.ErrFatalExecution))
+}
@@ -0,0 +1,86 @@
+package task
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+)
+
+// helmReleaseService is the service of the release in chart templates, {{ .Release.Service }}
+const helmReleaseService = "KUDO"
+
+// HelmTask renders a Helm chart bundled in the templates folder of the package and applies the rendered resources.
+// The resources are enhanced and applied the same way as the ones of an ApplyTask.
+type HelmTask struct {
+	Name       string
+	Chart      string
+	ValuesFile string
+}
+
+// Run method for the HelmTask. Given the task context, it renders the values file using context parameters, renders
+// the chart with these values and applies the resulting resources. Finally, resources are checked for health.
+func (ht HelmTask) Run(ctx Context) (bool, error) {
+	// 1. - Render the chart, convert and enhance the resources with metadata -
+	enhanced, err := ht.enhancedResources(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// 2. - Apply them using the client -
+	applied, err := applyResources(enhanced, ctx)
+	if err != nil {
+		return false, err
+	}
+	ctx.Applied.record(applied)
+
+	// 3. - Check health for all resources -
+	return checkHealth(applied, ctx)
+}
+
+// enhancedResources renders the chart of the task, converts the rendered templates to runtime objects and enhances
+// them with KUDO metadata.
+func (ht HelmTask) enhancedResources(ctx Context) ([]runtime.Object, error) {
+	values, err := ht.values(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chart, err := renderer.LoadChart(ht.Chart, ctx.Templates)
+	if err != nil {
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	release := renderer.Release{Name: ctx.Meta.InstanceName, Namespace: ctx.Meta.InstanceNamespace, Service: helmReleaseService}
+	rendered, err := renderer.RenderChart(chart, release, values)
+	if err != nil {
+		return nil, renderingError(fmt.Errorf("error rendering chart %s: %w", ht.Chart, err), ctx)
+	}
+
+	objs, err := convert(rendered)
+	if err != nil {
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	return enhance(objs, ctx.Meta, ctx.Enhancer)
+}
+
+// values renders the values file of the task, if any, and parses the values that override the chart defaults
+func (ht HelmTask) values(ctx Context) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if ht.ValuesFile == "" {
+		return values, nil
+	}
+
+	rendered, err := render([]string{ht.ValuesFile}, ctx)
+	if err != nil {
+		return nil, renderingError(err, ctx)
+	}
+
+	if err := yaml.Unmarshal([]byte(rendered[ht.ValuesFile]), &values); err != nil {
+		return nil, fatalExecutionError(fmt.Errorf("error parsing values file %s: %v", ht.ValuesFile, err), taskRenderingError, ctx.Meta)
+	}
+	return values, nil
+}
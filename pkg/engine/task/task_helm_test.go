@@ -0,0 +1,96 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+)
+
+func TestHelmTask_Run(t *testing.T) {
+	templates := map[string]string{
+		"app/Chart.yaml":  "name: app\nversion: 0.1.0\n",
+		"app/values.yaml": "greeting: hello\nname: world\n",
+		"app/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-{{ .Chart.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  message: {{ .Values.greeting }} {{ .Values.name }}
+  managedBy: {{ .Release.Service }}
+`,
+		"app-values.yaml":     "name: {{ .Params.NAME }}",
+		"invalid-values.yaml": "name: [",
+	}
+
+	tests := []struct {
+		name    string
+		task    HelmTask
+		done    bool
+		wantErr bool
+		fatal   bool
+		want    map[string]string
+	}{
+		{
+			name: "renders the chart with the default values",
+			task: HelmTask{Name: "app", Chart: "app"},
+			done: true,
+			want: map[string]string{"message": "hello world", "managedBy": "KUDO"},
+		},
+		{
+			name: "renders the chart with values from parameters",
+			task: HelmTask{Name: "app", Chart: "app", ValuesFile: "app-values.yaml"},
+			done: true,
+			want: map[string]string{"message": "hello kudo", "managedBy": "KUDO"},
+		},
+		{
+			name:    "fails when the chart does not exist",
+			task:    HelmTask{Name: "app", Chart: "missing"},
+			wantErr: true,
+			fatal:   true,
+		},
+		{
+			name:    "fails when the values file is invalid",
+			task:    HelmTask{Name: "app", Chart: "app", ValuesFile: "invalid-values.yaml"},
+			wantErr: true,
+			fatal:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme)
+			ctx := Context{
+				Client:     c,
+				Discovery:  kudofake.CachedDiscoveryClient(),
+				Enhancer:   &testEnhancer{},
+				Meta:       renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+				Templates:  templates,
+				Parameters: map[string]interface{}{"NAME": "kudo"},
+			}
+
+			done, err := tt.task.Run(ctx)
+			assert.Equal(t, tt.done, done)
+			assert.Equal(t, tt.wantErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, tt.fatal, errors.Is(err, engine.ErrFatalExecution), "unexpected error: %v", err)
+			if tt.want == nil {
+				return
+			}
+
+			cm := &corev1.ConfigMap{}
+			assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "test-app"}, cm))
+			assert.Equal(t, tt.want, cm.Data)
+		})
+	}
+}
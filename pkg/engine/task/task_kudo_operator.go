@@ -7,6 +7,7 @@ import (
 	"log"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -56,14 +57,19 @@ func (kt KudoOperatorTask) Run(ctx Context) (bool, error) {
 		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
 	}
 
-	// 3. - Apply the Instance object -
+	// 3. - Store the values of sensitive parameters in the sensitive parameters Secret of the Instance -
+	if err := storeSensitiveParameters(instance, ctx.Client, ctx.Scheme); err != nil {
+		return false, err
+	}
+
+	// 4. - Apply the Instance object -
 	err = applyInstance(instance, namespace, ctx.Client)
 	if err != nil {
 		return false, err
 	}
 
-	// 4. - Check the Instance health -
-	if err := isResourceHealthy(instance); err != nil {
+	// 5. - Check the Instance health -
+	if err := isResourceHealthy(instance, nil); err != nil {
 		return false, nil
 	}
 
@@ -146,6 +152,69 @@ func instanceResource(instanceName, operatorName, operatorVersionName, namespace
 	return instance, nil
 }
 
+// storeSensitiveParameters moves the values of the sensitive parameters of the passed instance into its sensitive
+// parameters Secret and replaces them with references, the same way kudoctl does it. Values that are already stored
+// keep their references, so that the instance is only updated if a value changes. Once the instance exists, it
+// controls the Secret.
+func storeSensitiveParameters(instance *kudoapi.Instance, c client.Client, scheme *runtime.Scheme) error {
+	ov, err := kudoapi.GetOperatorVersionByName(instance.Spec.OperatorVersion.Name, instance.Namespace, c)
+	if err != nil {
+		return fmt.Errorf("failed to get operator version %s/%s: %v", instance.Namespace, instance.Spec.OperatorVersion.Name, err)
+	}
+	if len(kudoapi.PlaintextSensitiveParameters(ov, instance.Spec.Parameters)) == 0 {
+		return nil
+	}
+
+	current := &kudoapi.Instance{}
+	err = c.Get(context.TODO(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, current)
+	switch {
+	case apierrors.IsNotFound(err):
+		current = instance
+	case err != nil:
+		return fmt.Errorf("failed to get instance %s/%s: %v", instance.Namespace, instance.Name, err)
+	}
+
+	name := kudoapi.SensitiveParametersSecretName(instance.Name)
+	secret := &corev1.Secret{}
+	err = c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: instance.Namespace}, secret)
+	exists := err == nil
+	switch {
+	case apierrors.IsNotFound(err):
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: instance.Namespace,
+				Labels:    map[string]string{kudo.HeritageLabel: "kudo", kudo.InstanceLabel: instance.Name},
+			},
+			Type: corev1.SecretTypeOpaque,
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get sensitive parameters secret %s/%s: %v", instance.Namespace, name, err)
+	}
+
+	original := secret.DeepCopy()
+	params := current.StoreSensitiveParameterValues(secret, ov, instance.Spec.Parameters)
+	if current.UID != "" {
+		if err := controllerutil.SetControllerReference(current, secret, scheme); err != nil {
+			return fmt.Errorf("failed to set owner of sensitive parameters secret %s/%s: %v", instance.Namespace, name, err)
+		}
+	}
+
+	switch {
+	case !exists:
+		err = c.Create(context.TODO(), secret)
+	case !equality.Semantic.DeepEqual(original, secret):
+		err = c.Update(context.TODO(), secret)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store sensitive parameters in secret %s/%s: %v", instance.Namespace, name, err)
+	}
+
+	instance.Spec.Parameters = params
+	instance.Spec.SensitiveParametersSecret = name
+	return nil
+}
+
 // applyInstance creates the passed instance if it doesn't exist or patches the existing one. Patch will override
 // current spec.parameters and Spec.operatorVersion the same way, kudoctl does it. If the was no error, then the passed
 // instance object is updated with the content returned by the server
@@ -1,6 +1,8 @@
 package task
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/kudobuilder/kuttl/pkg/test/utils"
@@ -8,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -117,6 +120,59 @@ func Test_applyInstance(t *testing.T) {
 	}
 }
 
+func Test_storeSensitiveParameters(t *testing.T) {
+	namespace := "default"
+	scheme := scheme.Scheme
+	if err := apis.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	sensitive := true
+	operatorVersion := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-0.1.0", Namespace: namespace},
+		Spec: kudoapi.OperatorVersionSpec{Parameters: []kudoapi.Parameter{
+			{Name: "PASSWORD", Sensitive: &sensitive},
+			{Name: "NODES"},
+		}},
+	}
+	newInstance := func(params map[string]string) *kudoapi.Instance {
+		i, err := instanceResource("test-instance", "test-operator", "test-0.1.0", namespace, params, operatorVersion, scheme)
+		assert.NoError(t, err)
+		return i
+	}
+	secretKey := client.ObjectKey{Name: "test-instance-sensitive-parameters", Namespace: namespace}
+	c := fake.NewFakeClientWithScheme(scheme, operatorVersion)
+
+	// the instance doesn't exist yet
+	instance := newInstance(map[string]string{"PASSWORD": "s3cr3t", "NODES": "3"})
+	assert.NoError(t, storeSensitiveParameters(instance, c, scheme))
+	assert.Equal(t, "3", instance.Spec.Parameters["NODES"])
+	assert.True(t, kudoapi.IsSensitiveParameterRef(instance.Spec.Parameters["PASSWORD"]))
+	assert.Equal(t, secretKey.Name, instance.Spec.SensitiveParametersSecret)
+
+	secret := &corev1.Secret{}
+	assert.NoError(t, c.Get(context.TODO(), secretKey, secret))
+	assert.Equal(t, []byte("s3cr3t"), secret.Data[strings.TrimPrefix(instance.Spec.Parameters["PASSWORD"], kudoapi.SensitiveParameterRefPrefix)])
+	assert.Nil(t, metav1.GetControllerOf(secret))
+
+	// the created instance keeps the reference and controls the secret
+	assert.NoError(t, applyInstance(instance, namespace, c))
+	created := &kudoapi.Instance{}
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Name: instance.Name, Namespace: namespace}, created))
+	created.UID = "instance-uid"
+	assert.NoError(t, c.Update(context.TODO(), created))
+
+	unchanged := newInstance(map[string]string{"PASSWORD": "s3cr3t", "NODES": "3"})
+	assert.NoError(t, storeSensitiveParameters(unchanged, c, scheme))
+	assert.Equal(t, instance.Spec.Parameters, unchanged.Spec.Parameters)
+	assert.NoError(t, c.Get(context.TODO(), secretKey, secret))
+	assert.Equal(t, types.UID("instance-uid"), metav1.GetControllerOf(secret).UID)
+
+	changed := newInstance(map[string]string{"PASSWORD": "n3w-s3cr3t", "NODES": "3"})
+	assert.NoError(t, storeSensitiveParameters(changed, c, scheme))
+	assert.NotEqual(t, instance.Spec.Parameters["PASSWORD"], changed.Spec.Parameters["PASSWORD"])
+}
+
 func Test_instanceParameters(t *testing.T) {
 	templates := map[string]string{
 		"bb-params.yaml": `
@@ -0,0 +1,56 @@
+package task
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+)
+
+// KustomizeTask builds a kustomization in the templates folder of the package and applies the resulting resources.
+// The resources are enhanced and applied the same way as the ones of an ApplyTask.
+type KustomizeTask struct {
+	Name          string
+	Kustomization string
+	Patches       []string
+}
+
+// Run method for the KustomizeTask. Given the task context, it renders the patches using context parameters, builds
+// the kustomization with these patches and applies the resulting resources. Finally, resources are checked for health.
+func (kt KustomizeTask) Run(ctx Context) (bool, error) {
+	// 1. - Build the kustomization, convert and enhance the resources with metadata -
+	enhanced, err := kt.enhancedResources(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// 2. - Apply them using the client -
+	applied, err := applyResources(enhanced, ctx)
+	if err != nil {
+		return false, err
+	}
+	ctx.Applied.record(applied)
+
+	// 3. - Check health for all resources -
+	return checkHealth(applied, ctx)
+}
+
+// enhancedResources builds the kustomization of the task, converts the result to runtime objects and enhances them
+// with KUDO metadata.
+func (kt KustomizeTask) enhancedResources(ctx Context) ([]runtime.Object, error) {
+	patches, err := render(kt.Patches, ctx)
+	if err != nil {
+		return nil, renderingError(err, ctx)
+	}
+
+	built, err := renderer.Kustomize(kt.Kustomization, ctx.Templates, patches)
+	if err != nil {
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	objs, err := convert(map[string]string{kt.Kustomization: built})
+	if err != nil {
+		return nil, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	return enhance(objs, ctx.Meta, ctx.Enhancer)
+}
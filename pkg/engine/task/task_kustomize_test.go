@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+)
+
+func TestKustomizeTask_Run(t *testing.T) {
+	templates := map[string]string{
+		"base/kustomization.yaml": "resources:\n- configmap.yaml\n",
+		"base/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: default
+data:
+  greeting: hello
+`,
+		"overlay/kustomization.yaml": "bases:\n- ../base\nnamePrefix: test-\n",
+		"greeting-patch.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  greeting: {{ .Params.GREETING }}
+`,
+	}
+
+	tests := []struct {
+		name    string
+		task    KustomizeTask
+		done    bool
+		wantErr bool
+		fatal   bool
+		want    map[string]string
+	}{
+		{
+			name: "builds the kustomization",
+			task: KustomizeTask{Name: "app", Kustomization: "overlay"},
+			done: true,
+			want: map[string]string{"greeting": "hello"},
+		},
+		{
+			name: "builds the kustomization with patches rendered from parameters",
+			task: KustomizeTask{Name: "app", Kustomization: "overlay", Patches: []string{"greeting-patch.yaml"}},
+			done: true,
+			want: map[string]string{"greeting": "hi"},
+		},
+		{
+			name:    "fails when the kustomization does not exist",
+			task:    KustomizeTask{Name: "app", Kustomization: "missing"},
+			wantErr: true,
+			fatal:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme)
+			ctx := Context{
+				Client:     c,
+				Discovery:  kudofake.CachedDiscoveryClient(),
+				Enhancer:   &testEnhancer{},
+				Meta:       renderer.Metadata{Metadata: engine.Metadata{InstanceName: "test", InstanceNamespace: "default"}},
+				Templates:  templates,
+				Parameters: map[string]interface{}{"GREETING": "hi"},
+			}
+
+			done, err := tt.task.Run(ctx)
+			assert.Equal(t, tt.done, done)
+			assert.Equal(t, tt.wantErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, tt.fatal, errors.Is(err, engine.ErrFatalExecution), "unexpected error: %v", err)
+			if tt.want == nil {
+				return
+			}
+
+			cm := &corev1.ConfigMap{}
+			assert.NoError(t, c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "test-config"}, cm))
+			assert.Equal(t, tt.want, cm.Data)
+		})
+	}
+}
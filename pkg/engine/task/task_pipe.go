@@ -68,7 +68,7 @@ func (pt PipeTask) Run(ctx Context) (bool, error) {
 	// 1. - Render container template -
 	rendered, err := render([]string{pt.Pod, pt.Pod}, ctx)
 	if err != nil {
-		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+		return false, renderingError(err, ctx)
 	}
 
 	// 2. - Create core/v1 container object -
@@ -109,7 +109,7 @@ func (pt PipeTask) Run(ctx Context) (bool, error) {
 	}
 
 	// 8. - Wait for the pod to be ready -
-	err = isHealthy(podObj)
+	err = isHealthy(podObj, nil)
 	// once the pod is Ready, it means that its initContainer finished successfully and we can copy
 	// out the generated files. An error during a health check is not treated as task execution error
 	if err != nil {
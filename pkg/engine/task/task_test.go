@@ -1,12 +1,15 @@
 package task
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/yaml"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	utilconvert "github.com/kudobuilder/kudo/pkg/util/convert"
 )
 
 func TestBuild(t *testing.T) {
@@ -31,6 +34,22 @@ spec:
 			},
 			wantErr: false,
 		},
+		{
+			name: "apply task with server-side apply",
+			taskYaml: `
+name: apply-task
+kind: Apply
+spec: 
+    resources:
+      - pod.yaml
+    serverSideApply: true`,
+			want: ApplyTask{
+				Name:            "apply-task",
+				Resources:       []string{"pod.yaml"},
+				ServerSideApply: utilconvert.BoolPtr(true),
+			},
+			wantErr: false,
+		},
 		{
 			name: "delete task",
 			taskYaml: `
@@ -194,6 +213,165 @@ spec:
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "exec task with output",
+			taskYaml: `
+name: rebalance
+kind: Exec
+spec:
+    podSelector: app=db
+    container: db
+    command: ["rebalance", "--all"]
+    failurePolicy: Retry
+    output:
+      kind: ConfigMap
+      key: Result`,
+			want: ExecTask{
+				Name:          "rebalance",
+				PodSelector:   "app=db",
+				Container:     "db",
+				Command:       []string{"rebalance", "--all"},
+				FailurePolicy: kudoapi.ExecFailurePolicyRetry,
+				Output:        &PipeFile{File: "stdout", Kind: "ConfigMap", Key: "Result"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "exec task without a command",
+			taskYaml: `
+name: rebalance
+kind: Exec
+spec:
+    podSelector: app=db`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "exec task with an invalid failure policy",
+			taskYaml: `
+name: rebalance
+kind: Exec
+spec:
+    podSelector: app=db
+    command: ["rebalance"]
+    failurePolicy: Ignore`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "helm task",
+			taskYaml: `
+name: redis
+kind: Helm
+spec:
+    chart: redis
+    valuesFile: redis-values.yaml`,
+			want: HelmTask{
+				Name:       "redis",
+				Chart:      "redis",
+				ValuesFile: "redis-values.yaml",
+			},
+			wantErr: false,
+		},
+		{
+			name: "helm task without a chart",
+			taskYaml: `
+name: redis
+kind: Helm
+spec:
+    valuesFile: redis-values.yaml`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "kustomize task",
+			taskYaml: `
+name: app
+kind: Kustomize
+spec:
+    kustomization: overlays/prod
+    patches:
+      - replicas.yaml`,
+			want: KustomizeTask{
+				Name:          "app",
+				Kustomization: "overlays/prod",
+				Patches:       []string{"replicas.yaml"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "kustomize task without a kustomization",
+			taskYaml: `
+name: app
+kind: Kustomize
+spec:
+    patches:
+      - replicas.yaml`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "external task",
+			taskYaml: `
+name: backup
+kind: External
+spec:
+    url: http://backup-plugin.kudo-system.svc/run
+    config:
+      bucket: backups`,
+			want: ExternalTask{
+				Name:   "backup",
+				URL:    "http://backup-plugin.kudo-system.svc/run",
+				Config: map[string]string{"bucket": "backups"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "external task without a url",
+			taskYaml: `
+name: backup
+kind: External
+spec:
+    config:
+      bucket: backups`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "external task with an http url outside of the cluster",
+			taskYaml: `
+name: backup
+kind: External
+spec:
+    url: http://backup.example.com/run`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "external task sending sensitive parameters",
+			taskYaml: `
+name: backup
+kind: External
+spec:
+    url: https://backup.example.com/run
+    sensitiveParameters: true`,
+			want: ExternalTask{
+				Name:                "backup",
+				URL:                 "https://backup.example.com/run",
+				SensitiveParameters: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "external task with a relative url",
+			taskYaml: `
+name: backup
+kind: External
+spec:
+    url: /run`,
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "unknown task",
 			taskYaml: `
@@ -227,3 +405,46 @@ spec:
 		})
 	}
 }
+
+type customTask struct {
+	Name   string
+	Bucket string
+}
+
+func (customTask) Run(ctx Context) (bool, error) { return true, nil }
+
+func TestRegister(t *testing.T) {
+	t.Cleanup(func() { Unregister("Backup") })
+
+	factory := func(task *kudoapi.Task) (Tasker, error) {
+		if task.Spec.Config["bucket"] == "" {
+			return nil, fmt.Errorf("task validation error: backup task '%s' has no bucket", task.Name)
+		}
+		return customTask{Name: task.Name, Bucket: task.Spec.Config["bucket"]}, nil
+	}
+
+	task := &kudoapi.Task{Name: "backup", Kind: "Backup", Spec: kudoapi.TaskSpec{ExternalTaskSpec: kudoapi.ExternalTaskSpec{Config: map[string]string{"bucket": "backups"}}}}
+	_, err := Build(task)
+	assert.EqualError(t, err, "unknown task kind Backup")
+	assert.False(t, IsRegistered("Backup"))
+
+	assert.NoError(t, Register("Backup", factory))
+	assert.True(t, IsRegistered("Backup"))
+
+	got, err := Build(task)
+	assert.NoError(t, err)
+	assert.Equal(t, customTask{Name: "backup", Bucket: "backups"}, got)
+
+	_, err = Build(&kudoapi.Task{Name: "backup", Kind: "Backup"})
+	assert.EqualError(t, err, "task validation error: backup task 'backup' has no bucket")
+
+	assert.EqualError(t, Register("Backup", factory), "task kind Backup is already registered")
+	assert.EqualError(t, Register(ApplyTaskKind, factory), "task kind Apply is a built-in kind")
+	assert.EqualError(t, Register("", factory), "task kind must not be empty")
+	assert.EqualError(t, Register("Restore", nil), "task kind Restore has no factory")
+	assert.False(t, IsRegistered(ApplyTaskKind))
+
+	Unregister("Backup")
+	assert.False(t, IsRegistered("Backup"))
+	assert.NoError(t, Register("Backup", factory))
+}
@@ -0,0 +1,227 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/resource"
+	"github.com/kudobuilder/kudo/pkg/kubernetes/status"
+)
+
+const (
+	waitConditionPrefix = "condition="
+	waitJSONPathPrefix  = "jsonpath="
+)
+
+// WaitTask waits for a set of existing objects to reach a condition. See Run method for more details.
+type WaitTask struct {
+	Name      string
+	Resources []string
+	Condition *WaitCondition
+}
+
+// WaitCondition is a parsed WaitTaskSpec.Condition. A nil condition means that the objects have to be healthy.
+type WaitCondition struct {
+	// ConditionType and ConditionStatus are set for `condition=<type>[=<status>]` conditions
+	ConditionType   string
+	ConditionStatus string
+	// JSONPath and Value are set for `jsonpath=<expression>[=<value>]` conditions
+	JSONPath string
+	Value    *string
+}
+
+// Run method for the WaitTask. Given the task context, it renders the templates using context parameters and
+// creates runtime objects. The objects are only used as references: they are fetched from the cluster and checked
+// for the condition. Objects that do not exist yet are waited for. A terminally failed object (e.g. a failed Job)
+// results in a fatal error.
+func (wt WaitTask) Run(ctx Context) (bool, error) {
+	// 1. - Render task templates -
+	rendered, err := render(wt.Resources, ctx)
+	if err != nil {
+		return false, renderingError(err, ctx)
+	}
+
+	// 2. - Convert to objects -
+	objs, err := convert(rendered)
+	if err != nil {
+		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	// 3. - Fetch the objects and check the condition -
+	for _, obj := range objs {
+		done, err := wt.isDone(obj, ctx)
+		if err != nil {
+			return false, err
+		}
+		if !done {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (wt WaitTask) isDone(obj runtime.Object, ctx Context) (bool, error) {
+	if err := setDefaultNamespace(obj, ctx.Meta.InstanceNamespace); err != nil {
+		return false, fatalExecutionError(err, taskRenderingError, ctx.Meta)
+	}
+
+	key, err := resource.ObjectKeyFromObject(obj, ctx.Discovery)
+	if err != nil {
+		return false, err
+	}
+
+	existing := obj.DeepCopyObject()
+	err = ctx.Client.Get(context.TODO(), key, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		log.Printf("TaskExecution: %s %s does not exist yet", obj.GetObjectKind().GroupVersionKind().Kind, key)
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	failed, msg, err := status.IsTerminallyFailed(existing)
+	if err != nil {
+		return false, err
+	}
+	if failed {
+		return false, fatalExecutionError(errors.New(msg), failedTerminalState, ctx.Meta)
+	}
+
+	done, msg, err := wt.Condition.isMet(existing, ctx.HealthChecks)
+	if err != nil {
+		return false, err
+	}
+	if !done {
+		log.Printf("TaskExecution: %s", msg)
+	}
+	return done, nil
+}
+
+// setDefaultNamespace sets the namespace of an object without one. It is cleared again for cluster-scoped objects
+// when the object key is computed.
+func setDefaultNamespace(obj runtime.Object, namespace string) error {
+	ns, err := metadataAccessor.Namespace(obj)
+	if err != nil {
+		return err
+	}
+	if ns == "" {
+		return metadataAccessor.SetNamespace(obj, namespace)
+	}
+	return nil
+}
+
+// ParseWaitCondition parses a condition in the syntax of `kubectl wait --for`. An empty condition returns nil.
+func ParseWaitCondition(condition string) (*WaitCondition, error) {
+	switch {
+	case condition == "":
+		return nil, nil
+
+	case strings.HasPrefix(condition, waitConditionPrefix):
+		cond := strings.TrimPrefix(condition, waitConditionPrefix)
+		parts := strings.SplitN(cond, "=", 2)
+		if parts[0] == "" {
+			return nil, fmt.Errorf("condition %q has no condition type", condition)
+		}
+		wc := &WaitCondition{ConditionType: parts[0], ConditionStatus: "True"}
+		if len(parts) == 2 {
+			wc.ConditionStatus = parts[1]
+		}
+		return wc, nil
+
+	case strings.HasPrefix(condition, waitJSONPathPrefix):
+		expr := strings.TrimPrefix(condition, waitJSONPathPrefix)
+		end := strings.LastIndex(expr, "}")
+		if !strings.HasPrefix(expr, "{") || end == -1 {
+			return nil, fmt.Errorf("condition %q must have a JSONPath expression in curly braces, e.g. 'jsonpath={.status.phase}=Running'", condition)
+		}
+
+		wc := &WaitCondition{JSONPath: expr[:end+1]}
+		if rest := expr[end+1:]; rest != "" {
+			if !strings.HasPrefix(rest, "=") {
+				return nil, fmt.Errorf("condition %q has an invalid value %q, expected '=<value>'", condition, rest)
+			}
+			value := strings.TrimPrefix(rest, "=")
+			wc.Value = &value
+		}
+
+		if err := jsonpath.New("condition").Parse(wc.JSONPath); err != nil {
+			return nil, fmt.Errorf("condition %q has an invalid JSONPath expression: %v", condition, err)
+		}
+		return wc, nil
+
+	default:
+		return nil, fmt.Errorf("condition %q must start with '%s' or '%s'", condition, waitConditionPrefix, waitJSONPathPrefix)
+	}
+}
+
+// isMet returns true if the condition is met for the given object and a message describing the state. Without
+// a condition, the object has to be healthy according to the passed health checks.
+func (wc *WaitCondition) isMet(obj runtime.Object, checks []kudoapi.HealthCheck) (bool, string, error) {
+	if wc == nil {
+		return status.IsHealthyWithChecks(obj, checks)
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to convert %s to unstructured: %v", obj.GetObjectKind().GroupVersionKind(), err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	name := fmt.Sprintf("%s %s/%s", obj.GetObjectKind().GroupVersionKind().Kind, u.GetNamespace(), u.GetName())
+
+	if wc.ConditionType != "" {
+		conditions, _, err := unstructured.NestedSlice(content, "status", "conditions")
+		if err != nil {
+			return false, fmt.Sprintf("%s has no valid status conditions: %v", name, err), nil
+		}
+		for _, c := range conditions {
+			c, ok := c.(map[string]interface{})
+			if !ok || c["type"] != wc.ConditionType {
+				continue
+			}
+			if strings.EqualFold(fmt.Sprint(c["status"]), wc.ConditionStatus) {
+				return true, fmt.Sprintf("%s has condition %s=%s", name, wc.ConditionType, wc.ConditionStatus), nil
+			}
+			return false, fmt.Sprintf("%s has condition %s=%v, waiting for %s", name, wc.ConditionType, c["status"], wc.ConditionStatus), nil
+		}
+		return false, fmt.Sprintf("%s has no condition %s yet", name, wc.ConditionType), nil
+	}
+
+	j := jsonpath.New("condition").AllowMissingKeys(true)
+	if err := j.Parse(wc.JSONPath); err != nil {
+		return false, "", err
+	}
+	results, err := j.FindResults(content)
+	if err != nil {
+		return false, fmt.Sprintf("%s: failed to evaluate %s: %v", name, wc.JSONPath, err), nil
+	}
+
+	var values []string
+	for _, r := range results {
+		for _, v := range r {
+			values = append(values, fmt.Sprint(v.Interface()))
+		}
+	}
+
+	if wc.Value == nil {
+		if len(values) > 0 {
+			return true, fmt.Sprintf("%s has a value for %s", name, wc.JSONPath), nil
+		}
+		return false, fmt.Sprintf("%s has no value for %s yet", name, wc.JSONPath), nil
+	}
+	for _, v := range values {
+		if v == *wc.Value {
+			return true, fmt.Sprintf("%s has %s=%s", name, wc.JSONPath, v), nil
+		}
+	}
+	return false, fmt.Sprintf("%s has %s=%v, waiting for %s", name, wc.JSONPath, values, *wc.Value), nil
+}
@@ -0,0 +1,198 @@
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+)
+
+func TestWaitTask_Run(t *testing.T) {
+	meta := renderer.Metadata{
+		Metadata: engine.Metadata{
+			InstanceName:        "test",
+			InstanceNamespace:   "default",
+			OperatorName:        "first-operator",
+			OperatorVersionName: "first-operator-1.0",
+			OperatorVersion:     "1.0",
+		},
+		PlanName:  "plan",
+		PhaseName: "phase",
+		StepName:  "step",
+		TaskName:  "task",
+	}
+
+	pendingPod := pod("pod1", "default")
+	pendingPod.Status = corev1.PodStatus{Phase: corev1.PodPending}
+
+	failedJob := job("job1", "default")
+	failedJob.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+
+	ctx := func(templates map[string]string, objs ...runtime.Object) Context {
+		return Context{
+			Client:    fake.NewFakeClientWithScheme(scheme.Scheme, objs...),
+			Discovery: kudofake.CachedDiscoveryClient(),
+			Enhancer:  &testEnhancer{},
+			Meta:      meta,
+			Templates: templates,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		resource  string
+		condition string
+		ctx       Context
+		done      bool
+		wantErr   bool
+		fatal     bool
+	}{
+		{
+			name:     "is not done when the resource does not exist",
+			resource: "pod",
+			ctx:      ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}),
+			done:     false,
+			wantErr:  false,
+		},
+		{
+			name:     "succeeds when the resource is healthy",
+			resource: "pod",
+			ctx:      ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pod("pod1", "default")),
+			done:     true,
+			wantErr:  false,
+		},
+		{
+			name:     "uses the instance namespace for resources without a namespace",
+			resource: "pod",
+			ctx:      ctx(map[string]string{"pod": resourceAsString(pod("pod1", ""))}, pod("pod1", "default")),
+			done:     true,
+			wantErr:  false,
+		},
+		{
+			name:     "is not done when the resource is unhealthy",
+			resource: "pod",
+			ctx:      ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pendingPod),
+			done:     false,
+			wantErr:  false,
+		},
+		{
+			name:      "succeeds when the condition is met",
+			resource:  "pod",
+			condition: "condition=Ready",
+			ctx:       ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pod("pod1", "default")),
+			done:      true,
+			wantErr:   false,
+		},
+		{
+			name:      "is not done when the condition has another status",
+			resource:  "pod",
+			condition: "condition=Ready=False",
+			ctx:       ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pod("pod1", "default")),
+			done:      false,
+			wantErr:   false,
+		},
+		{
+			name:      "succeeds when the jsonpath has the expected value",
+			resource:  "pod",
+			condition: "jsonpath={.status.phase}=Running",
+			ctx:       ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pod("pod1", "default")),
+			done:      true,
+			wantErr:   false,
+		},
+		{
+			name:      "is not done when the jsonpath has another value",
+			resource:  "pod",
+			condition: "jsonpath={.status.phase}=Running",
+			ctx:       ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pendingPod),
+			done:      false,
+			wantErr:   false,
+		},
+		{
+			name:      "is not done when the jsonpath has no value",
+			resource:  "pod",
+			condition: "jsonpath={.status.podIP}",
+			ctx:       ctx(map[string]string{"pod": resourceAsString(pod("pod1", "default"))}, pod("pod1", "default")),
+			done:      false,
+			wantErr:   false,
+		},
+		{
+			name:     "fails when the resource is terminally failed",
+			resource: "job",
+			ctx:      ctx(map[string]string{"job": resourceAsString(job("job1", "default"))}, failedJob),
+			done:     false,
+			wantErr:  true,
+			fatal:    true,
+		},
+		{
+			name:     "fails when the template is missing",
+			resource: "pod",
+			ctx:      ctx(map[string]string{}),
+			done:     false,
+			wantErr:  true,
+			fatal:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := ParseWaitCondition(tt.condition)
+			assert.NoError(t, err)
+
+			task := WaitTask{
+				Name:      "task",
+				Resources: []string{tt.resource},
+				Condition: condition,
+			}
+
+			got, err := task.Run(tt.ctx)
+			assert.Equal(t, tt.done, got)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.fatal, errors.Is(err, engine.ErrFatalExecution))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseWaitCondition(t *testing.T) {
+	value := func(s string) *string { return &s }
+
+	tests := []struct {
+		condition string
+		want      *WaitCondition
+		wantErr   bool
+	}{
+		{condition: "", want: nil},
+		{condition: "condition=Ready", want: &WaitCondition{ConditionType: "Ready", ConditionStatus: "True"}},
+		{condition: "condition=Available=False", want: &WaitCondition{ConditionType: "Available", ConditionStatus: "False"}},
+		{condition: "jsonpath={.status.phase}=Running", want: &WaitCondition{JSONPath: "{.status.phase}", Value: value("Running")}},
+		{condition: "jsonpath={.status.loadBalancer.ingress[0].ip}", want: &WaitCondition{JSONPath: "{.status.loadBalancer.ingress[0].ip}"}},
+		{condition: "condition=", wantErr: true},
+		{condition: "jsonpath=.status.phase", wantErr: true},
+		{condition: "jsonpath={.status.phase}Running", wantErr: true},
+		{condition: "jsonpath={.status[}", wantErr: true},
+		{condition: "delete", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseWaitCondition(tt.condition)
+		if tt.wantErr {
+			assert.Error(t, err, tt.condition)
+			continue
+		}
+		assert.NoError(t, err, tt.condition)
+		assert.Equal(t, tt.want, got, tt.condition)
+	}
+}
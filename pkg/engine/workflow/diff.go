@@ -0,0 +1,116 @@
+package workflow
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+)
+
+// TaskDiff contains the changes a single task of a plan would make to the cluster
+type TaskDiff struct {
+	Phase string
+	Step  string
+	Task  string
+	Kind  string
+	// Skipped is true if the phase or step of the task is skipped because of its 'when' expression
+	Skipped bool
+	// Supported is false for tasks that can't compute their changes upfront, e.g. Pipe or Exec tasks
+	Supported bool
+	Objects   []task.ObjectDiff
+}
+
+// Diff computes the changes that executing the passed plan would make to the cluster without executing it. All tasks
+// are rendered and enhanced the same way as during the execution and compared with the live objects. As tasks are not
+// executed, objects that depend on the results of earlier tasks, e.g. pipe artifacts, are compared in their current state.
+func Diff(pl *ActivePlan, em *engine.Metadata, c client.Client, r client.Reader, di discovery.CachedDiscoveryInterface, config *rest.Config, scheme *runtime.Scheme) ([]TaskDiff, error) {
+	enh := &renderer.DefaultEnhancer{Scheme: scheme, Client: c, Discovery: di}
+
+	diffs := []TaskDiff{}
+	for _, ph := range pl.Spec.Phases {
+		phaseSkipped, err := pl.skippedByWhen(ph.When, renderer.Metadata{Metadata: *em, PlanName: pl.Name, PhaseName: ph.Name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate 'when' expression of phase %s.%s: %v", pl.Name, ph.Name, err)
+		}
+
+		for _, st := range ph.Steps {
+			stepSkipped := phaseSkipped
+			if !stepSkipped {
+				stepSkipped, err = pl.skippedByWhen(st.When, renderer.Metadata{Metadata: *em, PlanName: pl.Name, PhaseName: ph.Name, StepName: st.Name})
+				if err != nil {
+					return nil, fmt.Errorf("failed to evaluate 'when' expression of step %s.%s.%s: %v", pl.Name, ph.Name, st.Name, err)
+				}
+			}
+
+			for _, tn := range st.Tasks {
+				t, ok := pl.taskByName(tn)
+				if !ok {
+					return nil, fmt.Errorf("missing task %s.%s.%s.%s", pl.Name, ph.Name, st.Name, tn)
+				}
+
+				td := TaskDiff{Phase: ph.Name, Step: st.Name, Task: tn, Kind: t.Kind, Skipped: stepSkipped}
+				if stepSkipped {
+					diffs = append(diffs, td)
+					continue
+				}
+
+				tt, err := task.Build(t)
+				if err != nil {
+					return nil, fmt.Errorf("failed to build task %s.%s.%s.%s: %v", pl.Name, ph.Name, st.Name, tn, err)
+				}
+
+				differ, ok := tt.(task.Differ)
+				td.Supported = ok
+				if !ok {
+					diffs = append(diffs, td)
+					continue
+				}
+
+				ctx := task.Context{
+					Client:    c,
+					Reader:    r,
+					Discovery: di,
+					Config:    config,
+					Scheme:    scheme,
+					Enhancer:  enh,
+					Meta: renderer.Metadata{
+						Metadata:  *em,
+						PlanName:  pl.Name,
+						PhaseName: ph.Name,
+						StepName:  st.Name,
+						TaskName:  tn,
+					},
+					Templates:  pl.Templates,
+					Parameters: pl.Params,
+					Pipes:      pl.Pipes,
+					Previous:   pl.Previous,
+
+					SensitiveParameters: pl.SensitiveParams,
+					HealthChecks:        pl.HealthChecks,
+				}
+
+				td.Objects, err = differ.Diff(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to diff task %s.%s.%s.%s: %v", pl.Name, ph.Name, st.Name, tn, err)
+				}
+				diffs = append(diffs, td)
+			}
+		}
+	}
+	return diffs, nil
+}
+
+// skippedByWhen returns true if a 'when' expression is set and evaluates to false
+func (ap *ActivePlan) skippedByWhen(when string, meta renderer.Metadata) (bool, error) {
+	if when == "" {
+		return false, nil
+	}
+	run, err := ap.evaluateWhen(when, meta)
+	return !run, err
+}
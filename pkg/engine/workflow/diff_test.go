@@ -0,0 +1,71 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery/cached/memory"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+)
+
+func TestDiff(t *testing.T) {
+	instance := instance()
+	meta := &engine.Metadata{
+		InstanceName:        instance.Name,
+		InstanceNamespace:   instance.Namespace,
+		OperatorName:        "first-operator",
+		OperatorVersionName: "first-operator-1.0",
+		OperatorVersion:     "1.0",
+		ResourcesOwner:      instance,
+	}
+
+	activePlan := &ActivePlan{
+		Name:       "deploy",
+		PlanStatus: &kudoapi.PlanStatus{Name: "deploy"},
+		Spec: &kudoapi.Plan{
+			Strategy: kudoapi.Serial,
+			Phases: []kudoapi.Phase{
+				{Name: "phase", Strategy: kudoapi.Serial, Steps: []kudoapi.Step{
+					{Name: "config", Tasks: []string{"app"}},
+					{Name: "wait", Tasks: []string{"dummy"}},
+					{Name: "optional", Tasks: []string{"app"}, When: "{{ eq .Params.OPTIONAL \"true\" }}"},
+				}},
+			},
+		},
+		Tasks: []kudoapi.Task{
+			{Name: "app", Kind: task.ApplyTaskKind, Spec: kudoapi.TaskSpec{ResourceTaskSpec: kudoapi.ResourceTaskSpec{Resources: []string{"cm.yaml"}}}},
+			{Name: "dummy", Kind: task.DummyTaskKind, Spec: kudoapi.TaskSpec{DummyTaskSpec: kudoapi.DummyTaskSpec{Done: true}}},
+		},
+		Templates: map[string]string{"cm.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Name }}-config\ndata:\n  replicas: \"{{ .Params.REPLICAS }}\"\n"},
+		Params:    map[string]interface{}{"REPLICAS": "3", "OPTIONAL": "false"},
+	}
+
+	testScheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	assert.NoError(t, kudoapi.AddToScheme(testScheme))
+
+	testClient := fake.NewFakeClientWithScheme(testScheme)
+	fakeCachedDiscovery := memory.NewMemCacheClient(kudofake.CachedDiscoveryClient())
+
+	diffs, err := Diff(activePlan, meta, testClient, testClient, fakeCachedDiscovery, nil, testScheme)
+	assert.NoError(t, err)
+	assert.Len(t, diffs, 3)
+
+	assert.Equal(t, "app", diffs[0].Task)
+	assert.True(t, diffs[0].Supported)
+	assert.Len(t, diffs[0].Objects, 1)
+	assert.Equal(t, task.DiffCreate, diffs[0].Objects[0].Action)
+	assert.Equal(t, "ConfigMap default/test-instance-config", diffs[0].Objects[0].Object)
+	assert.Contains(t, diffs[0].Objects[0].Desired, "replicas: \"3\"")
+	assert.Contains(t, diffs[0].Objects[0].Desired, "kudo.dev/instance: test-instance")
+
+	assert.Equal(t, TaskDiff{Phase: "phase", Step: "wait", Task: "dummy", Kind: task.DummyTaskKind}, diffs[1])
+	assert.Equal(t, TaskDiff{Phase: "phase", Step: "optional", Task: "app", Kind: task.ApplyTaskKind, Skipped: true}, diffs[2])
+}
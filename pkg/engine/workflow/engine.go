@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
@@ -22,6 +24,9 @@ var (
 	taskBuildError           = "TaskBuildError"
 	missingPhaseStatus       = "MissingPhaseStatus"
 	missingStepStatus        = "MissingStepStatus"
+	executionTimeout         = "ExecutionTimeout"
+	retriesExhausted         = "RetriesExhausted"
+	invalidWhenExpression    = "InvalidWhenExpression"
 )
 
 // ActivePlan wraps over all data that is needed for its execution including tasks, templates, parameters etc.
@@ -33,6 +38,13 @@ type ActivePlan struct {
 	Templates map[string]string
 	Params    map[string]interface{}
 	Pipes     map[string]string
+	Previous  renderer.Previous
+	// SensitiveParams are the names of the sensitive parameters of the OperatorVersion
+	SensitiveParams map[string]bool
+	// HealthChecks of the OperatorVersion
+	HealthChecks []kudoapi.HealthCheck
+	// Applied collects the objects applied during Execute, nil if they are not recorded
+	Applied task.AppliedObjects
 }
 
 func (ap *ActivePlan) taskByName(name string) (*kudoapi.Task, bool) {
@@ -44,6 +56,17 @@ func (ap *ActivePlan) taskByName(name string) (*kudoapi.Task, bool) {
 	return nil, false
 }
 
+// evaluateWhen renders a 'when' expression of a phase or step with the same variables that are available in templates
+func (ap *ActivePlan) evaluateWhen(when string, meta renderer.Metadata) (bool, error) {
+	vals := renderer.NewVariableMap().
+		WithMetadata(meta).
+		WithParameters(ap.Params).
+		WithPipes(ap.Pipes).
+		WithPrevious(ap.Previous)
+
+	return renderer.New().RenderCondition("when", when, vals)
+}
+
 // Execute method takes a currently active plan and Metadata from the underlying operator and executes it.
 // An execution loop iterates through plan phases, steps and tasks, executing them according to the execution strategy
 // (serial/parallel). Task execution might result in success, error and fatal error. It is to distinguish between transient
@@ -64,7 +87,7 @@ func (ap *ActivePlan) taskByName(name string) (*kudoapi.Task, bool) {
 //
 // Furthermore, a transient ERROR during a step execution, means that the next step may be executed if the step strategy
 // is "parallel". In case of a fatal error, it is returned alongside with the new plan status and published on the event bus.
-func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.CachedDiscoveryInterface, config *rest.Config, scheme *runtime.Scheme) (*kudoapi.PlanStatus, error) {
+func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, r client.Reader, di discovery.CachedDiscoveryInterface, config *rest.Config, scheme *runtime.Scheme) (*kudoapi.PlanStatus, error) {
 	if pl.Status.IsTerminal() {
 		log.Printf("PlanExecution: %s/%s plan %s is terminal, nothing to do", em.InstanceNamespace, em.InstanceName, pl.Name)
 		return pl.PlanStatus, nil
@@ -89,13 +112,39 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 			}
 		}
 
-		// Check current phase status: skip if finished, proceed if in progress, break out if a fatal error has occurred
+		// A pending phase whose 'when' expression evaluates to false is skipped together with all its steps
+		if phaseStatus.Status == kudoapi.ExecutionPending && ph.When != "" {
+			exm := renderer.Metadata{Metadata: *em, PlanName: pl.Name, PlanUID: planStatus.UID, PhaseName: ph.Name}
+			run, err := pl.evaluateWhen(ph.When, exm)
+			if err != nil {
+				err := fmt.Errorf("%s/%s %w failed to evaluate 'when' expression of phase %s.%s: %v", em.InstanceNamespace, em.InstanceName, engine.ErrFatalExecution, pl.Name, ph.Name, err)
+
+				phaseStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
+				planStatus.Set(kudoapi.ExecutionFatalError)
+				return planStatus, engine.ExecutionError{
+					Err:       err,
+					EventName: invalidWhenExpression,
+				}
+			}
+			if !run {
+				log.Printf("PlanExecution: %s/%s skipping phase %s.%s, its 'when' expression is false", em.InstanceNamespace, em.InstanceName, pl.Name, ph.Name)
+				phaseStatus.Set(kudoapi.ExecutionSkipped)
+				for i := range phaseStatus.Steps {
+					phaseStatus.Steps[i].Set(kudoapi.ExecutionSkipped)
+				}
+			}
+		}
+
+		// Check current phase status: skip if finished or skipped, proceed if in progress, break out if a fatal error has occurred
 		switch {
-		case phaseStatus.Status.IsFinished():
+		case phaseStatus.Status.IsFinished() || phaseStatus.Status == kudoapi.ExecutionSkipped:
 			phasesLeft--
 			continue
 		case phaseStatus.Status.IsRunning():
 			phaseStatus.Set(kudoapi.ExecutionInProgress)
+			if phaseStatus.StartedTimestamp == nil {
+				phaseStatus.StartedTimestamp = &metav1.Time{Time: time.Now()}
+			}
 		default:
 			break
 		}
@@ -115,18 +164,43 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 				}
 			}
 
-			// Check current phase status: skip if finished, proceed if in progress, break out if a fatal error has occurred
+			// A pending step whose 'when' expression evaluates to false is skipped
+			if stepStatus.Status == kudoapi.ExecutionPending && st.When != "" {
+				exm := renderer.Metadata{Metadata: *em, PlanName: pl.Name, PlanUID: planStatus.UID, PhaseName: ph.Name, StepName: st.Name}
+				run, err := pl.evaluateWhen(st.When, exm)
+				if err != nil {
+					err := fmt.Errorf("%s/%s %w failed to evaluate 'when' expression of step %s.%s.%s: %v", em.InstanceNamespace, em.InstanceName, engine.ErrFatalExecution, pl.Name, ph.Name, st.Name, err)
+
+					stepStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
+					phaseStatus.Set(kudoapi.ExecutionFatalError)
+					planStatus.Set(kudoapi.ExecutionFatalError)
+					return planStatus, engine.ExecutionError{
+						Err:       err,
+						EventName: invalidWhenExpression,
+					}
+				}
+				if !run {
+					log.Printf("PlanExecution: %s/%s skipping step %s.%s.%s, its 'when' expression is false", em.InstanceNamespace, em.InstanceName, pl.Name, ph.Name, st.Name)
+					stepStatus.Set(kudoapi.ExecutionSkipped)
+				}
+			}
+
+			// Check current step status: skip if finished or skipped, proceed if in progress, break out if a fatal error has occurred
 			switch {
-			case stepStatus.Status.IsFinished():
+			case stepStatus.Status.IsFinished() || stepStatus.Status == kudoapi.ExecutionSkipped:
 				delete(stepsLeft, stepStatus.Name)
 				continue
 			case stepStatus.Status.IsRunning():
 				stepStatus.Set(kudoapi.ExecutionInProgress)
+				if stepStatus.StartedTimestamp == nil {
+					stepStatus.StartedTimestamp = &metav1.Time{Time: time.Now()}
+				}
 			default:
 				break
 			}
 
 			tasksLeft := stringArrayToSet(st.Tasks)
+			stepFailed := false
 			// --- 3. Iterate over step tasks ---
 			for _, tn := range st.Tasks {
 				t, ok := pl.taskByName(tn)
@@ -141,6 +215,13 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 						EventName: unknownTaskNameEventName,
 					}
 				}
+				// a task with a retry backoff is not executed until the backoff after the last failed attempt passed
+				if backoff := retryBackoff(t, stepStatus); backoff > 0 {
+					message := fmt.Sprintf("Task %s.%s.%s.%s will be retried in %s after %d failed attempt(s)", pl.Name, ph.Name, st.Name, t.Name, backoff.Round(time.Second), stepStatus.Attempts)
+					stepStatus.SetWithMessage(kudoapi.ErrorStatus, message)
+					continue
+				}
+
 				// - 3.a build execution metadata -
 				exm := renderer.Metadata{
 					Metadata:  *em,
@@ -168,6 +249,7 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 				// - 3.c build task context -
 				ctx := task.Context{
 					Client:     c,
+					Reader:     r,
 					Discovery:  di,
 					Config:     config,
 					Scheme:     scheme,
@@ -176,6 +258,11 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 					Templates:  pl.Templates,
 					Parameters: pl.Params,
 					Pipes:      pl.Pipes,
+					Previous:   pl.Previous,
+
+					SensitiveParameters: pl.SensitiveParams,
+					HealthChecks:        pl.HealthChecks,
+					Applied:             pl.Applied,
 				}
 
 				// --- 4. Execute the engine task ---
@@ -190,6 +277,23 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 					stepStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
 					return planStatus, err
 				case err != nil:
+					// a failed attempt is counted once per step execution, even if multiple tasks fail
+					if !stepFailed {
+						stepFailed = true
+						stepStatus.Attempts++
+						stepStatus.LastAttemptTimestamp = &metav1.Time{Time: time.Now()}
+					}
+					if t.Retry != nil && t.Retry.MaxAttempts > 0 && stepStatus.Attempts >= t.Retry.MaxAttempts {
+						err := fmt.Errorf("%s/%s %w task %s.%s.%s.%s failed after %d attempt(s): %v", em.InstanceNamespace, em.InstanceName, engine.ErrFatalExecution, pl.Name, ph.Name, st.Name, t.Name, stepStatus.Attempts, err)
+
+						phaseStatus.Set(kudoapi.ExecutionFatalError)
+						planStatus.Set(kudoapi.ExecutionFatalError)
+						stepStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
+						return planStatus, engine.ExecutionError{
+							Err:       err,
+							EventName: retriesExhausted,
+						}
+					}
 					message := fmt.Sprintf("A transient error when executing task %s.%s.%s.%s. Will retry. %v", pl.Name, ph.Name, st.Name, t.Name, err)
 					stepStatus.SetWithMessage(kudoapi.ErrorStatus, message)
 					log.Printf("PlanExecution: %s", message)
@@ -202,6 +306,18 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 			// if some TASKs aren't ready yet and STEPs strategy is serial we can not proceed
 			// otherwise, if STEPs strategy is parallel or all TASKs are finished, we can go to the next STEP
 			if len(tasksLeft) > 0 {
+				// a STEP that is not finished in time or has TASKs that are not finished in time is a fatal error
+				if msg := stepTimeout(pl, ph.Name, st, tasksLeft, stepStatus); msg != "" {
+					err := fmt.Errorf("%s/%s %w %s", em.InstanceNamespace, em.InstanceName, engine.ErrFatalExecution, msg)
+
+					stepStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
+					phaseStatus.Set(kudoapi.ExecutionFatalError)
+					planStatus.Set(kudoapi.ExecutionFatalError)
+					return planStatus, engine.ExecutionError{
+						Err:       err,
+						EventName: executionTimeout,
+					}
+				}
 				if ph.Strategy == kudoapi.Serial {
 					log.Printf("PlanExecution: '%s' task(s) (instance: %s/%s) of the %s.%s.%s are not ready", mapKeysToString(tasksLeft), em.InstanceNamespace, em.InstanceName, pl.Name, ph.Name, st.Name)
 					break
@@ -216,6 +332,17 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 		// if some STEPs aren't ready yet and PHASEs strategy is serial we can not proceed
 		// otherwise, if PHASEs strategy is parallel or all STEPs are finished, we can go to the next PHASE
 		if len(stepsLeft) > 0 {
+			// a PHASE that is not finished in time is a fatal error
+			if timedOut(phaseStatus.StartedTimestamp, ph.Timeout) {
+				err := fmt.Errorf("%s/%s %w phase %s.%s timed out after %s", em.InstanceNamespace, em.InstanceName, engine.ErrFatalExecution, pl.Name, ph.Name, ph.Timeout.Duration)
+
+				phaseStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
+				planStatus.Set(kudoapi.ExecutionFatalError)
+				return planStatus, engine.ExecutionError{
+					Err:       err,
+					EventName: executionTimeout,
+				}
+			}
 			if pl.Spec.Strategy == kudoapi.Serial {
 				log.Printf("PlanExecution: '%s' step(s) (instance: %s/%s) of the %s.%s are not ready", mapKeysToString(stepsLeft), em.InstanceNamespace, em.InstanceName, pl.Name, ph.Name)
 				break
@@ -230,11 +357,55 @@ func Execute(pl *ActivePlan, em *engine.Metadata, c client.Client, di discovery.
 	if phasesLeft == 0 {
 		log.Printf("PlanExecution: %s/%s all phases of the plan %s are ready", em.InstanceNamespace, em.InstanceName, pl.Name)
 		planStatus.Set(kudoapi.ExecutionComplete)
+		return planStatus, nil
+	}
+
+	// --- 8. Check if the PLAN is finished in time ---
+	if timedOut(planStatus.StartedTimestamp(), pl.Spec.Timeout) {
+		err := fmt.Errorf("%s/%s %w plan %s timed out after %s", em.InstanceNamespace, em.InstanceName, engine.ErrFatalExecution, pl.Name, pl.Spec.Timeout.Duration)
+
+		planStatus.SetWithMessage(kudoapi.ExecutionFatalError, err.Error())
+		return planStatus, engine.ExecutionError{
+			Err:       err,
+			EventName: executionTimeout,
+		}
 	}
 
 	return planStatus, nil
 }
 
+// stepTimeout returns a message describing the timeout if the step or one of its unfinished tasks took longer than
+// its timeout and an empty string otherwise. Task timeouts are measured from the start of the step.
+func stepTimeout(pl *ActivePlan, phase string, st kudoapi.Step, tasksLeft map[string]bool, stepStatus *kudoapi.StepStatus) string {
+	if timedOut(stepStatus.StartedTimestamp, st.Timeout) {
+		return fmt.Sprintf("step %s.%s.%s timed out after %s", pl.Name, phase, st.Name, st.Timeout.Duration)
+	}
+	for _, tn := range st.Tasks {
+		if t, ok := pl.taskByName(tn); ok && tasksLeft[tn] && timedOut(stepStatus.StartedTimestamp, t.Timeout) {
+			return fmt.Sprintf("task %s.%s.%s.%s timed out after %s", pl.Name, phase, st.Name, tn, t.Timeout.Duration)
+		}
+	}
+	return ""
+}
+
+// retryBackoff returns the remaining duration until the task can be retried after the last failed attempt of the step
+// or zero if the task can be executed right away.
+func retryBackoff(t *kudoapi.Task, stepStatus *kudoapi.StepStatus) time.Duration {
+	if t.Retry == nil || t.Retry.Backoff == nil || stepStatus.LastAttemptTimestamp == nil {
+		return 0
+	}
+	remaining := t.Retry.Backoff.Duration - time.Since(stepStatus.LastAttemptTimestamp.Time)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// timedOut returns true if an execution that started at the given time took longer than the given timeout
+func timedOut(started *metav1.Time, timeout *metav1.Duration) bool {
+	return started != nil && timeout != nil && timeout.Duration > 0 && time.Since(started.Time) > timeout.Duration
+}
+
 // mapKeysToString is helper method for getting map keys as comma separated string
 func mapKeysToString(values map[string]bool) string {
 	keys := make([]string, 0, len(values))
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -181,7 +182,7 @@ func TestExecutePlan(t *testing.T) {
 				Status:               kudoapi.ExecutionInProgress,
 				LastUpdatedTimestamp: &v1.Time{Time: testTime},
 				Phases: []kudoapi.PhaseStatus{{Name: "phase", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{{Status: kudoapi.ErrorStatus, Name: "step",
-					Message: "A transient error when executing task test.phase.step.task. Will retry. dummy error"}}}},
+					Attempts: 1, Message: "A transient error when executing task test.phase.step.task. Will retry. dummy error"}}}},
 			},
 			enhancer: testEnhancer,
 		},
@@ -332,7 +333,7 @@ func TestExecutePlan(t *testing.T) {
 				Status:               kudoapi.ExecutionInProgress,
 				LastUpdatedTimestamp: &v1.Time{Time: testTime},
 				Phases: []kudoapi.PhaseStatus{{Name: "phase", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{
-					{Name: "stepOne", Status: kudoapi.ErrorStatus, Message: "A transient error when executing task test.phase.stepOne.taskOne. Will retry. dummy error"},
+					{Name: "stepOne", Status: kudoapi.ErrorStatus, Attempts: 1, Message: "A transient error when executing task test.phase.stepOne.taskOne. Will retry. dummy error"},
 					{Name: "stepTwo", Status: kudoapi.ExecutionInProgress},
 				}}},
 			},
@@ -381,7 +382,7 @@ func TestExecutePlan(t *testing.T) {
 				Status:               kudoapi.ExecutionInProgress,
 				LastUpdatedTimestamp: &v1.Time{Time: testTime},
 				Phases: []kudoapi.PhaseStatus{{Name: "phase", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{
-					{Name: "stepOne", Status: kudoapi.ErrorStatus, Message: "A transient error when executing task test.phase.stepOne.taskOne. Will retry. dummy error"},
+					{Name: "stepOne", Status: kudoapi.ErrorStatus, Attempts: 1, Message: "A transient error when executing task test.phase.stepOne.taskOne. Will retry. dummy error"},
 					{Name: "stepTwo", Status: kudoapi.ExecutionComplete},
 				}}},
 			},
@@ -479,7 +480,7 @@ func TestExecutePlan(t *testing.T) {
 				Status:               kudoapi.ExecutionInProgress,
 				LastUpdatedTimestamp: &v1.Time{Time: testTime},
 				Phases: []kudoapi.PhaseStatus{
-					{Name: "phaseOne", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ErrorStatus, Message: "A transient error when executing task test.phaseOne.step.taskOne. Will retry. dummy error"}}},
+					{Name: "phaseOne", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ErrorStatus, Attempts: 1, Message: "A transient error when executing task test.phaseOne.step.taskOne. Will retry. dummy error"}}},
 					{Name: "phaseTwo", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ExecutionInProgress}}},
 				},
 			},
@@ -526,7 +527,7 @@ func TestExecutePlan(t *testing.T) {
 				Status:               kudoapi.ExecutionInProgress,
 				LastUpdatedTimestamp: &v1.Time{Time: testTime},
 				Phases: []kudoapi.PhaseStatus{
-					{Name: "phaseOne", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ErrorStatus, Message: "A transient error when executing task test.phaseOne.step.taskOne. Will retry. dummy error"}}},
+					{Name: "phaseOne", Status: kudoapi.ExecutionInProgress, Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ErrorStatus, Attempts: 1, Message: "A transient error when executing task test.phaseOne.step.taskOne. Will retry. dummy error"}}},
 					{Name: "phaseTwo", Status: kudoapi.ExecutionComplete, Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ExecutionComplete}}},
 				},
 			},
@@ -624,8 +625,9 @@ func TestExecutePlan(t *testing.T) {
 	fakeDiscovery := kudofake.CachedDiscoveryClient()
 	fakeCachedDiscovery := memory.NewMemCacheClient(fakeDiscovery)
 	for _, tt := range tests {
-		newStatus, err := Execute(tt.activePlan, tt.metadata, testClient, fakeCachedDiscovery, nil, testScheme)
+		newStatus, err := Execute(tt.activePlan, tt.metadata, testClient, testClient, fakeCachedDiscovery, nil, testScheme)
 		newStatus.LastUpdatedTimestamp = &v1.Time{Time: testTime}
+		clearTimestamps(newStatus)
 
 		if !tt.wantErr && err != nil {
 			t.Errorf("%s: Expecting no error but got one: %v", tt.name, err)
@@ -641,6 +643,263 @@ func TestExecutePlan(t *testing.T) {
 	}
 }
 
+func TestExecutePlanTimeouts(t *testing.T) {
+	instance := instance()
+	meta := &engine.Metadata{
+		InstanceName:        instance.Name,
+		InstanceNamespace:   instance.Namespace,
+		OperatorName:        "first-operator",
+		OperatorVersionName: "first-operator-1.0",
+		OperatorVersion:     "1.0",
+		ResourcesOwner:      instance,
+	}
+
+	minute := &v1.Duration{Duration: time.Minute}
+	started := &v1.Time{Time: time.Now().Add(-2 * time.Minute)}
+
+	// a plan with a single step whose task never finishes
+	activePlan := func(planTimeout, phaseTimeout, stepTimeout, taskTimeout *v1.Duration, started *v1.Time) *ActivePlan {
+		return &ActivePlan{
+			Name: "test",
+			PlanStatus: &kudoapi.PlanStatus{
+				Name:   "test",
+				Status: kudoapi.ExecutionInProgress,
+				Phases: []kudoapi.PhaseStatus{{Name: "phase", Status: kudoapi.ExecutionInProgress, StartedTimestamp: started,
+					Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ExecutionInProgress, StartedTimestamp: started}}}},
+			},
+			Spec: &kudoapi.Plan{
+				Strategy: kudoapi.Serial,
+				Timeout:  planTimeout,
+				Phases: []kudoapi.Phase{
+					{Name: "phase", Strategy: kudoapi.Serial, Timeout: phaseTimeout, Steps: []kudoapi.Step{{Name: "step", Tasks: []string{"task"}, Timeout: stepTimeout}}},
+				},
+			},
+			Tasks: []kudoapi.Task{
+				{
+					Name:    "task",
+					Kind:    "Dummy",
+					Timeout: taskTimeout,
+					Spec: kudoapi.TaskSpec{
+						DummyTaskSpec: kudoapi.DummyTaskSpec{Done: false},
+					},
+				},
+			},
+			Templates: map[string]string{},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		activePlan *ActivePlan
+		wantStatus kudoapi.ExecutionStatus
+		wantErr    string
+	}{
+		{name: "plan without timeouts stays in progress", activePlan: activePlan(nil, nil, nil, nil, started), wantStatus: kudoapi.ExecutionInProgress},
+		{name: "plan within its timeouts stays in progress", activePlan: activePlan(&v1.Duration{Duration: time.Hour}, nil, nil, nil, started), wantStatus: kudoapi.ExecutionInProgress},
+		{name: "plan timeout is a fatal error", activePlan: activePlan(minute, nil, nil, nil, started), wantStatus: kudoapi.ExecutionFatalError, wantErr: "plan test timed out after 1m0s"},
+		{name: "phase timeout is a fatal error", activePlan: activePlan(nil, minute, nil, nil, started), wantStatus: kudoapi.ExecutionFatalError, wantErr: "phase test.phase timed out after 1m0s"},
+		{name: "step timeout is a fatal error", activePlan: activePlan(nil, nil, minute, nil, started), wantStatus: kudoapi.ExecutionFatalError, wantErr: "step test.phase.step timed out after 1m0s"},
+		{name: "task timeout is a fatal error", activePlan: activePlan(nil, nil, nil, minute, started), wantStatus: kudoapi.ExecutionFatalError, wantErr: "task test.phase.step.task timed out after 1m0s"},
+		{name: "timeouts are measured from the recorded start times", activePlan: activePlan(minute, minute, minute, minute, nil), wantStatus: kudoapi.ExecutionInProgress},
+	}
+
+	testClient := fake.NewFakeClientWithScheme(scheme.Scheme)
+	fakeCachedDiscovery := memory.NewMemCacheClient(kudofake.CachedDiscoveryClient())
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			newStatus, err := Execute(tt.activePlan, meta, testClient, testClient, fakeCachedDiscovery, nil, scheme.Scheme)
+
+			assert.Equal(t, tt.wantStatus, newStatus.Status)
+			assert.NotNil(t, newStatus.Phases[0].StartedTimestamp)
+			assert.NotNil(t, newStatus.Phases[0].Steps[0].StartedTimestamp)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecutePlanRetries(t *testing.T) {
+	instance := instance()
+	meta := &engine.Metadata{
+		InstanceName:        instance.Name,
+		InstanceNamespace:   instance.Namespace,
+		OperatorName:        "first-operator",
+		OperatorVersionName: "first-operator-1.0",
+		OperatorVersion:     "1.0",
+		ResourcesOwner:      instance,
+	}
+
+	// a plan with a single step whose task always fails with a transient error
+	activePlan := func(retry *kudoapi.RetryPolicy, attempts int32, lastAttempt *v1.Time) *ActivePlan {
+		return &ActivePlan{
+			Name: "test",
+			PlanStatus: &kudoapi.PlanStatus{
+				Name:   "test",
+				Status: kudoapi.ExecutionInProgress,
+				Phases: []kudoapi.PhaseStatus{{Name: "phase", Status: kudoapi.ExecutionInProgress,
+					Steps: []kudoapi.StepStatus{{Name: "step", Status: kudoapi.ErrorStatus, Attempts: attempts, LastAttemptTimestamp: lastAttempt}}}},
+			},
+			Spec: &kudoapi.Plan{
+				Strategy: kudoapi.Serial,
+				Phases: []kudoapi.Phase{
+					{Name: "phase", Strategy: kudoapi.Serial, Steps: []kudoapi.Step{{Name: "step", Tasks: []string{"task"}}}},
+				},
+			},
+			Tasks: []kudoapi.Task{
+				{
+					Name:  "task",
+					Kind:  "Dummy",
+					Retry: retry,
+					Spec: kudoapi.TaskSpec{
+						DummyTaskSpec: kudoapi.DummyTaskSpec{WantErr: true},
+					},
+				},
+			},
+			Templates: map[string]string{},
+		}
+	}
+
+	backoff := &v1.Duration{Duration: time.Minute}
+	recently := &v1.Time{Time: time.Now().Add(-10 * time.Second)}
+	longAgo := &v1.Time{Time: time.Now().Add(-2 * time.Minute)}
+
+	tests := []struct {
+		name         string
+		activePlan   *ActivePlan
+		wantStatus   kudoapi.ExecutionStatus
+		wantAttempts int32
+		wantErr      string
+		wantMessage  string
+	}{
+		{name: "task without a retry policy is retried forever", activePlan: activePlan(nil, 100, nil), wantStatus: kudoapi.ExecutionInProgress, wantAttempts: 101},
+		{name: "task with attempts left is retried", activePlan: activePlan(&kudoapi.RetryPolicy{MaxAttempts: 3}, 1, nil), wantStatus: kudoapi.ExecutionInProgress, wantAttempts: 2},
+		{name: "task with no attempts left is a fatal error", activePlan: activePlan(&kudoapi.RetryPolicy{MaxAttempts: 3}, 2, nil), wantStatus: kudoapi.ExecutionFatalError, wantAttempts: 3,
+			wantErr: "task test.phase.step.task failed after 3 attempt(s): "},
+		{name: "task is not executed during the backoff", activePlan: activePlan(&kudoapi.RetryPolicy{Backoff: backoff}, 1, recently), wantStatus: kudoapi.ExecutionInProgress, wantAttempts: 1,
+			wantMessage: "Task test.phase.step.task will be retried in "},
+		{name: "task is executed after the backoff", activePlan: activePlan(&kudoapi.RetryPolicy{Backoff: backoff}, 1, longAgo), wantStatus: kudoapi.ExecutionInProgress, wantAttempts: 2,
+			wantMessage: "A transient error when executing task test.phase.step.task. Will retry. "},
+	}
+
+	testClient := fake.NewFakeClientWithScheme(scheme.Scheme)
+	fakeCachedDiscovery := memory.NewMemCacheClient(kudofake.CachedDiscoveryClient())
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			newStatus, err := Execute(tt.activePlan, meta, testClient, testClient, fakeCachedDiscovery, nil, scheme.Scheme)
+
+			step := newStatus.Phases[0].Steps[0]
+			assert.Equal(t, tt.wantStatus, newStatus.Status)
+			assert.Equal(t, tt.wantAttempts, step.Attempts)
+			assert.Contains(t, step.Message, tt.wantMessage)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExecutePlanWhen(t *testing.T) {
+	instance := instance()
+	meta := &engine.Metadata{
+		InstanceName:        instance.Name,
+		InstanceNamespace:   instance.Namespace,
+		OperatorName:        "first-operator",
+		OperatorVersionName: "first-operator-1.0",
+		OperatorVersion:     "1.0",
+		ResourcesOwner:      instance,
+	}
+
+	// a plan with one phase and two steps, each with a single task that is done immediately
+	activePlan := func(phaseWhen, stepWhen string) *ActivePlan {
+		return &ActivePlan{
+			Name: "test",
+			PlanStatus: &kudoapi.PlanStatus{
+				Name:   "test",
+				Status: kudoapi.ExecutionPending,
+				Phases: []kudoapi.PhaseStatus{{Name: "phase", Status: kudoapi.ExecutionPending,
+					Steps: []kudoapi.StepStatus{{Name: "stepOne", Status: kudoapi.ExecutionPending}, {Name: "stepTwo", Status: kudoapi.ExecutionPending}}}},
+			},
+			Spec: &kudoapi.Plan{
+				Strategy: kudoapi.Serial,
+				Phases: []kudoapi.Phase{
+					{Name: "phase", Strategy: kudoapi.Serial, When: phaseWhen, Steps: []kudoapi.Step{
+						{Name: "stepOne", Tasks: []string{"task"}, When: stepWhen},
+						{Name: "stepTwo", Tasks: []string{"task"}},
+					}},
+				},
+			},
+			Tasks: []kudoapi.Task{
+				{
+					Name: "task",
+					Kind: "Dummy",
+					Spec: kudoapi.TaskSpec{
+						DummyTaskSpec: kudoapi.DummyTaskSpec{Done: true},
+					},
+				},
+			},
+			Templates: map[string]string{},
+			Params:    map[string]interface{}{"NODE_COUNT": "5"},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		activePlan  *ActivePlan
+		wantPlan    kudoapi.ExecutionStatus
+		wantPhase   kudoapi.ExecutionStatus
+		wantStepOne kudoapi.ExecutionStatus
+		wantStepTwo kudoapi.ExecutionStatus
+		wantErr     bool
+	}{
+		{name: "phase and steps without 'when' are executed", activePlan: activePlan("", ""),
+			wantPlan: kudoapi.ExecutionComplete, wantPhase: kudoapi.ExecutionComplete, wantStepOne: kudoapi.ExecutionComplete, wantStepTwo: kudoapi.ExecutionComplete},
+		{name: "phase with a true 'when' expression is executed", activePlan: activePlan("{{ gt (atoi .Params.NODE_COUNT) 3 }}", ""),
+			wantPlan: kudoapi.ExecutionComplete, wantPhase: kudoapi.ExecutionComplete, wantStepOne: kudoapi.ExecutionComplete, wantStepTwo: kudoapi.ExecutionComplete},
+		{name: "phase with a false 'when' expression is skipped with all its steps", activePlan: activePlan("{{ gt (atoi .Params.NODE_COUNT) 10 }}", ""),
+			wantPlan: kudoapi.ExecutionComplete, wantPhase: kudoapi.ExecutionSkipped, wantStepOne: kudoapi.ExecutionSkipped, wantStepTwo: kudoapi.ExecutionSkipped},
+		{name: "step with a false 'when' expression is skipped", activePlan: activePlan("", " {{ eq .PlanName \"deploy\" }} "),
+			wantPlan: kudoapi.ExecutionComplete, wantPhase: kudoapi.ExecutionComplete, wantStepOne: kudoapi.ExecutionSkipped, wantStepTwo: kudoapi.ExecutionComplete},
+		{name: "'when' expression that is not a boolean is a fatal error", activePlan: activePlan("", "{{ .Params.NODE_COUNT }}"),
+			wantPlan: kudoapi.ExecutionFatalError, wantPhase: kudoapi.ExecutionFatalError, wantStepOne: kudoapi.ExecutionFatalError, wantStepTwo: kudoapi.ExecutionPending, wantErr: true},
+	}
+
+	testClient := fake.NewFakeClientWithScheme(scheme.Scheme)
+	fakeCachedDiscovery := memory.NewMemCacheClient(kudofake.CachedDiscoveryClient())
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			newStatus, err := Execute(tt.activePlan, meta, testClient, testClient, fakeCachedDiscovery, nil, scheme.Scheme)
+
+			assert.Equal(t, tt.wantErr, err != nil, "unexpected error: %v", err)
+			assert.Equal(t, tt.wantPlan, newStatus.Status)
+			assert.Equal(t, tt.wantPhase, newStatus.Phases[0].Status)
+			assert.Equal(t, tt.wantStepOne, newStatus.Phases[0].Steps[0].Status)
+			assert.Equal(t, tt.wantStepTwo, newStatus.Phases[0].Steps[1].Status)
+		})
+	}
+}
+
+// clearTimestamps removes the phase and step start times and the time of the last failed step attempt, which are set
+// to the current time during the execution
+func clearTimestamps(ps *kudoapi.PlanStatus) {
+	for i := range ps.Phases {
+		ps.Phases[i].StartedTimestamp = nil
+		for j := range ps.Phases[i].Steps {
+			ps.Phases[i].Steps[j].StartedTimestamp = nil
+			ps.Phases[i].Steps[j].LastAttemptTimestamp = nil
+		}
+	}
+}
+
 func instance() *kudoapi.Instance {
 	return &kudoapi.Instance{
 		TypeMeta: v1.TypeMeta{
@@ -0,0 +1,69 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/util/kudo"
+)
+
+// OrphanedObjects returns the live objects of the InstanceStatus.AppliedObjects that a plan with Plan.Prune enabled
+// deletes: objects that still carry the labels of the instance and were last applied by a pruning plan, but are not
+// desired by the plan. Objects last applied by other plans, e.g. a 'backup' plan, are never orphaned. Objects last
+// applied by a phase and step that the pruning plan skipped because of a 'when' expression are kept as well, as the
+// plan didn't get to apply them.
+func OrphanedObjects(i *kudoapi.Instance, ov *kudoapi.OperatorVersion, desired func(live *unstructured.Unstructured) bool, skipped func(phase, step string) bool, c client.Reader) ([]*unstructured.Unstructured, error) {
+	live, err := LiveAppliedObjects(i, c)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := []*unstructured.Unstructured{}
+	for _, o := range i.Status.AppliedObjects {
+		obj, ok := live[o]
+		if !ok || !belongsTo(obj, i) || desired(obj) {
+			continue
+		}
+		annotations := obj.GetAnnotations()
+		if plan, ok := ov.Spec.Plans[annotations[kudo.PlanAnnotation]]; !ok || !plan.Prune {
+			continue
+		}
+		if skipped(annotations[kudo.PhaseAnnotation], annotations[kudo.StepAnnotation]) {
+			continue
+		}
+		orphans = append(orphans, obj)
+	}
+	return orphans, nil
+}
+
+// LiveAppliedObjects returns the existing objects of the InstanceStatus.AppliedObjects
+func LiveAppliedObjects(i *kudoapi.Instance, c client.Reader) (map[kudoapi.AppliedObject]*unstructured.Unstructured, error) {
+	live := map[kudoapi.AppliedObject]*unstructured.Unstructured{}
+	for _, o := range i.Status.AppliedObjects {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(o.APIVersion, o.Kind))
+		err := c.Get(context.TODO(), types.NamespacedName{Namespace: o.Namespace, Name: o.Name}, obj)
+		switch {
+		case apierrors.IsNotFound(err) || meta.IsNoMatchError(err): // the CRD of a custom resource might be gone
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to get %s %s/%s applied by instance %s/%s: %v", o.Kind, o.Namespace, o.Name, i.Namespace, i.Name, err)
+		}
+		live[o] = obj
+	}
+	return live, nil
+}
+
+// belongsTo returns true if the object carries the labels the enhancer adds for the instance
+func belongsTo(obj *unstructured.Unstructured, i *kudoapi.Instance) bool {
+	labels := obj.GetLabels()
+	return obj.GetNamespace() == i.Namespace && labels[kudo.HeritageLabel] == "kudo" && labels[kudo.InstanceLabel] == i.Name
+}
@@ -0,0 +1,243 @@
+package workflow
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+// PreparePlanExecution collects everything that is needed to execute the plan of the passed plan status on the instance.
+// The values of sensitive parameters and of InstanceSpec.ParametersFrom are read using the passed client.
+func PreparePlanExecution(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, activePlanStatus *kudoapi.PlanStatus, meta *engine.Metadata, c client.Reader) (*ActivePlan, error) {
+	planSpec, ok := ov.Spec.Plans[activePlanStatus.Name]
+	if !ok {
+		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not find required plan: '%v'", engine.ErrFatalExecution, activePlanStatus.Name), EventName: "InvalidPlan"}
+	}
+
+	// the referenced Secrets and ConfigMaps might not be created yet, so this error is not fatal and the plan
+	// execution is retried
+	instance, err := resolveParameters(instance, ov, c)
+	if err != nil {
+		return nil, &engine.ExecutionError{Err: fmt.Errorf("could not resolve parameters: %w", err), EventName: "InvalidParams"}
+	}
+	// unlike all other values, the values of sourced parameters are not validated by the admission webhook. A changed
+	// value triggers the plan again, so an invalid one is fatal.
+	if err := validateSourcedParameters(instance, ov); err != nil {
+		return nil, &engine.ExecutionError{Err: fmt.Errorf("%winvalid parameter source: %v", engine.ErrFatalExecution, err), EventName: "InvalidParams"}
+	}
+
+	params, err := ParamsMap(instance, ov)
+	if err != nil {
+		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not parse parameters: %v", engine.ErrFatalExecution, err), EventName: "InvalidParams"}
+	}
+
+	pipes, err := PipesMap(activePlanStatus.Name, &planSpec, ov.Spec.Tasks, meta)
+	if err != nil {
+		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not make task pipes: %v", engine.ErrFatalExecution, err), EventName: "InvalidPlan"}
+	}
+
+	previous, err := PreviousMap(instance, ov)
+	if err != nil {
+		return nil, &engine.ExecutionError{Err: fmt.Errorf("%wcould not parse previous parameters: %v", engine.ErrFatalExecution, err), EventName: "InvalidParams"}
+	}
+
+	return &ActivePlan{
+		Name:       activePlanStatus.Name,
+		Spec:       &planSpec,
+		PlanStatus: activePlanStatus,
+		Tasks:      ov.Spec.Tasks,
+		Templates:  ov.Spec.Templates,
+		Params:     params,
+		Pipes:      pipes,
+		Previous:   previous,
+
+		SensitiveParams: sensitiveParams(ov),
+		HealthChecks:    ov.Spec.HealthChecks,
+	}, nil
+}
+
+// sensitiveParams returns the names of the sensitive parameters of the OperatorVersion
+func sensitiveParams(ov *kudoapi.OperatorVersion) map[string]bool {
+	sensitive := map[string]bool{}
+	for _, p := range ov.Spec.Parameters {
+		if p.IsSensitive() {
+			sensitive[p.Name] = true
+		}
+	}
+	return sensitive
+}
+
+// resolveParameters returns a copy of the instance with all references to sensitive parameter values in its spec and
+// applied snapshot replaced by the actual values and with the values of InstanceSpec.ParametersFrom added to its
+// parameters. The returned instance is only used for rendering and must never be persisted. If there is nothing to
+// resolve, the instance itself is returned.
+func resolveParameters(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, c client.Reader) (*kudoapi.Instance, error) {
+	if !hasSensitiveParameterRefs(instance) && len(instance.Spec.ParametersFrom) == 0 {
+		return instance, nil
+	}
+
+	resolved := instance.DeepCopy()
+	params, err := instance.ResolveSensitiveParameters(instance.Spec.Parameters, ov, c)
+	if err != nil {
+		return nil, err
+	}
+	from, err := instance.ResolveParametersFrom(c)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range from {
+		params[name] = value
+	}
+	resolved.Spec.Parameters = params
+
+	if snapshot := resolved.Status.AppliedSnapshot; snapshot != nil {
+		if snapshot.Parameters, err = instance.ResolveSensitiveParameters(snapshot.Parameters, ov, c); err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+func hasSensitiveParameterRefs(instance *kudoapi.Instance) bool {
+	hasRefs := func(params map[string]string) bool {
+		for _, v := range params {
+			if kudoapi.IsSensitiveParameterRef(v) {
+				return true
+			}
+		}
+		return false
+	}
+	return hasRefs(instance.Spec.Parameters) ||
+		(instance.Status.AppliedSnapshot != nil && hasRefs(instance.Status.AppliedSnapshot.Parameters))
+}
+
+// validateSourcedParameters validates the resolved values of the parameters in InstanceSpec.ParametersFrom
+func validateSourcedParameters(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion) error {
+	for _, p := range ov.Spec.Parameters {
+		p := p
+		if _, ok := instance.Spec.ParametersFrom[p.Name]; !ok {
+			continue
+		}
+		if err := p.ValidateValue(instance.Spec.Parameters[p.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParamsMap generates {{ Params.* }} map of keys and values which is later used during template rendering. References
+// to sensitive parameter values and parameter sources must be resolved before, see resolveParameters.
+func ParamsMap(instance *kudoapi.Instance, operatorVersion *kudoapi.OperatorVersion) (map[string]interface{}, error) {
+	params := make(map[string]interface{}, len(operatorVersion.Spec.Parameters))
+
+	for _, param := range operatorVersion.Spec.Parameters {
+		var value *string
+
+		if v, ok := instance.Spec.Parameters[param.Name]; ok {
+			value = &v
+		} else {
+			value = param.Default
+		}
+
+		var err error
+
+		params[param.Name], err = convert.UnwrapParamValue(value, param.Type)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+// PreviousMap generates {{ Previous.* }} values from the last applied snapshot of the instance which is later used during
+// template rendering. Parameter values are unwrapped using the parameter types of the current OperatorVersion. If the
+// instance has no snapshot yet, all values are empty.
+func PreviousMap(instance *kudoapi.Instance, operatorVersion *kudoapi.OperatorVersion) (renderer.Previous, error) {
+	previous := renderer.Previous{Params: map[string]interface{}{}}
+
+	snapshot := instance.Status.AppliedSnapshot
+	if snapshot == nil {
+		return previous, nil
+	}
+
+	previous.OperatorVersionName = snapshot.OperatorVersion.Name
+	previous.OperatorVersion = snapshot.Version
+	previous.AppVersion = snapshot.AppVersion
+
+	for name, value := range snapshot.Parameters {
+		value := value
+		paramType := kudoapi.StringValueType
+		for _, param := range operatorVersion.Spec.Parameters {
+			if param.Name == name {
+				paramType = param.Type
+			}
+		}
+
+		var err error
+		previous.Params[name], err = convert.UnwrapParamValue(&value, paramType)
+		if err != nil {
+			return previous, err
+		}
+	}
+
+	return previous, nil
+}
+
+// PipesMap generates {{ Pipes.* }} map of keys and values which is later used during template rendering.
+func PipesMap(planName string, plan *kudoapi.Plan, tasks []kudoapi.Task, emeta *engine.Metadata) (map[string]string, error) {
+	taskByName := func(name string) (*kudoapi.Task, bool) {
+		for _, t := range tasks {
+			if t.Name == name {
+				return &t, true
+			}
+		}
+		return nil, false
+	}
+
+	pipes := make(map[string]string)
+
+	for _, ph := range plan.Phases {
+		for _, st := range ph.Steps {
+			for _, tn := range st.Tasks {
+				rmeta := renderer.Metadata{
+					Metadata:  *emeta,
+					PlanName:  planName,
+					PhaseName: ph.Name,
+					StepName:  st.Name,
+					TaskName:  tn,
+				}
+
+				t, ok := taskByName(tn)
+				if !ok {
+					continue
+				}
+
+				var keys []string
+				switch {
+				case t.Kind == task.PipeTaskKind:
+					for _, pipe := range t.Spec.PipeTaskSpec.Pipe {
+						keys = append(keys, pipe.Key)
+					}
+				case t.Kind == task.ExecTaskKind && t.Spec.ExecTaskSpec.Output != nil:
+					keys = append(keys, t.Spec.ExecTaskSpec.Output.Key)
+				}
+
+				for _, key := range keys {
+					if _, ok := pipes[key]; ok {
+						return nil, fmt.Errorf("duplicated pipe key %s", key)
+					}
+					pipes[key] = task.PipeArtifactName(rmeta, key)
+				}
+			}
+		}
+	}
+
+	return pipes, nil
+}
@@ -0,0 +1,350 @@
+package workflow
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+func Test_makePipes(t *testing.T) {
+	meta := &engine.Metadata{
+		InstanceName:        "first-operator-instance",
+		InstanceNamespace:   "default",
+		OperatorName:        "first-operator",
+		OperatorVersionName: "first-operator-1.0",
+		OperatorVersion:     "1.0",
+	}
+
+	tests := []struct {
+		name     string
+		planName string
+		plan     *kudoapi.Plan
+		tasks    []kudoapi.Task
+		emeta    *engine.Metadata
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "no tasks, no pipes",
+			planName: "deploy",
+			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
+				{
+					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
+						{
+							Name: "step", Tasks: []string{}},
+					}},
+			}},
+			tasks: []kudoapi.Task{},
+			emeta: meta,
+			want:  map[string]string{},
+		},
+		{
+			name:     "no pipe tasks, no pipes",
+			planName: "deploy",
+			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
+				{
+					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
+						{
+							Name: "step", Tasks: []string{"task"}},
+					}},
+			}},
+			tasks: []kudoapi.Task{
+				{
+					Name: "task",
+					Kind: "Dummy",
+					Spec: kudoapi.TaskSpec{
+						DummyTaskSpec: kudoapi.DummyTaskSpec{Done: false},
+					},
+				},
+			},
+			emeta: meta,
+			want:  map[string]string{},
+		},
+		{
+			name:     "one pipe task, one pipes element",
+			planName: "deploy",
+			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
+				{
+					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
+						{
+							Name: "step", Tasks: []string{"task"}},
+					}},
+			}},
+			tasks: []kudoapi.Task{
+				{
+					Name: "task",
+					Kind: "Pipe",
+					Spec: kudoapi.TaskSpec{
+						PipeTaskSpec: kudoapi.PipeTaskSpec{
+							Pod: "pipe-pod.yaml",
+							Pipe: []kudoapi.PipeSpec{
+								{
+									File: "foo.txt",
+									Kind: "Secret",
+									Key:  "Foo",
+								},
+							},
+						},
+					},
+				},
+			},
+			emeta: meta,
+			want:  map[string]string{"Foo": "firstoperatorinstance.deploy.phase.step.task.foo"},
+		},
+		{
+			name:     "two pipe tasks, two pipes element",
+			planName: "deploy",
+			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
+				{
+					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
+						{Name: "stepOne", Tasks: []string{"task-one"}},
+						{Name: "stepTwo", Tasks: []string{"task-two"}},
+					}},
+			}},
+			tasks: []kudoapi.Task{
+				{
+					Name: "task-one",
+					Kind: "Pipe",
+					Spec: kudoapi.TaskSpec{
+						PipeTaskSpec: kudoapi.PipeTaskSpec{
+							Pod: "pipe-pod.yaml",
+							Pipe: []kudoapi.PipeSpec{
+								{
+									File: "foo.txt",
+									Kind: "Secret",
+									Key:  "Foo",
+								},
+							},
+						},
+					},
+				},
+				{
+					Name: "task-two",
+					Kind: "Pipe",
+					Spec: kudoapi.TaskSpec{
+						PipeTaskSpec: kudoapi.PipeTaskSpec{
+							Pod: "pipe-pod.yaml",
+							Pipe: []kudoapi.PipeSpec{
+								{
+									File: "bar.txt",
+									Kind: "ConfigMap",
+									Key:  "Bar",
+								},
+							},
+						},
+					},
+				},
+			},
+			emeta: meta,
+			want: map[string]string{
+				"Foo": "firstoperatorinstance.deploy.phase.stepone.taskone.foo",
+				"Bar": "firstoperatorinstance.deploy.phase.steptwo.tasktwo.bar",
+			},
+		},
+		{
+			name:     "one pipe task, duplicated pipe keys",
+			planName: "deploy",
+			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
+				{
+					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
+						{
+							Name: "step", Tasks: []string{"task"}},
+					}},
+			}},
+			tasks: []kudoapi.Task{
+				{
+					Name: "task",
+					Kind: "Pipe",
+					Spec: kudoapi.TaskSpec{
+						PipeTaskSpec: kudoapi.PipeTaskSpec{
+							Pod: "pipe-pod.yaml",
+							Pipe: []kudoapi.PipeSpec{
+								{
+									File: "foo.txt",
+									Kind: "Secret",
+									Key:  "Foo",
+								},
+								{
+									File: "bar.txt",
+									Kind: "ConfigMap",
+									Key:  "Foo",
+								},
+							},
+						},
+					},
+				},
+			},
+			emeta:   meta,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:     "one exec task with output",
+			planName: "deploy",
+			plan: &kudoapi.Plan{Strategy: "serial", Phases: []kudoapi.Phase{
+				{
+					Name: "phase", Strategy: "serial", Steps: []kudoapi.Step{
+						{
+							Name: "step", Tasks: []string{"task"}},
+					}},
+			}},
+			tasks: []kudoapi.Task{
+				{
+					Name: "task",
+					Kind: "Exec",
+					Spec: kudoapi.TaskSpec{
+						ExecTaskSpec: kudoapi.ExecTaskSpec{
+							PodSelector: "app=db",
+							Command:     []string{"nodetool", "status"},
+							Output:      &kudoapi.ExecOutputSpec{Kind: "ConfigMap", Key: "Status"},
+						},
+					},
+				},
+			},
+			emeta: meta,
+			want:  map[string]string{"Status": "firstoperatorinstance.deploy.phase.step.task.status"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PipesMap(tt.planName, tt.plan, tt.tasks, tt.emeta)
+			if err != nil {
+				if !tt.wantErr {
+					t.Fatalf("PipesMap() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPreviousMap(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator-1.0", Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Version:    "1.0",
+			AppVersion: "3.2.1",
+			Parameters: []kudoapi.Parameter{
+				{Name: "replicas", Default: convert.StringPtr("1")},
+				{Name: "labels", Type: kudoapi.MapValueType},
+				{Name: "unset"},
+			},
+		},
+	}
+
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			Parameters: map[string]string{"labels": "foo: bar"},
+		},
+	}
+
+	previous, err := PreviousMap(instance, ov)
+	assert.NoError(t, err)
+	assert.Equal(t, "", previous.OperatorVersionName)
+	assert.Empty(t, previous.Params)
+
+	instance.Status.AppliedSnapshot = &kudoapi.InstanceSnapshot{
+		OperatorVersion: v1.ObjectReference{Name: "foo-operator-1.0", Namespace: "default"},
+		Version:         "1.0",
+		AppVersion:      "3.2.1",
+		Parameters:      map[string]string{"replicas": "1", "labels": "foo: bar"},
+	}
+
+	previous, err = PreviousMap(instance, ov)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo-operator-1.0", previous.OperatorVersionName)
+	assert.Equal(t, "1.0", previous.OperatorVersion)
+	assert.Equal(t, "3.2.1", previous.AppVersion)
+	assert.Equal(t, map[string]interface{}{"replicas": "1", "labels": map[string]interface{}{"foo": "bar"}}, previous.Params)
+}
+
+func TestPreparePlanExecution_SensitiveParameters(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator-1.0", Namespace: "test"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{
+				{Name: "password", Sensitive: convert.BoolPtr(true)},
+			},
+			Plans: map[string]kudoapi.Plan{"deploy": {}},
+		},
+	}
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			Parameters:                map[string]string{"password": "sensitive:password.new"},
+			SensitiveParametersSecret: "test-sensitive-parameters",
+		},
+		Status: kudoapi.InstanceStatus{
+			AppliedSnapshot: &kudoapi.InstanceSnapshot{Parameters: map[string]string{"password": "sensitive:password.old"}},
+		},
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-sensitive-parameters", Namespace: "test"},
+		Data:       map[string][]byte{"password.old": []byte("old-s3cr3t"), "password.new": []byte("new-s3cr3t")},
+	}
+	meta := &engine.Metadata{InstanceName: "test", InstanceNamespace: "test"}
+
+	_, err := PreparePlanExecution(instance, ov, &kudoapi.PlanStatus{Name: "deploy"}, meta, fake.NewFakeClientWithScheme(scheme.Scheme))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, engine.ErrFatalExecution), "a missing secret should be retried")
+
+	plan, err := PreparePlanExecution(instance, ov, &kudoapi.PlanStatus{Name: "deploy"}, meta, fake.NewFakeClientWithScheme(scheme.Scheme, secret))
+	assert.NoError(t, err)
+	assert.Equal(t, "new-s3cr3t", plan.Params["password"])
+	assert.Equal(t, "old-s3cr3t", plan.Previous.Params["password"])
+	assert.Equal(t, map[string]bool{"password": true}, plan.SensitiveParams)
+	assert.Equal(t, "sensitive:password.new", instance.Spec.Parameters["password"], "the instance must keep the references")
+	assert.Equal(t, "sensitive:password.old", instance.Status.AppliedSnapshot.Parameters["password"])
+}
+
+func TestPreparePlanExecution_ParametersFrom(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator-1.0", Namespace: "test"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{{Name: "nodes", Type: kudoapi.IntegerValueType}},
+			Plans:      map[string]kudoapi.Plan{"deploy": {}},
+		},
+	}
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			ParametersFrom: map[string]kudoapi.ParameterSource{
+				"nodes": {ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}, Key: "nodes"}},
+			},
+		},
+	}
+	cm := func(value string) *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "test"},
+			Data:       map[string]string{"nodes": value},
+		}
+	}
+	meta := &engine.Metadata{InstanceName: "test", InstanceNamespace: "test"}
+
+	_, err := PreparePlanExecution(instance, ov, &kudoapi.PlanStatus{Name: "deploy"}, meta, fake.NewFakeClientWithScheme(scheme.Scheme))
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, engine.ErrFatalExecution), "a missing configmap should be retried")
+
+	_, err = PreparePlanExecution(instance, ov, &kudoapi.PlanStatus{Name: "deploy"}, meta, fake.NewFakeClientWithScheme(scheme.Scheme, cm("three")))
+	assert.True(t, errors.Is(err, engine.ErrFatalExecution), "an invalid value is fatal")
+
+	plan, err := PreparePlanExecution(instance, ov, &kudoapi.PlanStatus{Name: "deploy"}, meta, fake.NewFakeClientWithScheme(scheme.Scheme, cm("3")))
+	assert.NoError(t, err)
+	assert.Equal(t, "3", plan.Params["nodes"])
+	assert.Empty(t, instance.Spec.Parameters, "the instance must not be modified")
+}
@@ -15,4 +15,16 @@ func init() {
 	runtime.Must(DefaultMutableFeatureGate.Add(defaultKUDOFeatureGates))
 }
 
-var defaultKUDOFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{}
+const (
+	// ServerSideApply makes Apply tasks use server-side apply instead of client-side three-way merges, unless a
+	// task explicitly opts out.
+	ServerSideApply featuregate.Feature = "ServerSideApply"
+
+	// LookupSecrets allows templates to look up Secrets in the instance namespace with the lookup function.
+	LookupSecrets featuregate.Feature = "LookupSecrets"
+)
+
+var defaultKUDOFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	ServerSideApply: {Default: false, PreRelease: featuregate.Alpha},
+	LookupSecrets:   {Default: false, PreRelease: featuregate.Alpha},
+}
@@ -78,7 +78,8 @@ func IsTerminallyFailed(obj runtime.Object) (terminal bool, msg string, err erro
 // the returned healthy status and an optional reason.
 // When the returned error is non-nil, all other parameters can have undefined values and should not
 // be used.
-// Must be implemented for each type; all unimplemented resources are considered healthy by default.
+// Must be implemented for each type; all unimplemented resources are considered healthy by default. Unstructured
+// objects, e.g. custom resources, are checked using the common status conventions.
 func IsHealthy(obj runtime.Object) (healthy bool, msg string, err error) {
 	if obj == nil {
 		return true, "", nil
@@ -158,6 +159,9 @@ func IsHealthy(obj runtime.Object) (healthy bool, msg string, err error) {
 	case *corev1.Service:
 		return isServiceHealthy(obj)
 
+	case *unstructured.Unstructured:
+		return isHealthyByConventions(obj)
+
 	// unless we build logic for what a healthy object is, assume it's healthy when created.
 	default:
 		return true, fmt.Sprintf("unknown type %s is marked healthy by default", reflect.TypeOf(obj)), nil
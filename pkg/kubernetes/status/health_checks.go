@@ -0,0 +1,204 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+// IsHealthyWithChecks returns whether an object is healthy and a corresponding message. Objects of a kind with one
+// of the passed health checks are healthy when all rules of the check pass. All other objects are checked by IsHealthy.
+func IsHealthyWithChecks(obj runtime.Object, checks []kudoapi.HealthCheck) (healthy bool, msg string, err error) {
+	if obj == nil {
+		return true, "", nil
+	}
+
+	check := healthCheckFor(obj.GetObjectKind().GroupVersionKind(), checks)
+	if check == nil {
+		return IsHealthy(obj)
+	}
+
+	u, err := asUnstructured(obj)
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, r := range check.Rules {
+		passed, msg, err := evaluateHealthRule(u, r)
+		if err != nil {
+			return false, "", err
+		}
+		if !passed {
+			return false, fmt.Sprintf("%s %s is not healthy: %s", u.GetKind(), objectName(u), msg), nil
+		}
+	}
+	return true, fmt.Sprintf("%s %s is marked healthy", u.GetKind(), objectName(u)), nil
+}
+
+// ValidateHealthCheck returns an error if the passed health check is invalid.
+func ValidateHealthCheck(check kudoapi.HealthCheck) error {
+	if check.Kind == "" {
+		return fmt.Errorf("health check for %q has no kind", check.APIVersion)
+	}
+	if _, err := schema.ParseGroupVersion(check.APIVersion); err != nil || check.APIVersion == "" {
+		return fmt.Errorf("health check for %s has an invalid apiVersion %q", check.Kind, check.APIVersion)
+	}
+	if len(check.Rules) == 0 {
+		return fmt.Errorf("health check for %s %s has no rules", check.APIVersion, check.Kind)
+	}
+
+	for i, r := range check.Rules {
+		set := 0
+		if r.Condition != nil {
+			set++
+			if r.Condition.Type == "" {
+				return fmt.Errorf("health check for %s %s: rule %d has a condition without a type", check.APIVersion, check.Kind, i)
+			}
+		}
+		if r.Field != nil {
+			set++
+			if err := jsonpath.New("health").Parse(r.Field.Path); err != nil || r.Field.Path == "" {
+				return fmt.Errorf("health check for %s %s: rule %d has an invalid field path %q", check.APIVersion, check.Kind, i, r.Field.Path)
+			}
+		}
+		if r.ObservedGeneration {
+			set++
+		}
+		if set != 1 {
+			return fmt.Errorf("health check for %s %s: rule %d must have exactly one of condition, field or observedGeneration set", check.APIVersion, check.Kind, i)
+		}
+	}
+	return nil
+}
+
+func healthCheckFor(gvk schema.GroupVersionKind, checks []kudoapi.HealthCheck) *kudoapi.HealthCheck {
+	if gvk.Empty() {
+		return nil
+	}
+	for i, c := range checks {
+		if c.APIVersion == gvk.GroupVersion().String() && c.Kind == gvk.Kind {
+			return &checks[i]
+		}
+	}
+	return nil
+}
+
+func evaluateHealthRule(u *unstructured.Unstructured, r kudoapi.HealthRule) (bool, string, error) {
+	switch {
+	case r.Condition != nil:
+		want := r.Condition.Status
+		if want == "" {
+			want = "True"
+		}
+		status, found := conditionStatus(u, r.Condition.Type)
+		if !found {
+			return false, fmt.Sprintf("condition %s is not set", r.Condition.Type), nil
+		}
+		if !strings.EqualFold(status, want) {
+			return false, fmt.Sprintf("condition %s is %s, expected %s", r.Condition.Type, status, want), nil
+		}
+		return true, "", nil
+
+	case r.Field != nil:
+		j := jsonpath.New("health").AllowMissingKeys(true)
+		if err := j.Parse(r.Field.Path); err != nil {
+			return false, "", fmt.Errorf("invalid health check field path %q: %v", r.Field.Path, err)
+		}
+		results, err := j.FindResults(u.Object)
+		if err != nil {
+			return false, fmt.Sprintf("failed to evaluate %s: %v", r.Field.Path, err), nil
+		}
+		var values []string
+		for _, rs := range results {
+			for _, v := range rs {
+				values = append(values, fmt.Sprint(v.Interface()))
+			}
+		}
+		for _, v := range values {
+			if v == r.Field.Value {
+				return true, "", nil
+			}
+		}
+		return false, fmt.Sprintf("%s is %v, expected %s", r.Field.Path, values, r.Field.Value), nil
+
+	case r.ObservedGeneration:
+		observed, found, err := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+		if err != nil || !found {
+			return false, "status.observedGeneration is not set", nil
+		}
+		if observed != u.GetGeneration() {
+			return false, fmt.Sprintf("generation %d is not observed yet (observed generation is %d)", u.GetGeneration(), observed), nil
+		}
+		return true, "", nil
+
+	default:
+		return false, "", fmt.Errorf("health rule has neither condition, field nor observedGeneration set")
+	}
+}
+
+// isHealthyByConventions checks the health of an object without a specific health check using the common conventions
+// of the Kubernetes ecosystem (as used by kstatus): an object is not healthy while its latest generation is not
+// observed yet, while it has a `Reconciling` or `Stalled` condition with status "True" or a `Ready` condition that
+// is not "True". Objects without any of these are healthy.
+func isHealthyByConventions(u *unstructured.Unstructured) (bool, string, error) {
+	observed, found, err := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	if err == nil && found && observed < u.GetGeneration() {
+		return false, fmt.Sprintf("%s %s is not healthy: generation %d is not observed yet", u.GetKind(), objectName(u), u.GetGeneration()), nil
+	}
+
+	for _, c := range []string{"Reconciling", "Stalled"} {
+		if status, found := conditionStatus(u, c); found && strings.EqualFold(status, "True") {
+			return false, fmt.Sprintf("%s %s is not healthy: condition %s is True", u.GetKind(), objectName(u), c), nil
+		}
+	}
+
+	if status, found := conditionStatus(u, "Ready"); found {
+		if !strings.EqualFold(status, "True") {
+			return false, fmt.Sprintf("%s %s is not healthy: condition Ready is %s", u.GetKind(), objectName(u), status), nil
+		}
+		return true, fmt.Sprintf("%s %s is ready", u.GetKind(), objectName(u)), nil
+	}
+
+	return true, fmt.Sprintf("%s %s is marked healthy by default", u.GetKind(), objectName(u)), nil
+}
+
+// conditionStatus returns the status of a status condition of the passed object and whether it is set
+func conditionStatus(u *unstructured.Unstructured, conditionType string) (string, bool) {
+	conditions, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return "", false
+	}
+	for _, c := range conditions {
+		c, ok := c.(map[string]interface{})
+		if !ok || c["type"] != conditionType {
+			continue
+		}
+		return fmt.Sprint(c["status"]), true
+	}
+	return "", false
+}
+
+func asUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+	u, err := toUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+	return u, nil
+}
+
+func objectName(u *unstructured.Unstructured) string {
+	if u.GetNamespace() == "" {
+		return u.GetName()
+	}
+	return fmt.Sprintf("%s/%s", u.GetNamespace(), u.GetName())
+}
@@ -0,0 +1,178 @@
+package status
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+func TestIsHealthyWithChecks(t *testing.T) {
+	checks := []kudoapi.HealthCheck{
+		{
+			APIVersion: "kafka.strimzi.io/v1beta1",
+			Kind:       "Kafka",
+			Rules: []kudoapi.HealthRule{
+				{ObservedGeneration: true},
+				{Condition: &kudoapi.ConditionHealthRule{Type: "Ready"}},
+				{Field: &kudoapi.FieldHealthRule{Path: "{.status.phase}", Value: "Running"}},
+			},
+		},
+		{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Rules: []kudoapi.HealthRule{
+				{Field: &kudoapi.FieldHealthRule{Path: "{.data.state}", Value: "done"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		input   runtime.Object
+		healthy bool
+		msg     string
+	}{
+		{
+			name:    "all rules pass",
+			input:   customResource("Kafka", 2, 2, "Running", map[string]string{"Ready": "True"}),
+			healthy: true,
+			msg:     "Kafka default/cr is marked healthy",
+		},
+		{
+			name:    "generation is not observed",
+			input:   customResource("Kafka", 2, 1, "Running", map[string]string{"Ready": "True"}),
+			healthy: false,
+			msg:     "Kafka default/cr is not healthy: generation 2 is not observed yet (observed generation is 1)",
+		},
+		{
+			name:    "condition is missing",
+			input:   customResource("Kafka", 2, 2, "Running", nil),
+			healthy: false,
+			msg:     "Kafka default/cr is not healthy: condition Ready is not set",
+		},
+		{
+			name:    "condition has another status",
+			input:   customResource("Kafka", 2, 2, "Running", map[string]string{"Ready": "False"}),
+			healthy: false,
+			msg:     "Kafka default/cr is not healthy: condition Ready is False, expected True",
+		},
+		{
+			name:    "field has another value",
+			input:   customResource("Kafka", 2, 2, "Pending", map[string]string{"Ready": "True"}),
+			healthy: false,
+			msg:     "Kafka default/cr is not healthy: {.status.phase} is [Pending], expected Running",
+		},
+		{
+			name: "typed object with a health check",
+			input: &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "default"},
+				Data:       map[string]string{"state": "running"},
+			},
+			healthy: false,
+			msg:     "ConfigMap default/cm is not healthy: {.data.state} is [running], expected done",
+		},
+		{
+			name:    "kind without a health check falls back to conventions",
+			input:   customResource("Zookeeper", 2, 2, "", map[string]string{"Ready": "False"}),
+			healthy: false,
+			msg:     "Zookeeper default/cr is not healthy: condition Ready is False",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			healthy, msg, err := IsHealthyWithChecks(tt.input, checks)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.healthy, healthy)
+			assert.Equal(t, tt.msg, msg)
+		})
+	}
+}
+
+func TestIsHealthy_conventions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   runtime.Object
+		healthy bool
+	}{
+		{name: "no status", input: customResource("Kafka", 1, 0, "", nil), healthy: true},
+		{name: "ready", input: customResource("Kafka", 1, 1, "", map[string]string{"Ready": "True"}), healthy: true},
+		{name: "not ready", input: customResource("Kafka", 1, 1, "", map[string]string{"Ready": "False"}), healthy: false},
+		{name: "reconciling", input: customResource("Kafka", 1, 1, "", map[string]string{"Reconciling": "True"}), healthy: false},
+		{name: "stalled", input: customResource("Kafka", 1, 1, "", map[string]string{"Stalled": "True"}), healthy: false},
+		{name: "generation not observed", input: customResource("Kafka", 2, 1, "", nil), healthy: false},
+	}
+
+	for _, tt := range tests {
+		healthy, _, err := IsHealthy(tt.input)
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.healthy, healthy, tt.name)
+	}
+}
+
+func TestValidateHealthCheck(t *testing.T) {
+	rules := []kudoapi.HealthRule{{ObservedGeneration: true}}
+
+	tests := []struct {
+		name    string
+		check   kudoapi.HealthCheck
+		wantErr bool
+	}{
+		{name: "valid", check: kudoapi.HealthCheck{APIVersion: "kafka.strimzi.io/v1beta1", Kind: "Kafka", Rules: rules}},
+		{name: "missing kind", check: kudoapi.HealthCheck{APIVersion: "kafka.strimzi.io/v1beta1", Rules: rules}, wantErr: true},
+		{name: "missing apiVersion", check: kudoapi.HealthCheck{Kind: "Kafka", Rules: rules}, wantErr: true},
+		{name: "no rules", check: kudoapi.HealthCheck{APIVersion: "v1", Kind: "Kafka"}, wantErr: true},
+		{name: "empty rule", check: kudoapi.HealthCheck{APIVersion: "v1", Kind: "Kafka", Rules: []kudoapi.HealthRule{{}}}, wantErr: true},
+		{name: "rule with two fields", check: kudoapi.HealthCheck{APIVersion: "v1", Kind: "Kafka", Rules: []kudoapi.HealthRule{
+			{ObservedGeneration: true, Condition: &kudoapi.ConditionHealthRule{Type: "Ready"}},
+		}}, wantErr: true},
+		{name: "condition without type", check: kudoapi.HealthCheck{APIVersion: "v1", Kind: "Kafka", Rules: []kudoapi.HealthRule{
+			{Condition: &kudoapi.ConditionHealthRule{}},
+		}}, wantErr: true},
+		{name: "invalid field path", check: kudoapi.HealthCheck{APIVersion: "v1", Kind: "Kafka", Rules: []kudoapi.HealthRule{
+			{Field: &kudoapi.FieldHealthRule{Path: "{.status[", Value: "Running"}},
+		}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateHealthCheck(tt.check)
+		assert.Equal(t, tt.wantErr, err != nil, "%s: %v", tt.name, err)
+	}
+}
+
+func customResource(kind string, generation, observedGeneration int64, phase string, conditions map[string]string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kafka.strimzi.io/v1beta1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"namespace":  "default",
+			"name":       "cr",
+			"generation": generation,
+		},
+	}}
+
+	status := map[string]interface{}{}
+	if observedGeneration > 0 {
+		status["observedGeneration"] = observedGeneration
+	}
+	if phase != "" {
+		status["phase"] = phase
+	}
+	if conditions != nil {
+		var cc []interface{}
+		for t, s := range conditions {
+			cc = append(cc, map[string]interface{}{"type": t, "status": s})
+		}
+		status["conditions"] = cc
+	}
+	u.Object["status"] = status
+	return u
+}
@@ -7,8 +7,11 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
@@ -17,16 +20,23 @@ import (
 
 // IsReady computes instance readiness based on current state of underlying resources
 // currently readiness examines the following types: Pods, StatefulSets, Deployments, ReplicaSets and DaemonSets
+// and all kinds with a health check in the OperatorVersion.
 // Instance is considered ready if all the resources linked to this instance are also ready (healthy)
-func IsReady(i kudoapi.Instance, c client.Client) (bool, string, error) {
+func IsReady(i kudoapi.Instance, c client.Client, checks []kudoapi.HealthCheck) (bool, string, error) {
 	resources, err := healthResources(c, i.Name, i.Namespace)
 	if err != nil {
 		return false, "", err
 	}
+	checked, err := healthCheckResources(c, i.Namespace, checks, instanceSelector(i.Name))
+	if err != nil {
+		return false, "", err
+	}
+	resources = append(resources, checked...)
+
 	ready := true
 	readinessMessage := ""
 	for _, res := range resources {
-		healthy, msg, err := IsHealthy(res)
+		healthy, msg, err := IsHealthyWithChecks(res, checks)
 		if err != nil {
 			return false, "", err
 		}
@@ -43,14 +53,39 @@ func IsReady(i kudoapi.Instance, c client.Client) (bool, string, error) {
 	return ready, readinessMessage, nil
 }
 
-func healthResources(c client.Client, instanceName, instanceNamespace string) ([]runtime.Object, error) {
-	instanceLabels, err := labels.Parse(fmt.Sprintf("%s=%s,%s=%s", label.InstanceLabel, instanceName, label.HeritageLabel, "kudo"))
-	if err != nil {
-		return nil, fmt.Errorf("unable to create list of labels to define health: %v", err)
+func instanceSelector(instanceName string) labels.Selector {
+	return labels.SelectorFromSet(labels.Set{label.InstanceLabel: instanceName, label.HeritageLabel: "kudo"})
+}
+
+// healthCheckResources lists the objects of all kinds with a health check that belong to the instance. Kinds that
+// are not known to the cluster (yet) are skipped.
+func healthCheckResources(c client.Client, instanceNamespace string, checks []kudoapi.HealthCheck, instanceLabels labels.Selector) ([]runtime.Object, error) {
+	var result []runtime.Object
+	for _, check := range checks {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(schema.FromAPIVersionAndKind(check.APIVersion, check.Kind+"List"))
+
+		err := c.List(context.TODO(), list, &client.ListOptions{Namespace: instanceNamespace, LabelSelector: instanceLabels})
+		if meta.IsNoMatchError(err) {
+			log.Printf("Skipping health check of unknown kind %s %s", check.APIVersion, check.Kind)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to pull resources of type %s %s: %v", check.APIVersion, check.Kind, err)
+		}
+
+		for i := range list.Items {
+			result = append(result, &list.Items[i])
+		}
 	}
+	return result, nil
+}
+
+func healthResources(c client.Client, instanceName, instanceNamespace string) ([]runtime.Object, error) {
+	instanceLabels := instanceSelector(instanceName)
 
 	dList := &appsv1.DeploymentList{}
-	err = c.List(context.TODO(), dList, &client.ListOptions{Namespace: instanceNamespace, LabelSelector: instanceLabels})
+	err := c.List(context.TODO(), dList, &client.ListOptions{Namespace: instanceNamespace, LabelSelector: instanceLabels})
 	if err != nil {
 		return nil, fmt.Errorf("unable to pull resources of type Deployment: %v", err)
 	}
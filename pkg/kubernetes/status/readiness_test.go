@@ -6,7 +6,9 @@ import (
 
 	apps "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -21,25 +23,32 @@ func TestIsReady(t *testing.T) {
 			Namespace: "n",
 		},
 	}
+	checks := []kudoapi.HealthCheck{
+		{APIVersion: "kafka.strimzi.io/v1beta1", Kind: "Kafka", Rules: []kudoapi.HealthRule{{Condition: &kudoapi.ConditionHealthRule{Type: "Ready"}}}},
+	}
 	tests := []struct {
 		name    string
 		isReady bool
 		objs    []runtime.Object
+		checks  []kudoapi.HealthCheck
 	}{
-		{"no linked resources, ready", true, []runtime.Object{}},
-		{"one ready deployment", true, []runtime.Object{readyDeployment()}},
-		{"one not ready deployment", false, []runtime.Object{notReadyDeployment()}},
-		{"one ready and one not ready deployment", false, []runtime.Object{readyDeployment(), notReadyDeployment()}},
+		{"no linked resources, ready", true, []runtime.Object{}, nil},
+		{"one ready deployment", true, []runtime.Object{readyDeployment()}, nil},
+		{"one not ready deployment", false, []runtime.Object{notReadyDeployment()}, nil},
+		{"one ready and one not ready deployment", false, []runtime.Object{readyDeployment(), notReadyDeployment()}, nil},
+		{"one ready custom resource", true, []runtime.Object{kafka("True")}, checks},
+		{"one not ready custom resource", false, []runtime.Object{kafka("False")}, checks},
+		{"custom resource without a health check", true, []runtime.Object{kafka("False")}, nil},
 	}
 	for _, tt := range tests {
-		c := fake.NewFakeClientWithScheme(scheme.Scheme)
+		c := fake.NewFakeClientWithScheme(testScheme())
 		for _, obj := range tt.objs {
 			err := c.Create(context.TODO(), obj)
 			if err != nil {
 				t.Errorf("Error in test setup for %s. %v", tt.name, err)
 			}
 		}
-		ready, _, _ := IsReady(*instance, c)
+		ready, _, _ := IsReady(*instance, c, tt.checks)
 		if ready != tt.isReady {
 			t.Errorf("%s: expected instance to be ready: %t but got ready: %t", tt.name, tt.isReady, ready)
 		}
@@ -91,3 +100,30 @@ func notReadyDeployment() runtime.Object {
 		},
 	}
 }
+
+func kafka(ready string) runtime.Object {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kafka.strimzi.io/v1beta1",
+		"kind":       "Kafka",
+		"metadata": map[string]interface{}{
+			"namespace": "n",
+			"name":      "kafka",
+			"labels":    map[string]interface{}{label.HeritageLabel: "kudo", label.InstanceLabel: "i"},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": ready},
+			},
+		},
+	}}
+}
+
+// testScheme returns a scheme with an unstructured Kafka custom resource
+func testScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = scheme.AddToScheme(s)
+	gv := schema.GroupVersion{Group: "kafka.strimzi.io", Version: "v1beta1"}
+	s.AddKnownTypeWithName(gv.WithKind("Kafka"), &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(gv.WithKind("KafkaList"), &unstructured.UnstructuredList{})
+	return s
+}
@@ -14,6 +14,9 @@ import (
 const (
 	diagCollectExample = `  # collect diagnostics example
   kubectl kudo diagnostics collect --instance flink
+
+  # collect diagnostics into a single archive, diag.tar.gz
+  kubectl kudo diagnostics collect --instance flink --archive
 `
 )
 
@@ -31,6 +34,8 @@ func newDiagnosticsCollectCmd(fs afero.Fs) *cobra.Command {
 	var logSince time.Duration
 	var instance string
 	var outputDir string
+	var archive bool
+	var redactEnvPatterns []string
 	cmd := &cobra.Command{
 		Use:     "collect",
 		Short:   "collect diagnostics",
@@ -41,11 +46,13 @@ func newDiagnosticsCollectCmd(fs afero.Fs) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create kudo client: %v", err)
 			}
-			return diagnostics.Collect(fs, instance, diagnostics.NewOptions(logSince, outputDir), c, &Settings)
+			return diagnostics.Collect(fs, instance, diagnostics.NewOptions(logSince, outputDir, archive, redactEnvPatterns), c, &Settings)
 		},
 	}
 	cmd.Flags().StringVarP(&outputDir, "output-directory", "O", diagnostics.DefaultDiagDir, "The output directory. Defaults to 'diag'")
 	cmd.Flags().StringVar(&instance, "instance", "", "The instance name.")
+	cmd.Flags().BoolVar(&archive, "archive", false, "Write the collected data into a single gzipped tarball <output-directory>.tar.gz instead of a directory.")
+	cmd.Flags().StringArrayVar(&redactEnvPatterns, "redact-env", nil, fmt.Sprintf("Regular expression matching the names of environment variables whose values are masked. Can be repeated. Defaults to %v", diagnostics.DefaultRedactEnvPatterns))
 	cmd.Flags().DurationVar(&logSince, "log-since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs.")
 
 	_ = cobra.MarkFlagRequired(cmd.Flags(), "instance")
@@ -0,0 +1,91 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// archive writes the diagnostics directory into a single gzipped tarball and removes the directory afterwards.
+// The tarball entries keep the directory as their prefix, so that the archive unpacks into the same tree.
+func archive(fs afero.Fs, dir, target string) error {
+	file, err := fs.Create(target)
+	if err != nil {
+		return fmt.Errorf("failed to create archive %s: %v", target, err)
+	}
+	defer file.Close()
+
+	r, w := io.Pipe()
+	go func() {
+		_ = w.CloseWithError(writeTar(fs, dir, w))
+	}()
+
+	if err := newGzipWriter(file).write(r); err != nil {
+		return fmt.Errorf("failed to write archive %s: %v", target, err)
+	}
+
+	if err := removeDir(fs, dir); err != nil {
+		return fmt.Errorf("failed to remove %s after archiving it: %v", dir, err)
+	}
+	return nil
+}
+
+// removeDir removes a directory and its content. Unlike fs.RemoveAll, it doesn't remove the archive next to the
+// directory in an afero.MemMapFs, which removes all paths starting with the passed one.
+func removeDir(fs afero.Fs, dir string) error {
+	var paths []string
+	err := afero.Walk(fs, dir, func(path string, _ os.FileInfo, err error) error {
+		paths = append(paths, path)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	// remove the content of a directory before the directory itself
+	for i := len(paths) - 1; i >= 0; i-- {
+		if err := fs.Remove(paths[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTar writes all regular files of the directory as a tarball into the writer
+func writeTar(fs afero.Fs, dir string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	err := afero.Walk(fs, dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return fmt.Errorf("failed to create tar header for %s: %v", path, err)
+		}
+		header.Name = filepath.ToSlash(path)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		f, err := fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
@@ -105,7 +105,11 @@ type logsCollector struct {
 func (c *logsCollector) collect(printer *nonFailingPrinter) error {
 	clog.V(0).Printf("Collect Logs for %d pods", len(c.pods()))
 	for _, pod := range c.pods() {
-		for _, container := range pod.Spec.Containers {
+		// init containers are included for the pipe pods, which create their artifacts in an init container
+		containers := make([]v1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+		containers = append(containers, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		for _, container := range containers {
 			log, err := c.loadLogFn(pod.Name, container.Name)
 			if err != nil {
 				printer.printError(err, filepath.Join(c.parentDir(), fmt.Sprintf("pod_%s", pod.Name)), fmt.Sprintf("%s.log", container.Name))
@@ -118,6 +122,31 @@ func (c *logsCollector) collect(printer *nonFailingPrinter) error {
 	return nil
 }
 
+var _ collector = &metricsCollector{}
+
+// metricsCollector - collects the metrics exposed by each pod
+type metricsCollector struct {
+	loadMetricsFn func(string) ([]byte, error)
+	pods          func() []v1.Pod
+	parentDir     stringGetter
+}
+
+func (c *metricsCollector) collect(printer *nonFailingPrinter) error {
+	clog.V(0).Printf("Collect Metrics for %d pods", len(c.pods()))
+	for _, pod := range c.pods() {
+		dir := filepath.Join(c.parentDir(), fmt.Sprintf("pod_%s", pod.Name))
+		metrics, err := c.loadMetricsFn(pod.Name)
+		if err != nil {
+			printer.printError(fmt.Errorf("failed to retrieve metrics of pod %s: %v", pod.Name, err), dir, "metrics")
+			continue
+		}
+		if len(metrics) > 0 {
+			printer.printFile(metrics, dir, "metrics.txt")
+		}
+	}
+	return nil
+}
+
 var _ collector = &objCollector{}
 
 type objCollector struct {
@@ -16,27 +16,42 @@ import (
 const DefaultDiagDir = "diag"
 
 type Options struct {
-	LogSince  *int64
-	outputDir string
+	LogSince          *int64
+	outputDir         string
+	archive           bool
+	redactEnvPatterns []string
 }
 
 func (o *Options) DiagDir() string {
 	return o.outputDir
 }
 
+// ArchivePath is the gzipped tarball the diagnostics directory is archived to
+func (o *Options) ArchivePath() string {
+	return fmt.Sprintf("%s.tar.gz", o.DiagDir())
+}
+
 func (o *Options) KudoDir() string {
 	return path.Join(o.DiagDir(), "kudo")
 }
 
 func NewDefaultOptions() *Options {
 	return &Options{
-		LogSince:  nil,
-		outputDir: DefaultDiagDir,
+		LogSince:          nil,
+		outputDir:         DefaultDiagDir,
+		redactEnvPatterns: DefaultRedactEnvPatterns,
 	}
 }
 
-func NewOptions(logSince time.Duration, outputDir string) *Options {
+// NewOptions creates the options for the collection of diagnostics. If archive is true, the collected data is
+// archived to a single gzipped tarball. The values of environment variables whose names match one of the
+// redactEnvPatterns are masked, if no patterns are passed DefaultRedactEnvPatterns are used.
+func NewOptions(logSince time.Duration, outputDir string, archive bool, redactEnvPatterns []string) *Options {
 	opts := NewDefaultOptions()
+	opts.archive = archive
+	if len(redactEnvPatterns) > 0 {
+		opts.redactEnvPatterns = redactEnvPatterns
+	}
 	if logSince > 0 {
 		sec := int64(logSince.Round(time.Second).Seconds())
 		opts.LogSince = &sec
@@ -51,7 +66,11 @@ func Collect(fs afero.Fs, instance string, options *Options, c *kudo.Client, s *
 	if err := verifyDiagDirNotExists(fs, options); err != nil {
 		return err
 	}
-	p := &nonFailingPrinter{fs: fs}
+	r, err := newRedactor(options.redactEnvPatterns, c.KubeClientset)
+	if err != nil {
+		return err
+	}
+	p := &nonFailingPrinter{fs: fs, redactor: r}
 
 	if err := diagForInstance(instance, options, c, version.Get(), s, p); err != nil {
 		p.errors = append(p.errors, err.Error())
@@ -59,6 +78,12 @@ func Collect(fs afero.Fs, instance string, options *Options, c *kudo.Client, s *
 	if err := diagForKudoManager(options, c, p); err != nil {
 		p.errors = append(p.errors, err.Error())
 	}
+	p.errors = append(p.errors, r.errors...)
+	if options.archive {
+		if err := archive(fs, options.DiagDir(), options.ArchivePath()); err != nil {
+			p.errors = append(p.errors, err.Error())
+		}
+	}
 	if len(p.errors) > 0 {
 		return fmt.Errorf(strings.Join(p.errors, "\n"))
 	}
@@ -73,5 +98,14 @@ func verifyDiagDirNotExists(fs afero.Fs, options *Options) error {
 	if exists {
 		return fmt.Errorf("target directory %s already exists", options.DiagDir())
 	}
+	if options.archive {
+		exists, err := afero.Exists(fs, options.ArchivePath())
+		if err != nil {
+			return fmt.Errorf("failed to verify that target archive %s doesn't exist: %v", options.ArchivePath(), err)
+		}
+		if exists {
+			return fmt.Errorf("target archive %s already exists", options.ArchivePath())
+		}
+	}
 	return nil
 }
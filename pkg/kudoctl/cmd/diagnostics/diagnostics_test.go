@@ -45,6 +45,11 @@ const (
 	zkPod1File               = "diag/operator_zookeeper/instance_zookeeper-instance/pod_zookeeper-instance-zookeeper-1/zookeeper-instance-zookeeper-1.yaml"
 	zkLog1Container1File     = "diag/operator_zookeeper/instance_zookeeper-instance/pod_zookeeper-instance-zookeeper-1/kubernetes-zookeeper.log.gz"
 	zkStatefulSetsFile       = "diag/operator_zookeeper/instance_zookeeper-instance/statefulsetlist.yaml"
+	zkPVCsFile               = "diag/operator_zookeeper/instance_zookeeper-instance/persistentvolumeclaimlist.yaml"
+	zkPVsFile                = "diag/operator_zookeeper/instance_zookeeper-instance/persistentvolumelist.yaml"
+	zkConfigMapsFile         = "diag/operator_zookeeper/instance_zookeeper-instance/configmaplist.yaml"
+	zkSecretsFile            = "diag/operator_zookeeper/instance_zookeeper-instance/secretlist.yaml"
+	zkEventsFile             = "diag/operator_zookeeper/instance_zookeeper-instance/eventlist.yaml"
 	childOperatorFile        = "diag/operator_zookeeper/operator_zookeeper-instance-child/zookeeper-instance-child.yaml"
 	childOperatorVersionFile = "diag/operator_zookeeper/operator_zookeeper-instance-child/operatorversion_zookeeper-instance-child-0.1.0/zookeeper-instance-child-0.1.0.yaml"
 	childInstanceFile        = "diag/operator_zookeeper/operator_zookeeper-instance-child/instance_zookeeper-instance-child-instance/zookeeper-instance-child-instance.yaml"
@@ -55,6 +60,7 @@ const (
 	kmServiceAccountsFile    = "diag/kudo/serviceaccountlist.yaml"
 	kmStatefulSetsFile       = "diag/kudo/statefulsetlist.yaml"
 	settingsFile             = "diag/settings.yaml"
+	summaryFile              = "diag/summary.md"
 )
 
 // defaultFileNames - all the files that should be created if no error happens
@@ -72,6 +78,11 @@ func defaultFileNames() map[string]struct{} {
 		zkPod1File:               {},
 		zkLog1Container1File:     {},
 		zkStatefulSetsFile:       {},
+		zkPVCsFile:               {},
+		zkPVsFile:                {},
+		zkConfigMapsFile:         {},
+		zkSecretsFile:            {},
+		zkEventsFile:             {},
 		childOperatorFile:        {},
 		childOperatorVersionFile: {},
 		childInstanceFile:        {},
@@ -82,6 +93,7 @@ func defaultFileNames() map[string]struct{} {
 		kmServiceAccountsFile:    {},
 		kmStatefulSetsFile:       {},
 		settingsFile:             {},
+		summaryFile:              {},
 	}
 }
 
@@ -94,6 +106,9 @@ var (
 	statefulsets         appsv1.StatefulSetList
 	pvs                  corev1.PersistentVolumeList
 	pvcs                 corev1.PersistentVolumeClaimList
+	configMaps           corev1.ConfigMapList
+	secrets              corev1.SecretList
+	events               corev1.EventList
 	operator             kudoapi.Operator
 	operatorVersion      kudoapi.OperatorVersion
 	instance             kudoapi.Instance
@@ -157,6 +172,9 @@ func init() {
 	mustReadObjectFromYaml(osFs, "testdata/zk_statefulsets.yaml", &statefulsets, check)
 	mustReadObjectFromYaml(osFs, "testdata/zk_pvs.yaml", &pvs, check)
 	mustReadObjectFromYaml(osFs, "testdata/zk_pvcs.yaml", &pvcs, check)
+	mustReadObjectFromYaml(osFs, "testdata/zk_configmaps.yaml", &configMaps, check)
+	mustReadObjectFromYaml(osFs, "testdata/zk_secrets.yaml", &secrets, check)
+	mustReadObjectFromYaml(osFs, "testdata/zk_events.yaml", &events, check)
 	mustReadObjectFromYaml(osFs, "testdata/zk_operator.yaml", &operator, check)
 	mustReadObjectFromYaml(osFs, "testdata/zk_operatorversion.yaml", &operatorVersion, check)
 	mustReadObjectFromYaml(osFs, "testdata/zk_instance.yaml", &instance, check)
@@ -180,6 +198,9 @@ func init() {
 		append(&statefulsets).
 		append(&pvs).
 		append(&pvcs).
+		append(&configMaps).
+		append(&secrets).
+		append(&events).
 		append(&kmNs).
 		append(&kmPod).
 		append(&kmServices).
@@ -241,6 +262,11 @@ func TestCollect_OK(t *testing.T) {
 		collectedKmServices           corev1.ServiceList
 		collectedKmServiceAccounts    corev1.ServiceAccountList
 		collectedKmStatefulsets       appsv1.StatefulSetList
+		collectedPVCs                 corev1.PersistentVolumeClaimList
+		collectedPVs                  corev1.PersistentVolumeList
+		collectedConfigMaps           corev1.ConfigMapList
+		collectedSecrets              corev1.SecretList
+		collectedEvents               corev1.EventList
 	)
 
 	// read the created files and assert no error
@@ -259,6 +285,11 @@ func TestCollect_OK(t *testing.T) {
 	mustReadObjectFromYaml(fs, kmPodFile, &collectedKmPod, assertNilError(t))
 	mustReadObjectFromYaml(fs, kmServiceAccountsFile, &collectedKmServiceAccounts, assertNilError(t))
 	mustReadObjectFromYaml(fs, kmStatefulSetsFile, &collectedKmStatefulsets, assertNilError(t))
+	mustReadObjectFromYaml(fs, zkPVCsFile, &collectedPVCs, assertNilError(t))
+	mustReadObjectFromYaml(fs, zkPVsFile, &collectedPVs, assertNilError(t))
+	mustReadObjectFromYaml(fs, zkConfigMapsFile, &collectedConfigMaps, assertNilError(t))
+	mustReadObjectFromYaml(fs, zkSecretsFile, &collectedSecrets, assertNilError(t))
+	mustReadObjectFromYaml(fs, zkEventsFile, &collectedEvents, assertNilError(t))
 
 	// verify the correctness of the created files by comparison of the objects read from those to the original objects
 	assert.Equal(t, operator, collectedOperator)
@@ -276,6 +307,32 @@ func TestCollect_OK(t *testing.T) {
 	assert.Equal(t, kmPod, collectedKmPod)
 	assert.Equal(t, kmServiceAccounts, collectedKmServiceAccounts)
 	assert.Equal(t, kmStatefulsets, collectedKmStatefulsets)
+	assert.Equal(t, pvcs, collectedPVCs)
+
+	// only the volumes bound to the claims of the instance are collected
+	assert.Equal(t, 2, len(collectedPVs.Items))
+	assert.Equal(t, pvs.Items[0], collectedPVs.Items[0])
+	assert.Equal(t, pvs.Items[1], collectedPVs.Items[1])
+
+	// only the keys of config maps and secrets are collected, values and last applied configurations are redacted
+	assert.Equal(t, 2, len(collectedConfigMaps.Items))
+	assert.Equal(t, map[string]string{"zoo.cfg": redactedValue}, collectedConfigMaps.Items[0].Data)
+	assert.Equal(t, map[string]string{"kudo.dev/plan": "deploy"}, collectedConfigMaps.Items[0].Annotations)
+	assert.Equal(t, map[string][]byte{"result.txt": []byte(redactedValue)}, collectedConfigMaps.Items[1].BinaryData)
+	assert.Equal(t, 1, len(collectedSecrets.Items))
+	assert.Equal(t, map[string][]byte{"password": []byte(redactedValue)}, collectedSecrets.Items[0].Data)
+	assert.Empty(t, collectedSecrets.Items[0].Annotations)
+
+	// only the events of collected objects are collected, oldest first
+	assert.Equal(t, 2, len(collectedEvents.Items))
+	assert.Equal(t, events.Items[1], collectedEvents.Items[0])
+	assert.Equal(t, events.Items[0], collectedEvents.Items[1])
+
+	summary, err := afero.ReadFile(fs, summaryFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(summary), "# Instance my-namespace/zookeeper-instance")
+	assert.Contains(t, string(summary), "- event Pod/zookeeper-instance-zookeeper-0 (Unhealthy): Readiness probe failed")
+	assert.NotContains(t, string(summary), "BackOff")
 }
 
 // Fatal error
@@ -511,7 +568,7 @@ func TestNewOptions(t *testing.T) {
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.desc, func(t *testing.T) {
-			opts := NewOptions(tt.logSince, tt.outputDir)
+			opts := NewOptions(tt.logSince, tt.outputDir, false, nil)
 			assert.True(t, (tt.exp > 0) == (opts.LogSince != nil))
 			if tt.exp > 0 {
 				assert.Equal(t, tt.exp, *opts.LogSince)
@@ -27,12 +27,15 @@ const (
 
 // nonFailingPrinter - print provided data into provided directory and accumulate errors instead of returning them.
 // Creates a nested directory if an object type requires so.
+// All objects, logs and files are redacted before they are printed.
 type nonFailingPrinter struct {
-	fs     afero.Fs
-	errors []string
+	fs       afero.Fs
+	redactor *redactor
+	errors   []string
 }
 
 func (p *nonFailingPrinter) printObject(obj runtime.Object, parentDir string, mode printMode) {
+	obj = p.redactor.redact(obj)
 	switch mode {
 	case ObjectWithDir:
 		if err := printSingleObject(p.fs, obj, parentDir); err != nil {
@@ -65,7 +68,14 @@ func (p *nonFailingPrinter) printError(err error, parentDir, name string) {
 }
 
 func (p *nonFailingPrinter) printLog(log io.ReadCloser, parentDir, name string) {
-	if err := doPrint(p.fs, gzipStreamWriter{log}.write, parentDir, fmt.Sprintf("%s.log.gz", name)); err != nil {
+	if err := doPrint(p.fs, gzipStreamWriter{p.redactor.scrubStream(log)}.write, parentDir, fmt.Sprintf("%s.log.gz", name)); err != nil {
+		p.errors = append(p.errors, err.Error())
+	}
+}
+
+func (p *nonFailingPrinter) printFile(b []byte, parentDir, name string) {
+	b = []byte(p.redactor.scrub(string(b)))
+	if err := doPrint(p.fs, byteWriter{b}.write, parentDir, name); err != nil {
 		p.errors = append(p.errors, err.Error())
 	}
 }
@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 )
@@ -17,7 +20,12 @@ type processingContext struct {
 	opName        string
 	opVersionName string
 	instanceName  string
+	instanceObj   *kudoapi.Instance
 	pods          []v1.Pod
+	pvcs          []v1.PersistentVolumeClaim
+	events        []v1.Event
+	objects       map[string]struct{} // collected objects as <kind>/<name>, used to find the events involving them
+	redactor      *redactor
 }
 
 func (ctx *processingContext) rootDirectory() string {
@@ -33,15 +41,57 @@ func (ctx *processingContext) instanceDirectory() string {
 }
 
 func (ctx *processingContext) setOperatorNameFromOperatorVersion(obj runtime.Object) {
-	ctx.opName = obj.(*kudoapi.OperatorVersion).Spec.Operator.Name
+	ov := obj.(*kudoapi.OperatorVersion)
+	ctx.opName = ov.Spec.Operator.Name
+	// the instance is printed after its operator version is collected, its sensitive parameters are known by then
+	ctx.redactor.addOperatorVersion(ov)
 }
 
 func (ctx *processingContext) setOperatorVersionNameFromInstance(obj runtime.Object) {
-	ctx.opVersionName = obj.(*kudoapi.Instance).Spec.OperatorVersion.Name
+	ctx.instanceObj = obj.(*kudoapi.Instance)
+	ctx.opVersionName = ctx.instanceObj.Spec.OperatorVersion.Name
+	ctx.track("Instance", ctx.instanceObj.Name)
 }
 
 func (ctx *processingContext) setPods(o runtime.Object) {
 	ctx.pods = o.(*v1.PodList).Items
+	ctx.trackObjects(o)
+}
+
+func (ctx *processingContext) setPVCs(o runtime.Object) {
+	ctx.pvcs = o.(*v1.PersistentVolumeClaimList).Items
+	ctx.trackObjects(o)
+}
+
+func (ctx *processingContext) setEvents(o runtime.Object) {
+	ctx.events = o.(*v1.EventList).Items
+}
+
+// trackObjects remembers the items of a list of kube objects, so that the events involving them can be collected
+func (ctx *processingContext) trackObjects(o runtime.Object) {
+	_ = meta.EachListItem(o, func(item runtime.Object) error {
+		gvks, _, err := scheme.Scheme.ObjectKinds(item)
+		if err != nil || len(gvks) == 0 {
+			return nil
+		}
+		if m, ok := item.(metav1.Object); ok {
+			ctx.track(gvks[0].Kind, m.GetName())
+		}
+		return nil
+	})
+}
+
+func (ctx *processingContext) track(kind, name string) {
+	if ctx.objects == nil {
+		ctx.objects = map[string]struct{}{}
+	}
+	ctx.objects[fmt.Sprintf("%s/%s", kind, name)] = struct{}{}
+}
+
+// involves returns true if the passed event is about one of the collected objects
+func (ctx *processingContext) involves(e v1.Event) bool {
+	_, ok := ctx.objects[fmt.Sprintf("%s/%s", e.InvolvedObject.Kind, e.InvolvedObject.Name)]
+	return ok
 }
 
 func (ctx *processingContext) operatorVersionName() string {
@@ -55,3 +105,7 @@ func (ctx *processingContext) operatorName() string {
 func (ctx *processingContext) podList() []v1.Pod {
 	return ctx.pods
 }
+
+func (ctx *processingContext) pvcList() []v1.PersistentVolumeClaim {
+	return ctx.pvcs
+}
@@ -0,0 +1,344 @@
+package diagnostics
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	kudoutil "github.com/kudobuilder/kudo/pkg/util/kudo"
+)
+
+const (
+	// redactedValue replaces all masked values
+	redactedValue = "REDACTED"
+	// minScrubLength - shorter values of sensitive parameters are not scrubbed from the output, as they would mask
+	// unrelated text
+	minScrubLength = 4
+)
+
+// DefaultRedactEnvPatterns - the values of environment variables whose names match one of these patterns are masked
+var DefaultRedactEnvPatterns = []string{
+	`(?i)passw(or)?d`,
+	`(?i)secret`,
+	`(?i)token`,
+	`(?i)credential`,
+	`(?i)(api|access|private)[-_]?key`,
+}
+
+// redactor - masks sensitive data in collected objects before they are printed:
+// - the values of secrets and config maps, only the keys are kept
+// - the last applied configurations of all objects
+// - the values of container environment variables whose names match one of the patterns
+// - the values of instance parameters flagged as sensitive in the operator version
+// Values of sensitive parameters are additionally scrubbed from everything printed after the instance, e.g. from
+// container arguments and logs. Values stored in the sensitive parameters Secret of an instance are read with the
+// passed client to scrub them as well. A nil redactor doesn't mask anything.
+type redactor struct {
+	client      kubernetes.Interface
+	envPatterns []*regexp.Regexp
+	// sensitive parameter names by operator version, as <namespace>/<name>
+	sensitiveParams map[string]map[string]struct{}
+	// values of sensitive parameters to scrub from the output
+	values []string
+	// errors resolving references to sensitive parameter values, the referenced values are not scrubbed
+	errors []string
+}
+
+func newRedactor(envPatterns []string, client kubernetes.Interface) (*redactor, error) {
+	r := &redactor{client: client, sensitiveParams: map[string]map[string]struct{}{}}
+	for _, p := range envPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %v", p, err)
+		}
+		r.envPatterns = append(r.envPatterns, re)
+	}
+	return r, nil
+}
+
+// addOperatorVersion remembers the sensitive parameters of an operator version, it must be called before the
+// instances of the operator version are redacted
+func (r *redactor) addOperatorVersion(ov *kudoapi.OperatorVersion) {
+	if r == nil {
+		return
+	}
+	names := map[string]struct{}{}
+	for _, p := range ov.Spec.Parameters {
+		p := p
+		if p.IsSensitive() {
+			names[p.Name] = struct{}{}
+		}
+	}
+	r.sensitiveParams[fmt.Sprintf("%s/%s", ov.Namespace, ov.Name)] = names
+}
+
+// redact returns a copy of the passed object or list with all sensitive data masked
+func (r *redactor) redact(obj runtime.Object) runtime.Object {
+	if r == nil {
+		return obj
+	}
+
+	obj = obj.DeepCopyObject()
+	if meta.IsListType(obj) {
+		_ = meta.EachListItem(obj, func(item runtime.Object) error {
+			r.redactObject(item)
+			return nil
+		})
+	} else {
+		r.redactObject(obj)
+	}
+	r.scrubObject(obj)
+	return obj
+}
+
+func (r *redactor) redactObject(obj runtime.Object) {
+	redactMetadata(obj)
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		for k := range o.Data {
+			o.Data[k] = []byte(redactedValue)
+		}
+		for k := range o.StringData {
+			o.StringData[k] = redactedValue
+		}
+	case *corev1.ConfigMap:
+		for k := range o.Data {
+			o.Data[k] = redactedValue
+		}
+		for k := range o.BinaryData {
+			o.BinaryData[k] = []byte(redactedValue)
+		}
+	case *corev1.Pod:
+		r.redactPodSpec(&o.Spec)
+	case *appsv1.Deployment:
+		r.redactPodSpec(&o.Spec.Template.Spec)
+	case *appsv1.StatefulSet:
+		r.redactPodSpec(&o.Spec.Template.Spec)
+	case *appsv1.ReplicaSet:
+		r.redactPodSpec(&o.Spec.Template.Spec)
+	case *appsv1.DaemonSet:
+		r.redactPodSpec(&o.Spec.Template.Spec)
+	case *batchv1.Job:
+		r.redactPodSpec(&o.Spec.Template.Spec)
+	case *batchv1beta1.CronJob:
+		r.redactPodSpec(&o.Spec.JobTemplate.Spec.Template.Spec)
+	case *kudoapi.OperatorVersion:
+		for i, p := range o.Spec.Parameters {
+			p := p
+			if p.IsSensitive() && p.Default != nil {
+				o.Spec.Parameters[i].Default = redactedString()
+			}
+		}
+	case *kudoapi.Instance:
+		r.redactInstance(o)
+	}
+}
+
+// redactPodSpec masks the values of all environment variables whose names match one of the patterns
+func (r *redactor) redactPodSpec(spec *corev1.PodSpec) {
+	redactContainers := func(containers []corev1.Container) {
+		for i := range containers {
+			for j, e := range containers[i].Env {
+				if e.Value != "" && r.matchesEnvPattern(e.Name) {
+					containers[i].Env[j].Value = redactedValue
+				}
+			}
+		}
+	}
+	redactContainers(spec.InitContainers)
+	redactContainers(spec.Containers)
+}
+
+func (r *redactor) matchesEnvPattern(name string) bool {
+	for _, re := range r.envPatterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactInstance masks the values of the sensitive parameters in the spec and status of an instance and remembers
+// them to scrub them from everything printed afterwards. References to values in the sensitive parameters Secret are
+// kept, but the referenced values are read from the Secret and remembered as well: the instance is printed before
+// the Secret, which itself is redacted like any other.
+func (r *redactor) redactInstance(i *kudoapi.Instance) {
+	sensitive := r.sensitiveParams[fmt.Sprintf("%s/%s", i.Namespace, i.Spec.OperatorVersion.Name)]
+	if len(sensitive) == 0 {
+		return
+	}
+
+	var secret *corev1.Secret
+	redactValue := func(name string, value *string) *string {
+		if _, ok := sensitive[name]; !ok || value == nil {
+			return value
+		}
+		if kudoapi.IsSensitiveParameterRef(*value) {
+			if secret == nil {
+				secret = r.sensitiveParametersSecret(i)
+			}
+			if v, ok := secret.Data[strings.TrimPrefix(*value, kudoapi.SensitiveParameterRefPrefix)]; ok {
+				r.addValue(string(v))
+			}
+			return value
+		}
+		r.addValue(*value)
+		return redactedString()
+	}
+	redactParams := func(params map[string]string) {
+		for name, value := range params {
+			value := value
+			params[name] = *redactValue(name, &value)
+		}
+	}
+	redactParams(i.Spec.Parameters)
+	if i.Status.AppliedSnapshot != nil {
+		redactParams(i.Status.AppliedSnapshot.Parameters)
+	}
+	for _, record := range i.Status.PlanHistory {
+		for j, c := range record.ParameterChanges {
+			record.ParameterChanges[j].From = redactValue(c.Name, c.From)
+			record.ParameterChanges[j].To = redactValue(c.Name, c.To)
+		}
+	}
+}
+
+// sensitiveParametersSecret returns the sensitive parameters Secret of the instance. If it can't be read, an empty
+// Secret is returned and the error is remembered, as the referenced values can't be scrubbed.
+func (r *redactor) sensitiveParametersSecret(i *kudoapi.Instance) *corev1.Secret {
+	if i.Spec.SensitiveParametersSecret == "" {
+		r.errors = append(r.errors, fmt.Sprintf("instance %s/%s references sensitive parameter values but has no sensitive parameters secret", i.Namespace, i.Name))
+		return &corev1.Secret{}
+	}
+	if r.client == nil {
+		r.errors = append(r.errors, fmt.Sprintf("failed to get sensitive parameters secret %s/%s: no client", i.Namespace, i.Spec.SensitiveParametersSecret))
+		return &corev1.Secret{}
+	}
+	secret, err := r.client.CoreV1().Secrets(i.Namespace).Get(context.TODO(), i.Spec.SensitiveParametersSecret, metav1.GetOptions{})
+	if err != nil {
+		r.errors = append(r.errors, fmt.Sprintf("failed to get sensitive parameters secret %s/%s, its values are not scrubbed: %v", i.Namespace, i.Spec.SensitiveParametersSecret, err))
+		return &corev1.Secret{}
+	}
+	return secret
+}
+
+func (r *redactor) addValue(v string) {
+	if len(v) < minScrubLength {
+		return
+	}
+	for _, known := range r.values {
+		if known == v {
+			return
+		}
+	}
+	r.values = append(r.values, v)
+}
+
+// scrub replaces all values of sensitive parameters in the passed text
+func (r *redactor) scrub(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, v := range r.values {
+		s = strings.ReplaceAll(s, v, redactedValue)
+	}
+	return s
+}
+
+// scrubObject replaces all values of sensitive parameters in all strings of the passed object
+func (r *redactor) scrubObject(obj runtime.Object) {
+	if len(r.values) == 0 {
+		return
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return
+	}
+	scrubbed := r.scrubValue(u).(map[string]interface{})
+	_ = runtime.DefaultUnstructuredConverter.FromUnstructured(scrubbed, obj)
+}
+
+func (r *redactor) scrubValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return r.scrub(t)
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = r.scrubValue(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = r.scrubValue(e)
+		}
+	}
+	return v
+}
+
+// scrubStream returns a stream that replaces all values of sensitive parameters line by line
+func (r *redactor) scrubStream(stream io.ReadCloser) io.ReadCloser {
+	if r == nil || len(r.values) == 0 {
+		return stream
+	}
+	return &scrubbingReader{r: r, reader: bufio.NewReader(stream), closer: stream}
+}
+
+// scrubbingReader - a reader that scrubs sensitive values from the lines of the underlying stream
+type scrubbingReader struct {
+	r      *redactor
+	reader *bufio.Reader
+	closer io.Closer
+	buf    bytes.Buffer
+	err    error
+}
+
+func (s *scrubbingReader) Read(p []byte) (int, error) {
+	for s.buf.Len() == 0 && s.err == nil {
+		var line string
+		line, s.err = s.reader.ReadString('\n')
+		s.buf.WriteString(s.r.scrub(line))
+	}
+	if s.buf.Len() > 0 {
+		return s.buf.Read(p)
+	}
+	return 0, s.err
+}
+
+func (s *scrubbingReader) Close() error {
+	return s.closer.Close()
+}
+
+// redactMetadata removes the last applied configurations of any object, as they contain the full object including all
+// values, e.g. the unmasked environment of a workload
+func redactMetadata(obj runtime.Object) {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	annotations := m.GetAnnotations()
+	if annotations == nil {
+		return
+	}
+	delete(annotations, kudoutil.LastAppliedConfigAnnotation)
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	m.SetAnnotations(annotations)
+}
+
+func redactedString() *string {
+	s := redactedValue
+	return &s
+}
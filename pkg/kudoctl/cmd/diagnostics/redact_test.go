@@ -0,0 +1,250 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/client/clientset/versioned/fake"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+// all secret values of the redaction fixture contain this marker
+const secretMarker = "s3cr3t"
+
+func TestCollect_Redaction(t *testing.T) {
+	var (
+		operator        kudoapi.Operator
+		operatorVersion kudoapi.OperatorVersion
+		instance        kudoapi.Instance
+		pods            corev1.PodList
+		secrets         corev1.SecretList
+		configMaps      corev1.ConfigMapList
+		statefulSets    appsv1.StatefulSetList
+	)
+	osFs := afero.NewOsFs()
+	mustReadObjectFromYaml(osFs, "testdata/redaction/operator.yaml", &operator, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/operatorversion.yaml", &operatorVersion, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/instance.yaml", &instance, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/pods.yaml", &pods, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/secrets.yaml", &secrets, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/configmaps.yaml", &configMaps, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/statefulsets.yaml", &statefulSets, check)
+
+	k8cs := kubefake.NewSimpleClientset(objectList{}.append(&pods).append(&secrets).append(&configMaps).append(&statefulSets).append(&kmNs).append(&kmPod)...)
+	kcs := fake.NewSimpleClientset(&operator, &operatorVersion, &instance)
+	client := kudo.NewClientFromK8s(kcs, k8cs)
+
+	fs := afero.NewMemMapFs()
+	err := Collect(fs, "cassandra-instance", NewOptions(0, "", true, nil), client, &env.Settings{Namespace: fakeNamespace})
+	assert.NoError(t, err)
+
+	exists, _ := afero.Exists(fs, DefaultDiagDir)
+	assert.False(t, exists, "the diagnostics directory must be removed after archiving it")
+
+	files := readArchive(t, fs, "diag.tar.gz")
+	assert.Contains(t, files, "diag/operator_cassandra/instance_cassandra-instance/cassandra-instance.yaml")
+	assert.Contains(t, files, "diag/operator_cassandra/instance_cassandra-instance/secretlist.yaml")
+	assert.Contains(t, files, "diag/operator_cassandra/instance_cassandra-instance/statefulsetlist.yaml")
+	assert.Contains(t, files, "diag/summary.md")
+
+	for name, content := range files {
+		assert.NotContains(t, content, secretMarker, "secret material found in %s", name)
+		assert.NotContains(t, content, "a2V5c3RvcmUtczNjcjN0", "secret material found in %s", name)
+		assert.NotContains(t, content, "env-api-t0ken", "secret material found in %s", name)
+	}
+
+	// only sensitive values are masked
+	assert.Contains(t, files["diag/operator_cassandra/instance_cassandra-instance/cassandra-instance.yaml"], `NODE_COUNT: "5"`)
+	assert.Contains(t, files["diag/operator_cassandra/instance_cassandra-instance/pod_cassandra-instance-node-0/cassandra-instance-node-0.yaml"], "value: 4G")
+	assert.Contains(t, files["diag/operator_cassandra/instance_cassandra-instance/secretlist.yaml"], "keystore-password:")
+	assert.NotContains(t, files["diag/operator_cassandra/instance_cassandra-instance/statefulsetlist.yaml"], "last-applied-configuration")
+}
+
+func TestCollect_ArchiveExists(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "diag.tar.gz", []byte{}, 0600)
+
+	client := kudo.NewClientFromK8s(fake.NewSimpleClientset(), kubefake.NewSimpleClientset())
+	err := Collect(fs, fakeZkInstance, NewOptions(0, "", true, nil), client, &env.Settings{Namespace: fakeNamespace})
+	assert.EqualError(t, err, "target archive diag.tar.gz already exists")
+}
+
+func TestNewRedactor_InvalidPattern(t *testing.T) {
+	_, err := newRedactor([]string{"("}, nil)
+	assert.Error(t, err)
+}
+
+func TestRedactor_scrubStream(t *testing.T) {
+	r, err := newRedactor(nil, nil)
+	assert.NoError(t, err)
+	r.addValue("s3cr3t")
+	r.addValue("abc") // too short to be scrubbed
+
+	log := "first line\nlogin with s3cr3t and abc\nlast line s3cr3t without newline"
+	b, err := ioutil.ReadAll(r.scrubStream(ioutil.NopCloser(strings.NewReader(log))))
+	assert.NoError(t, err)
+	assert.Equal(t, "first line\nlogin with REDACTED and abc\nlast line REDACTED without newline", string(b))
+}
+
+// readArchive returns the content of all files in a gzipped tarball, gzipped files are unpacked
+func readArchive(t *testing.T, fs afero.Fs, name string) map[string]string {
+	files := map[string]string{}
+
+	f, err := fs.Open(name)
+	if !assert.NoError(t, err) {
+		return files
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if !assert.NoError(t, err) {
+		return files
+	}
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF || !assert.NoError(t, err) {
+			break
+		}
+
+		b, err := ioutil.ReadAll(tr)
+		assert.NoError(t, err)
+		if strings.HasSuffix(header.Name, ".gz") && len(b) > 0 {
+			zr, err := gzip.NewReader(bytes.NewReader(b))
+			assert.NoError(t, err)
+			b, err = ioutil.ReadAll(zr)
+			assert.NoError(t, err)
+		}
+		files[header.Name] = string(b)
+	}
+	return files
+}
+
+func TestCollect_RedactionWithSensitiveParameterRefs(t *testing.T) {
+	var (
+		operator        kudoapi.Operator
+		operatorVersion kudoapi.OperatorVersion
+		instance        kudoapi.Instance
+		pods            corev1.PodList
+		secrets         corev1.SecretList
+		configMaps      corev1.ConfigMapList
+		events          corev1.EventList
+	)
+	osFs := afero.NewOsFs()
+	mustReadObjectFromYaml(osFs, "testdata/redaction/operator.yaml", &operator, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/operatorversion.yaml", &operatorVersion, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/refs/instance.yaml", &instance, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/refs/pods.yaml", &pods, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/refs/secrets.yaml", &secrets, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/refs/configmaps.yaml", &configMaps, check)
+	mustReadObjectFromYaml(osFs, "testdata/redaction/refs/events.yaml", &events, check)
+
+	k8cs := kubefake.NewSimpleClientset(objectList{}.append(&pods).append(&secrets).append(&configMaps).append(&events).append(&kmNs).append(&kmPod)...)
+	kcs := fake.NewSimpleClientset(&operator, &operatorVersion, &instance)
+	client := kudo.NewClientFromK8s(kcs, k8cs)
+
+	fs := afero.NewMemMapFs()
+	err := Collect(fs, "cassandra-instance", NewOptions(0, "", true, nil), client, &env.Settings{Namespace: fakeNamespace})
+	assert.NoError(t, err)
+
+	files := readArchive(t, fs, "diag.tar.gz")
+	instanceDir := "diag/operator_cassandra/instance_cassandra-instance"
+	assert.Contains(t, files, instanceDir+"/eventlist.yaml")
+	assert.Contains(t, files[instanceDir+"/cassandra-instance.yaml"], "ADMIN_PASSWORD: sensitive:ADMIN_PASSWORD.4f2a9c")
+	for name, content := range files {
+		assert.NotContains(t, content, secretMarker, "secret material found in %s", name)
+	}
+
+	// the fake clientset doesn't return logs, the values are scrubbed from the logs printed after the instance
+	r, err := newRedactor(nil, k8cs)
+	assert.NoError(t, err)
+	p := &nonFailingPrinter{fs: afero.NewMemMapFs(), redactor: r}
+	r.addOperatorVersion(&operatorVersion)
+	p.printObject(&instance, "diag", ObjectWithDir)
+
+	logs := &logsCollector{
+		loadLogFn: func(string, string) (io.ReadCloser, error) { return osFs.Open("testdata/redaction/refs/cassandra.log") },
+		pods:      func() []corev1.Pod { return pods.Items[:1] },
+		parentDir: func() string { return "diag" },
+	}
+	assert.NoError(t, logs.collect(p))
+	assert.Empty(t, p.errors)
+
+	for _, container := range []string{"init", "cassandra"} {
+		f, err := p.fs.Open(fmt.Sprintf("diag/pod_cassandra-instance-node-0/%s.log.gz", container))
+		if !assert.NoError(t, err) {
+			continue
+		}
+		zr, err := gzip.NewReader(f)
+		assert.NoError(t, err)
+		b, err := ioutil.ReadAll(zr)
+		assert.NoError(t, err)
+		assert.Contains(t, string(b), "Created superuser with password REDACTED")
+		assert.NotContains(t, string(b), secretMarker)
+		_ = f.Close()
+	}
+}
+
+func TestRedactor_keepsSensitiveParameterRefs(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "cassandra-instance-sensitive-parameters", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"ADMIN_PASSWORD.abc": []byte("admin-s3cr3t")},
+	}
+	r, err := newRedactor(nil, kubefake.NewSimpleClientset(secret))
+	assert.NoError(t, err)
+	r.addOperatorVersion(&kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "cassandra-1.0.0", Namespace: fakeNamespace},
+		Spec:       kudoapi.OperatorVersionSpec{Parameters: []kudoapi.Parameter{{Name: "ADMIN_PASSWORD", Sensitive: convert.BoolPtr(true)}}},
+	})
+
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "cassandra-instance", Namespace: fakeNamespace},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion:           corev1.ObjectReference{Name: "cassandra-1.0.0"},
+			Parameters:                map[string]string{"ADMIN_PASSWORD": "sensitive:ADMIN_PASSWORD.abc"},
+			SensitiveParametersSecret: "cassandra-instance-sensitive-parameters",
+		},
+	}
+
+	redacted := r.redact(instance).(*kudoapi.Instance)
+	assert.Equal(t, "sensitive:ADMIN_PASSWORD.abc", redacted.Spec.Parameters["ADMIN_PASSWORD"])
+	assert.Equal(t, []string{"admin-s3cr3t"}, r.values, "referenced values must be scrubbed")
+	assert.Empty(t, r.errors)
+}
+
+func TestRedactor_missingSensitiveParametersSecret(t *testing.T) {
+	r, err := newRedactor(nil, kubefake.NewSimpleClientset())
+	assert.NoError(t, err)
+	r.addOperatorVersion(&kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "cassandra-1.0.0", Namespace: fakeNamespace},
+		Spec:       kudoapi.OperatorVersionSpec{Parameters: []kudoapi.Parameter{{Name: "ADMIN_PASSWORD", Sensitive: convert.BoolPtr(true)}}},
+	})
+
+	r.redact(&kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "cassandra-instance", Namespace: fakeNamespace},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion:           corev1.ObjectReference{Name: "cassandra-1.0.0"},
+			Parameters:                map[string]string{"ADMIN_PASSWORD": "sensitive:ADMIN_PASSWORD.abc"},
+			SensitiveParametersSecret: "cassandra-instance-sensitive-parameters",
+		},
+	})
+	assert.Len(t, r.errors, 1)
+	assert.Contains(t, r.errors[0], "failed to get sensitive parameters secret my-namespace/cassandra-instance-sensitive-parameters")
+}
@@ -7,6 +7,8 @@ import (
 	"io"
 	"io/ioutil"
 	"reflect"
+	"sort"
+	"time"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
@@ -98,6 +100,9 @@ func newDependenciesResources(instanceName string, options *Options, c *kudo.Cli
 	return configs, nil
 }
 
+// kudoMetricsPort is the default port of the controller-runtime metrics endpoint of the KUDO manager
+const kudoMetricsPort = "8080"
+
 type stringGetter func() string
 
 func (r *resourceFuncsConfig) instance() (runtime.Object, error) {
@@ -166,6 +171,99 @@ func (r *resourceFuncsConfig) roles() (runtime.Object, error) {
 	return obj, err
 }
 
+func (r *resourceFuncsConfig) persistentVolumeClaims() (runtime.Object, error) {
+	obj, err := r.c.KubeClientset.CoreV1().PersistentVolumeClaims(r.ns).List(context.TODO(), r.opts)
+	return obj, err
+}
+
+// persistentVolumes loads the persistent volumes bound to the passed claims. Volumes are cluster-scoped and not labeled
+// by KUDO, so all of them are listed and filtered by their claim reference.
+func (r *resourceFuncsConfig) persistentVolumes(pvcs func() []corev1.PersistentVolumeClaim) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		claims := map[string]string{}
+		for _, pvc := range pvcs() {
+			claims[pvc.Name] = pvc.Spec.VolumeName
+		}
+		if len(claims) == 0 {
+			return nil, nil
+		}
+
+		pvs, err := r.c.KubeClientset.CoreV1().PersistentVolumes().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		bound := &corev1.PersistentVolumeList{}
+		for _, pv := range pvs.Items {
+			if ref := pv.Spec.ClaimRef; ref != nil && ref.Namespace == r.ns {
+				if _, ok := claims[ref.Name]; ok {
+					bound.Items = append(bound.Items, pv)
+					continue
+				}
+			}
+			for _, volumeName := range claims {
+				if volumeName != "" && volumeName == pv.Name {
+					bound.Items = append(bound.Items, pv)
+					break
+				}
+			}
+		}
+		return bound, nil
+	}
+}
+
+func (r *resourceFuncsConfig) configMaps() (runtime.Object, error) {
+	obj, err := r.c.KubeClientset.CoreV1().ConfigMaps(r.ns).List(context.TODO(), r.opts)
+	return obj, err
+}
+
+func (r *resourceFuncsConfig) secrets() (runtime.Object, error) {
+	obj, err := r.c.KubeClientset.CoreV1().Secrets(r.ns).List(context.TODO(), r.opts)
+	return obj, err
+}
+
+// events loads the events of the namespace that involve one of the objects accepted by the passed filter. Events
+// are not labeled, so they can't be selected by the list options.
+func (r *resourceFuncsConfig) events(involves func(corev1.Event) bool) func() (runtime.Object, error) {
+	return func() (runtime.Object, error) {
+		events, err := r.c.KubeClientset.CoreV1().Events(r.ns).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		involved := &corev1.EventList{}
+		for _, e := range events.Items {
+			if involves(e) {
+				involved.Items = append(involved.Items, e)
+			}
+		}
+		sort.SliceStable(involved.Items, func(i, j int) bool {
+			return eventTime(involved.Items[i]).Before(eventTime(involved.Items[j]))
+		})
+		return involved, nil
+	}
+}
+
+// eventTime returns the last time an event was observed
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.FirstTimestamp.Time
+	}
+}
+
+// metrics loads the Prometheus metrics exposed by a pod of the KUDO manager
+func (r *resourceFuncsConfig) metrics(podName string) ([]byte, error) {
+	resp := r.c.KubeClientset.CoreV1().Pods(r.ns).ProxyGet("http", podName, kudoMetricsPort, "metrics", nil)
+	// a hack for tests: fake client returns nil for ProxyGet if no reactor handles it
+	if resp == nil {
+		return nil, nil
+	}
+	return resp.DoRaw(context.TODO())
+}
+
 func (r *resourceFuncsConfig) log(podName, containerName string) (io.ReadCloser, error) {
 	req := r.c.KubeClientset.CoreV1().Pods(r.ns).GetLogs(podName, &corev1.PodLogOptions{SinceSeconds: r.logOpts.SinceSeconds, Container: containerName})
 	// a hack for tests: fake client returns rest.Request{} for GetLogs and Stream panics with null-pointer
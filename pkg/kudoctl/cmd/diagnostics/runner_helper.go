@@ -13,11 +13,12 @@ func diagForInstance(instance string, options *Options, c *kudo.Client, info ver
 		return err
 	}
 
-	ctx := &processingContext{root: options.DiagDir(), instanceName: instance}
+	ctx := &processingContext{root: options.DiagDir(), instanceName: instance, redactor: p.redactor}
 
 	runner := runnerForInstance(ir, ctx)
 	runner.addObjDump(info, ctx.rootDirectory, "version")
 	runner.addObjDump(s, ctx.rootDirectory, "settings")
+	runner.addCollector(&summaryCollector{ctx: ctx, parentDir: ctx.rootDirectory})
 
 	if err := runner.run(p); err != nil {
 		return err
@@ -33,7 +34,7 @@ func diagForInstance(instance string, options *Options, c *kudo.Client, info ver
 		// Nest the dependencies in the parents operator directory
 		root := ctx.operatorDirectory()
 
-		depCtx := &processingContext{root: root, instanceName: dep.instanceObj.Name}
+		depCtx := &processingContext{root: root, instanceName: dep.instanceObj.Name, redactor: p.redactor}
 
 		runner := runnerForInstance(dep, depCtx)
 		if err := runner.run(p); err != nil {
@@ -97,26 +98,31 @@ func runnerForInstance(ir *resourceFuncsConfig, ctx *processingContext) *runner
 		loadResourceFn: ir.services,
 		name:           "service",
 		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
 		printMode:      RuntimeObject})
 	r.addCollector(&resourceCollector{
 		loadResourceFn: ir.deployments,
 		name:           "deployment",
 		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
 		printMode:      RuntimeObject})
 	r.addCollector(&resourceCollector{
 		loadResourceFn: ir.statefulSets,
 		name:           "statefulset",
 		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
 		printMode:      RuntimeObject})
 	r.addCollector(&resourceCollector{
 		loadResourceFn: ir.replicaSets,
 		name:           "replicaset",
 		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
 		printMode:      RuntimeObject})
 	r.addCollector(&resourceCollector{
 		loadResourceFn: ir.statefulSets,
 		name:           "statefulset",
 		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
 		printMode:      RuntimeObject})
 	r.addCollector(&resourceCollector{
 		loadResourceFn: ir.serviceAccounts,
@@ -143,11 +149,41 @@ func runnerForInstance(ir *resourceFuncsConfig, ctx *processingContext) *runner
 		name:           "role",
 		parentDir:      ctx.instanceDirectory,
 		printMode:      RuntimeObject})
+	r.addCollector(&resourceCollector{
+		loadResourceFn: ir.persistentVolumeClaims,
+		name:           "persistentvolumeclaim",
+		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.setPVCs,
+		printMode:      RuntimeObject})
+	r.addCollector(&resourceCollector{
+		loadResourceFn: ir.persistentVolumes(ctx.pvcList),
+		name:           "persistentvolume",
+		parentDir:      ctx.instanceDirectory,
+		printMode:      RuntimeObject})
+	r.addCollector(&resourceCollector{
+		loadResourceFn: ir.configMaps,
+		name:           "configmap",
+		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
+		printMode:      RuntimeObject})
+	r.addCollector(&resourceCollector{
+		loadResourceFn: ir.secrets,
+		name:           "secret",
+		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.trackObjects,
+		printMode:      RuntimeObject})
 	r.addCollector(&logsCollector{
 		loadLogFn: ir.log,
 		pods:      ctx.podList,
 		parentDir: ctx.instanceDirectory,
 	})
+	// events are collected last, as only the events involving the collected objects are kept
+	r.addCollector(&resourceCollector{
+		loadResourceFn: ir.events(ctx.involves),
+		name:           "event",
+		parentDir:      ctx.instanceDirectory,
+		callback:       ctx.setEvents,
+		printMode:      RuntimeObject})
 
 	return r
 }
@@ -180,6 +216,10 @@ func runnerForKudoManager(kr *resourceFuncsConfig, ctx *processingContext) *runn
 		loadLogFn: kr.log,
 		pods:      ctx.podList,
 		parentDir: ctx.rootDirectory})
+	r.addCollector(&metricsCollector{
+		loadMetricsFn: kr.metrics,
+		pods:          ctx.podList,
+		parentDir:     ctx.rootDirectory})
 
 	return r
 }
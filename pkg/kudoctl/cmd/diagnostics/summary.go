@@ -0,0 +1,178 @@
+package diagnostics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+const summaryFileName = "summary.md"
+
+// Ensure collector is implemented
+var _ collector = &summaryCollector{}
+
+// summaryCollector - writes a human readable summary of the collected instance: the timeline of its plans, phases and
+// steps, all error messages and the events of its objects. Must run after the instance and its events are collected.
+type summaryCollector struct {
+	ctx       *processingContext
+	parentDir stringGetter
+}
+
+func (c *summaryCollector) collect(printer *nonFailingPrinter) error {
+	if c.ctx.instanceObj == nil {
+		return nil
+	}
+	printer.printFile([]byte(summary(c.ctx.instanceObj, c.ctx.events)), c.parentDir(), summaryFileName)
+	return nil
+}
+
+// summary renders the summary of an instance and its events as markdown
+func summary(instance *kudoapi.Instance, events []v1.Event) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Instance %s/%s\n\n", instance.Namespace, instance.Name)
+	fmt.Fprintf(&b, "- Operator version: %s\n", instance.Spec.OperatorVersion.Name)
+	if instance.Spec.PlanExecution.PlanName != "" {
+		fmt.Fprintf(&b, "- Active plan: %s (%s)\n", instance.Spec.PlanExecution.PlanName, instance.Spec.PlanExecution.Status)
+	} else {
+		fmt.Fprintf(&b, "- Active plan: none\n")
+	}
+
+	b.WriteString("\n## Plans\n\n")
+	plans := sortedPlanStatuses(instance)
+	if len(plans) == 0 {
+		b.WriteString("No plan was executed.\n")
+	}
+	for _, plan := range plans {
+		fmt.Fprintf(&b, "### %s: %s\n\n", plan.Name, statusOrNever(plan.Status))
+		fmt.Fprintf(&b, "Last updated: %s\n\n", timestamp(plan.LastUpdatedTimestamp))
+		for _, phase := range plan.Phases {
+			fmt.Fprintf(&b, "- phase %s: %s, started %s\n", phase.Name, statusOrNever(phase.Status), timestamp(phase.StartedTimestamp))
+			for _, step := range phase.Steps {
+				fmt.Fprintf(&b, "  - step %s: %s, started %s", step.Name, statusOrNever(step.Status), timestamp(step.StartedTimestamp))
+				if step.Attempts > 0 {
+					fmt.Fprintf(&b, ", %d failed attempts, last at %s", step.Attempts, timestamp(step.LastAttemptTimestamp))
+				}
+				b.WriteString("\n")
+			}
+		}
+		if len(plan.Phases) > 0 {
+			b.WriteString("\n")
+		}
+	}
+
+	if len(instance.Status.PlanHistory) > 0 {
+		b.WriteString("## Plan history\n\n")
+		b.WriteString("| Plan | Status | Started | Finished | Message |\n")
+		b.WriteString("|------|--------|---------|----------|---------|\n")
+		for _, r := range instance.Status.PlanHistory {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n",
+				r.Name, r.Status, timestamp(r.StartedTimestamp), timestamp(r.FinishedTimestamp), tableCell(r.Message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Errors\n\n")
+	errs := errorMessages(instance, events)
+	if len(errs) == 0 {
+		b.WriteString("No errors found.\n")
+	}
+	for _, e := range errs {
+		fmt.Fprintf(&b, "- %s\n", e)
+	}
+
+	b.WriteString("\n## Events\n\n")
+	if len(events) == 0 {
+		b.WriteString("No events found.\n")
+		return b.String()
+	}
+	b.WriteString("| Time | Type | Object | Reason | Message |\n")
+	b.WriteString("|------|------|--------|--------|---------|\n")
+	for _, e := range events {
+		fmt.Fprintf(&b, "| %s | %s | %s/%s | %s | %s |\n",
+			eventTime(e).UTC().Format(timeFormat), e.Type, e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Reason, tableCell(e.Message))
+	}
+	return b.String()
+}
+
+// errorMessages returns the messages of all plans, phases and steps with a message, of all false conditions of the
+// instance and of all warning events
+func errorMessages(instance *kudoapi.Instance, events []v1.Event) []string {
+	var errs []string
+	for _, plan := range sortedPlanStatuses(instance) {
+		if plan.Message != "" {
+			errs = append(errs, fmt.Sprintf("plan %s (%s): %s", plan.Name, plan.Status, plan.Message))
+		}
+		for _, phase := range plan.Phases {
+			if phase.Message != "" {
+				errs = append(errs, fmt.Sprintf("phase %s/%s (%s): %s", plan.Name, phase.Name, phase.Status, phase.Message))
+			}
+			for _, step := range phase.Steps {
+				if step.Message != "" {
+					errs = append(errs, fmt.Sprintf("step %s/%s/%s (%s): %s", plan.Name, phase.Name, step.Name, step.Status, step.Message))
+				}
+			}
+		}
+	}
+	for _, c := range instance.Status.Conditions {
+		if c.Status == metav1.ConditionFalse && c.Message != "" {
+			errs = append(errs, fmt.Sprintf("condition %s (%s): %s", c.Type, c.Reason, c.Message))
+		}
+	}
+	for _, e := range events {
+		if e.Type == v1.EventTypeWarning {
+			errs = append(errs, fmt.Sprintf("event %s/%s (%s): %s", e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Reason, e.Message))
+		}
+	}
+	return errs
+}
+
+// sortedPlanStatuses returns the plan statuses of an instance, the most recently updated plan first and plans that
+// were never updated last
+func sortedPlanStatuses(instance *kudoapi.Instance) []kudoapi.PlanStatus {
+	plans := make([]kudoapi.PlanStatus, 0, len(instance.Status.PlanStatus))
+	for _, p := range instance.Status.PlanStatus {
+		plans = append(plans, p)
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		ti, tj := plans[i].LastUpdatedTimestamp, plans[j].LastUpdatedTimestamp
+		switch {
+		case ti == nil && tj == nil:
+			return plans[i].Name < plans[j].Name
+		case ti == nil || tj == nil:
+			return ti != nil
+		case !ti.Equal(tj):
+			return tj.Before(ti)
+		default:
+			return plans[i].Name < plans[j].Name
+		}
+	})
+	return plans
+}
+
+const timeFormat = "2006-01-02T15:04:05Z"
+
+func timestamp(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(timeFormat)
+}
+
+func statusOrNever(s kudoapi.ExecutionStatus) string {
+	if s == "" {
+		return string(kudoapi.ExecutionNeverRun)
+	}
+	return string(s)
+}
+
+// tableCell escapes a string for a markdown table cell
+func tableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,113 @@
+package diagnostics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+func TestSummary(t *testing.T) {
+	started := metav1.NewTime(time.Date(2020, 5, 25, 8, 0, 0, 0, time.UTC))
+	updated := metav1.NewTime(time.Date(2020, 5, 25, 8, 5, 0, 0, time.UTC))
+
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "zk", Namespace: "default"},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion: v1.ObjectReference{Name: "zookeeper-0.3.0"},
+			PlanExecution:   kudoapi.PlanExecution{PlanName: "deploy", Status: kudoapi.ExecutionFatalError},
+		},
+		Status: kudoapi.InstanceStatus{
+			PlanStatus: map[string]kudoapi.PlanStatus{
+				"deploy": {
+					Name:                 "deploy",
+					Status:               kudoapi.ExecutionFatalError,
+					Message:              "step deploy failed",
+					LastUpdatedTimestamp: &updated,
+					Phases: []kudoapi.PhaseStatus{{
+						Name:             "zookeeper",
+						Status:           kudoapi.ExecutionFatalError,
+						StartedTimestamp: &started,
+						Steps: []kudoapi.StepStatus{{
+							Name:                 "deploy",
+							Status:               kudoapi.ExecutionFatalError,
+							Message:              "failed to apply | invalid",
+							StartedTimestamp:     &started,
+							Attempts:             2,
+							LastAttemptTimestamp: &updated,
+						}},
+					}},
+				},
+				"backup": {Name: "backup", Status: kudoapi.ExecutionNeverRun},
+			},
+			PlanHistory: []kudoapi.PlanExecutionRecord{
+				{Name: "deploy", Status: kudoapi.ExecutionComplete, StartedTimestamp: &started, FinishedTimestamp: &updated},
+			},
+			Conditions: []metav1.Condition{
+				{Type: "Ready", Status: metav1.ConditionFalse, Reason: "PlanFailed", Message: "plan deploy failed"},
+			},
+		},
+	}
+	events := []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "zk-0"},
+			LastTimestamp:  updated,
+			Type:           v1.EventTypeWarning,
+			Reason:         "Unhealthy",
+			Message:        "Readiness probe failed",
+		},
+	}
+
+	expected := `# Instance default/zk
+
+- Operator version: zookeeper-0.3.0
+- Active plan: deploy (FATAL_ERROR)
+
+## Plans
+
+### deploy: FATAL_ERROR
+
+Last updated: 2020-05-25T08:05:00Z
+
+- phase zookeeper: FATAL_ERROR, started 2020-05-25T08:00:00Z
+  - step deploy: FATAL_ERROR, started 2020-05-25T08:00:00Z, 2 failed attempts, last at 2020-05-25T08:05:00Z
+
+### backup: NEVER_RUN
+
+Last updated: -
+
+## Plan history
+
+| Plan | Status | Started | Finished | Message |
+|------|--------|---------|----------|---------|
+| deploy | COMPLETE | 2020-05-25T08:00:00Z | 2020-05-25T08:05:00Z |  |
+
+## Errors
+
+- plan deploy (FATAL_ERROR): step deploy failed
+- step deploy/zookeeper/deploy (FATAL_ERROR): failed to apply | invalid
+- condition Ready (PlanFailed): plan deploy failed
+- event Pod/zk-0 (Unhealthy): Readiness probe failed
+
+## Events
+
+| Time | Type | Object | Reason | Message |
+|------|------|--------|--------|---------|
+| 2020-05-25T08:05:00Z | Warning | Pod/zk-0 | Unhealthy | Readiness probe failed |
+`
+	assert.Equal(t, expected, summary(instance, events))
+}
+
+func TestSummary_NoPlans(t *testing.T) {
+	instance := &kudoapi.Instance{ObjectMeta: metav1.ObjectMeta{Name: "zk", Namespace: "default"}}
+
+	s := summary(instance, nil)
+	assert.Contains(t, s, "- Active plan: none\n")
+	assert.Contains(t, s, "No plan was executed.\n")
+	assert.Contains(t, s, "No errors found.\n")
+	assert.Contains(t, s, "No events found.\n")
+}
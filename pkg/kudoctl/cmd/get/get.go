@@ -57,6 +57,17 @@ func Run(args []string, opts CmdOpts) error {
 	}
 
 	if opts.Output.IsFormattedOutput() {
+		if args[0] == Instances {
+			operatorversions, err := opts.Client.ListOperatorVersionsAsRuntimeObject(opts.Namespace)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve operatorversions: %v", err)
+			}
+			maskSensitiveParameters(objs, operatorversions)
+		}
+		if args[0] == OperatorVersions {
+			maskSensitiveParameters(nil, objs)
+		}
+
 		var outObj []interface{}
 		for _, o := range objs {
 			outObj = append(outObj, o)
@@ -94,6 +105,8 @@ func runGetAll(opts CmdOpts) error {
 	}
 
 	if opts.Output.IsFormattedOutput() {
+		maskSensitiveParameters(instances, operatorversions)
+
 		var outObj []interface{}
 		for _, o := range operators {
 			outObj = append(outObj, o)
@@ -138,6 +151,43 @@ func printAllTree(opts CmdOpts, operators, operatorversions, instances []runtime
 
 }
 
+// maskSensitiveParameters masks the values of sensitive parameters in the instances and their defaults in the operator
+// versions. References to values in the sensitive parameters Secret of an instance are kept, as they don't reveal the
+// value.
+func maskSensitiveParameters(instances, operatorversions []runtime.Object) {
+	sensitive := map[string]map[string]bool{}
+	for _, o := range operatorversions {
+		ov, _ := o.(*v1beta1.OperatorVersion)
+		sensitive[ov.Name] = map[string]bool{}
+		for i, p := range ov.Spec.Parameters {
+			p := p
+			if !p.IsSensitive() {
+				continue
+			}
+			sensitive[ov.Name][p.Name] = true
+			if p.Default != nil {
+				masked := v1beta1.MaskedParameterValue
+				ov.Spec.Parameters[i].Default = &masked
+			}
+		}
+	}
+
+	for _, o := range instances {
+		i, _ := o.(*v1beta1.Instance)
+		mask := func(params map[string]string) {
+			for name, value := range params {
+				if sensitive[i.Spec.OperatorVersion.Name][name] && !v1beta1.IsSensitiveParameterRef(value) {
+					params[name] = v1beta1.MaskedParameterValue
+				}
+			}
+		}
+		mask(i.Spec.Parameters)
+		if i.Status.AppliedSnapshot != nil {
+			mask(i.Status.AppliedSnapshot.Parameters)
+		}
+	}
+}
+
 func validate(args []string) error {
 	if len(args) != 1 {
 		return errors.New(`expecting exactly one argument - "instances, operators, operatorversions or all"`)
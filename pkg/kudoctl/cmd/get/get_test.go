@@ -16,6 +16,7 @@ import (
 	"github.com/kudobuilder/kudo/pkg/client/clientset/versioned/fake"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/cmd/output"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
 )
 
 var updateGolden = flag.Bool("update", false, "update .golden files")
@@ -58,6 +59,10 @@ func TestGetInstances(t *testing.T) {
 			OperatorVersion: v1.ObjectReference{
 				Name: "some-operator-0.1.0",
 			},
+			Parameters: map[string]string{
+				"NODE_COUNT": "3",
+				"PASSWORD":   "s3cr3t",
+			},
 		},
 	}
 
@@ -92,6 +97,10 @@ func TestGetInstances(t *testing.T) {
 				Name:       "some-operator",
 			},
 			Version: "0.1.0",
+			Parameters: []kudoapi.Parameter{
+				{Name: "NODE_COUNT", Default: convert.StringPtr("1")},
+				{Name: "PASSWORD", Default: convert.StringPtr("changeme"), Sensitive: convert.BoolPtr(true)},
+			},
 		},
 	}
 
@@ -30,7 +30,10 @@ var (
   kubectl kudo install zookeeper --operator-version=0.3.0 --in-cluster
 
   # Specify an operator version of Kafka to install to your cluster
-  kubectl kudo install kafka --operator-version=1.1.1`
+  kubectl kudo install kafka --operator-version=1.1.1
+
+  # Install operator with a parameter sourced from the key 'password' of the existing secret 'cassandra-credentials'
+  kubectl kudo install cassandra --parameter-from-secret ADMIN_PASSWORD=cassandra-credentials/password`
 )
 
 // newInstallCmd creates the install command for the CLI
@@ -38,6 +41,8 @@ func newInstallCmd(fs afero.Fs) *cobra.Command {
 	options := install.DefaultOptions
 	var parameters []string
 	var parameterFiles []string
+	var parametersFromSecrets []string
+	var parametersFromConfigMaps []string
 	installCmd := &cobra.Command{
 		Use:     "install <name>",
 		Short:   "Install an official KUDO package.",
@@ -50,6 +55,10 @@ func newInstallCmd(fs afero.Fs) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("could not parse parameters: %v", err)
 			}
+			options.ParametersFrom, err = params.GetParameterSources(parametersFromSecrets, parametersFromConfigMaps)
+			if err != nil {
+				return fmt.Errorf("could not parse parameter sources: %v", err)
+			}
 
 			return install.Run(args, options, fs, &Settings)
 		},
@@ -58,6 +67,8 @@ func newInstallCmd(fs afero.Fs) *cobra.Command {
 	installCmd.Flags().StringVar(&options.InstanceName, "instance", "", "The Instance name. (defaults to Operator name appended with -instance)")
 	installCmd.Flags().StringArrayVarP(&parameters, "parameter", "p", nil, "The parameter name and value separated by '='")
 	installCmd.Flags().StringArrayVarP(&parameterFiles, "parameter-file", "P", nil, "YAML file with parameters")
+	installCmd.Flags().StringArrayVar(&parametersFromSecrets, "parameter-from-secret", nil, "The parameter name and a key of an existing secret in the form <secret>/<key> separated by '='. The instance is updated when the value changes")
+	installCmd.Flags().StringArrayVar(&parametersFromConfigMaps, "parameter-from-configmap", nil, "The parameter name and a key of an existing configmap in the form <configmap>/<key> separated by '='. The instance is updated when the value changes")
 	installCmd.Flags().StringVar(&options.RepoName, "repo", "", "Name of repository configuration to use. (default defined by context)")
 	installCmd.Flags().StringVar(&options.AppVersion, "app-version", "", "A specific app version in the official GitHub repo. (default to the most recent)")
 	installCmd.Flags().StringVar(&options.OperatorVersion, "operator-version", "", "A specific operator version int the official GitHub repo. (default to the most recent)")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/afero"
 
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/clog"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
@@ -26,6 +27,7 @@ type Options struct {
 	RepositoryOptions
 	InstanceName    string
 	Parameters      map[string]string
+	ParametersFrom  map[string]kudoapi.ParameterSource
 	AppVersion      string
 	OperatorVersion string
 	SkipInstance    bool
@@ -107,6 +109,7 @@ func installOperator(operatorArgument string, options *Options, fs afero.Fs, set
 	installOpts := install.Options{
 		SkipInstance:    options.SkipInstance,
 		CreateNamespace: options.CreateNameSpace,
+		ParametersFrom:  options.ParametersFrom,
 	}
 
 	if options.Wait {
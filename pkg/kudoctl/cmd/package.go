@@ -10,7 +10,7 @@ import (
 const packageDesc = `
 This command consists of multiple sub-commands to interact with KUDO packages.
 
-It can be used to package or verify an operator, or list parameters.  When working with parameters it can 
+It can be used to package or verify an operator, list parameters, or prune unused operator versions.  When working with parameters it can 
 provide a list of parameters from a remote operator given a url or repository along with the name and version.
 `
 
@@ -18,6 +18,7 @@ const packageExamples = `  kubectl kudo package create [operator folder]
   kubectl kudo package list parameters [operator]
   kubectl kudo package verify [operator]
   kubectl kudo package add [subcommand]
+  kubectl kudo package prune --keep 2
 `
 
 // newPackageCmd for operator commands such as packaging an operator or retrieving it's parameters
@@ -33,6 +34,7 @@ func newPackageCmd(fs afero.Fs, out io.Writer) *cobra.Command {
 	cmd.AddCommand(newPackageCreateCmd(fs, out))
 	cmd.AddCommand(newPackageNewCmd(fs, out))
 	cmd.AddCommand(newPackageParamsCmd(fs, out))
+	cmd.AddCommand(newPackagePruneCmd(out))
 	cmd.AddCommand(newPackageVerifyCmd(fs, out))
 
 	return cmd
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -127,9 +128,36 @@ func addTaskNodeWithResources(sNode treeprint.Tree, taskName string, tasks []kud
 				for _, resource := range t.Spec.Resources {
 					tNode.AddNode(resource)
 				}
+			case task.WaitTaskKind:
+				tNode := sNode.AddMetaBranch("wait", taskName)
+				if t.Spec.Condition != "" {
+					tNode.AddMetaBranch("condition", t.Spec.Condition)
+				}
+				for _, resource := range t.Spec.Resources {
+					tNode.AddNode(resource)
+				}
+			case task.ExecTaskKind:
+				tNode := sNode.AddMetaBranch("exec", taskName)
+				tNode.AddMetaBranch("pods", t.Spec.PodSelector)
+				tNode.AddNode(strings.Join(t.Spec.Command, " "))
+			case task.HelmTaskKind:
+				tNode := sNode.AddMetaBranch("helm", taskName)
+				if t.Spec.ValuesFile != "" {
+					tNode.AddMetaBranch("values", t.Spec.ValuesFile)
+				}
+				tNode.AddNode(t.Spec.Chart)
+			case task.KustomizeTaskKind:
+				tNode := sNode.AddMetaBranch("kustomize", taskName)
+				for _, patch := range t.Spec.Patches {
+					tNode.AddMetaBranch("patch", patch)
+				}
+				tNode.AddNode(t.Spec.Kustomization)
 			case task.PipeTaskKind:
 				tNode := sNode.AddMetaBranch("pipe", taskName)
 				tNode.AddNode(t.Spec.Pod)
+			case task.ExternalTaskKind:
+				tNode := sNode.AddMetaBranch("external", taskName)
+				tNode.AddNode(t.Spec.URL)
 			case task.DummyTaskKind:
 				sNode.AddMetaBranch("dummy", taskName)
 			default:
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kudobuilder/kudo/pkg/kudoctl/cmd/prune"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
+)
+
+const packagePruneDesc = `
+Deletes the operator versions of the namespace that are not used by any instance. The newest operator versions of
+each operator, and the operator versions that KudoOperator tasks of the kept ones would install, are kept.
+Operators without any operator version left are deleted as well.
+`
+
+const packagePruneExample = `  # Delete all but the newest operator version of each operator that are not used
+  kubectl kudo package prune
+
+  # Keep the three newest operator versions of each operator
+  kubectl kudo package prune --keep 3
+
+  # Show what would be deleted
+  kubectl kudo package prune --dry-run
+`
+
+// newPackagePruneCmd creates a command that deletes unused operator versions
+func newPackagePruneCmd(out io.Writer) *cobra.Command {
+	opts := prune.CmdOpts{
+		Out: out,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "prune",
+		Short:   "delete unused operator versions",
+		Long:    packagePruneDesc,
+		Example: packagePruneExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := env.GetClient(&Settings)
+			if err != nil {
+				return err
+			}
+			opts.Client = client
+			opts.Namespace = Settings.Namespace
+
+			return prune.Run(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Keep, "keep", 1, "The number of the newest operator versions of each operator to keep, even if they are not used.")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only print the operator versions and operators that would be deleted.")
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/yaml"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
@@ -31,6 +32,51 @@ func GetParameterMap(fs afero.Fs, raw []string, filePaths []string) (map[string]
 	return mergeParams(paramsFromCmdline, paramsFromFiles), nil
 }
 
+// GetParameterSources takes a slice of parameter strings referencing Secret keys and a slice referencing ConfigMap keys
+// in the form `NAME=object-name/key` and parses them into a map of parameter sources. A parameter can only be sourced
+// once and not from both a Secret and a ConfigMap.
+func GetParameterSources(fromSecrets []string, fromConfigMaps []string) (map[string]kudoapi.ParameterSource, error) {
+	var errs []string
+	sources := make(map[string]kudoapi.ParameterSource)
+
+	add := func(raw string, source func(name, key string) kudoapi.ParameterSource) {
+		param, ref, err := parseParameter(raw)
+		if err != nil {
+			errs = append(errs, *err)
+			return
+		}
+		s := strings.SplitN(ref, "/", 2)
+		if len(s) < 2 || s[0] == "" || s[1] == "" {
+			errs = append(errs, fmt.Sprintf("parameter source must be in the form <name>/<key>: %+v", raw))
+			return
+		}
+		if _, ok := sources[param]; ok {
+			errs = append(errs, fmt.Sprintf("parameter %s is sourced more than once", param))
+			return
+		}
+		sources[param] = source(s[0], s[1])
+	}
+
+	for _, raw := range fromSecrets {
+		add(raw, func(name, key string) kudoapi.ParameterSource {
+			return kudoapi.ParameterSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}}
+		})
+	}
+	for _, raw := range fromConfigMaps {
+		add(raw, func(name, key string) kudoapi.ParameterSource {
+			return kudoapi.ParameterSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}}
+		})
+	}
+
+	if errs != nil {
+		return nil, errors.New(strings.Join(errs, ", "))
+	}
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	return sources, nil
+}
+
 func getParamsFromCmdline(raw []string) (map[string]string, []string) {
 	var errs []string
 	parameters := make(map[string]string)
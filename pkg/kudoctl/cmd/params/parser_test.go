@@ -6,6 +6,9 @@ import (
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 )
 
 var parameterParsingTests = []struct {
@@ -152,3 +155,21 @@ func TestGetParameterMap(t *testing.T) {
 		})
 	}
 }
+
+func TestGetParameterSources(t *testing.T) {
+	sources, err := GetParameterSources([]string{"PASSWORD=credentials/password"}, []string{"NODES=settings/nodes"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]kudoapi.ParameterSource{
+		"PASSWORD": {SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "password"}},
+		"NODES":    {ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "nodes"}},
+	}, sources)
+
+	sources, err = GetParameterSources(nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, sources)
+
+	_, err = GetParameterSources([]string{"PASSWORD=credentials", "NODES=/nodes", "=credentials/user"}, []string{"PASSWORD=settings/password"})
+	assert.EqualError(t, err, "parameter source must be in the form <name>/<key>: PASSWORD=credentials, "+
+		"parameter source must be in the form <name>/<key>: NODES=/nodes, "+
+		"parameter name can not be empty: =credentials/user")
+}
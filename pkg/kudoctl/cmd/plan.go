@@ -13,12 +13,24 @@ import (
 const (
 	planHistoryExample = `  # View plan history
   kubectl kudo plan history --instance=<instanceName>
+
+  # Inspect a single plan execution from the plan history
+  kubectl kudo plan history --instance=<instanceName> --uid=<planExecutionUID>
 `
 	planStatusExample = `  # View plan status
   kubectl kudo plan status --instance=<instanceName>
 `
 	planTriggerExample = `  # Trigger an instance plan
 kubectl kudo plan trigger <planName> --instance=<instanceName>
+`
+	planDiffExample = `  # Show the changes the 'deploy' plan would make to the objects of an instance
+  kubectl kudo plan diff --instance=<instanceName> --plan=deploy
+`
+	planCancelExample = `  # Cancel the currently running plan of an instance
+  kubectl kudo plan cancel --instance=<instanceName>
+
+  # Cancel the plan only if it is the 'deploy' plan that is currently running
+  kubectl kudo plan cancel --instance=<instanceName> --name=deploy
 `
 )
 
@@ -30,19 +42,22 @@ func newPlanCmd(out io.Writer) *cobra.Command {
 		Long:  `The plan command has subcommands to view all available plans.`,
 	}
 
-	cmd.AddCommand(NewPlanHistoryCmd())
+	cmd.AddCommand(NewPlanHistoryCmd(out))
 	cmd.AddCommand(NewPlanStatusCmd(out))
 	cmd.AddCommand(NewPlanTriggerCmd())
+	cmd.AddCommand(NewPlanCancelCmd())
+	cmd.AddCommand(NewPlanDiffCmd(out))
 
 	return cmd
 }
 
 // NewPlanHistoryCmd creates a command that shows the plan history of an instance.
-func NewPlanHistoryCmd() *cobra.Command {
+func NewPlanHistoryCmd(out io.Writer) *cobra.Command {
 	options := plan.DefaultHistoryOptions
+	options.Out = out
 	cmd := &cobra.Command{
 		Use:     "history",
-		Short:   "Lists history for each plan of an instance.",
+		Short:   "Lists the past plan executions of an instance.",
 		Example: planHistoryExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return plan.RunHistory(cmd, options, &Settings)
@@ -50,6 +65,8 @@ func NewPlanHistoryCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&options.Instance, "instance", "", "The instance name available from 'kubectl get instances'")
+	cmd.Flags().StringVar(&options.UID, "uid", "", "The UID of a plan execution to show in detail")
+	cmd.Flags().StringVarP(options.Output.AsStringPtr(), "output", "o", "", "Output format for command results.")
 
 	return cmd
 }
@@ -97,3 +114,39 @@ func NewPlanTriggerCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewPlanCancelCmd creates a command that cancels the currently running plan of an instance.
+func NewPlanCancelCmd() *cobra.Command {
+	options := &plan.CancelOptions{}
+	cmd := &cobra.Command{
+		Use:     "cancel",
+		Short:   "Cancels the currently scheduled (or running) plan of a particular instance.",
+		Example: planCancelExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return plan.RunCancel(options, &Settings)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Instance, "instance", "", "The instance name available from 'kubectl get instances'")
+	cmd.Flags().StringVar(&options.Plan, "name", "", "The plan name. If set, the plan is only cancelled if it is the one currently running")
+
+	return cmd
+}
+
+// NewPlanDiffCmd creates a command that shows the changes a plan would make to the cluster without executing it.
+func NewPlanDiffCmd(out io.Writer) *cobra.Command {
+	options := &plan.DiffOptions{Out: out}
+	cmd := &cobra.Command{
+		Use:     "diff",
+		Short:   "Shows the changes a plan would make to the objects of a particular instance.",
+		Example: planDiffExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return plan.RunDiff(options, &Settings)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Instance, "instance", "", "The instance name available from 'kubectl get instances'")
+	cmd.Flags().StringVar(&options.Plan, "plan", "", "The plan name")
+
+	return cmd
+}
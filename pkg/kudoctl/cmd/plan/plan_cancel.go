@@ -0,0 +1,32 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kudobuilder/kudo/pkg/kudoctl/clog"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
+)
+
+type CancelOptions struct {
+	Plan     string
+	Instance string
+}
+
+// RunCancel cancels the currently scheduled (or running) plan execution
+func RunCancel(options *CancelOptions, settings *env.Settings) error {
+	if options.Instance == "" {
+		return errors.New("please choose the instance with '--instance=<instanceName>'")
+	}
+
+	kc, err := env.GetClient(settings)
+	if err != nil {
+		return fmt.Errorf("creating kudo client: %w", err)
+	}
+
+	err = kc.CancelPlan(options.Instance, settings.Namespace, options.Plan)
+	if err == nil {
+		clog.Printf("Cancelled the scheduled plan of %s/%s instance", settings.Namespace, options.Instance)
+	}
+	return err
+}
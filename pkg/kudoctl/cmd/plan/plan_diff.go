@@ -0,0 +1,307 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kudobuilder/kudo/pkg/apis"
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+	"github.com/kudobuilder/kudo/pkg/engine/workflow"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/kube"
+)
+
+// DiffOptions are the configurable options for plan diffs
+type DiffOptions struct {
+	Out      io.Writer
+	Instance string
+	Plan     string
+}
+
+// Cluster bundles everything that is needed to compare rendered plans with the live objects of a cluster
+type Cluster struct {
+	Client    client.Client
+	Discovery discovery.CachedDiscoveryInterface
+	Config    *rest.Config
+	Scheme    *runtime.Scheme
+}
+
+// NewCluster creates the clients to diff plans against the cluster of the current kubeconfig
+func NewCluster(settings *env.Settings) (*Cluster, error) {
+	config, err := kube.GetConfig(settings.KubeConfig).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("could not get Kubernetes config: %v", err)
+	}
+	config.Timeout = time.Duration(settings.RequestTimeout) * time.Second
+
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, apis.AddToScheme, apiextv1.AddToScheme, apiextv1beta1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("could not create Controller Runtime client: %v", err)
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create discovery client: %v", err)
+	}
+
+	return &Cluster{Client: c, Discovery: memory.NewMemCacheClient(dc), Config: config, Scheme: scheme}, nil
+}
+
+// RunDiff runs the plan diff command
+func RunDiff(options *DiffOptions, settings *env.Settings) error {
+	if options.Instance == "" {
+		return errors.New("please choose the instance with '--instance=<instanceName>'")
+	}
+	if options.Plan == "" {
+		return errors.New("please choose the plan with '--plan=<planName>'")
+	}
+
+	kc, err := env.GetClient(settings)
+	if err != nil {
+		return err
+	}
+
+	instance, err := kc.GetInstance(options.Instance, settings.Namespace)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("instance %s/%s does not exist", settings.Namespace, options.Instance)
+	}
+
+	ov, err := kc.GetOperatorVersion(instance.Spec.OperatorVersion.Name, settings.Namespace)
+	if err != nil {
+		return err
+	}
+	if ov == nil {
+		return fmt.Errorf("operator version %s/%s does not exist", settings.Namespace, instance.Spec.OperatorVersion.Name)
+	}
+
+	cluster, err := NewCluster(settings)
+	if err != nil {
+		return err
+	}
+
+	return Diff(options.Out, instance, ov, options.Plan, cluster)
+}
+
+// Diff renders all tasks of a plan for the passed instance and operator version and writes a unified diff of every
+// object that executing the plan would create, patch or delete. The cluster is not modified.
+func Diff(out io.Writer, instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, planName string, cluster *Cluster) error {
+	if !kudoapi.PlanExists(planName, ov) {
+		return fmt.Errorf("plan %s does not exist in operator version %s", planName, ov.Name)
+	}
+
+	meta := &engine.Metadata{
+		OperatorVersionName: ov.Name,
+		OperatorVersion:     ov.Spec.Version,
+		AppVersion:          ov.Spec.AppVersion,
+		ResourcesOwner:      instance,
+		OperatorName:        ov.Spec.Operator.Name,
+		InstanceNamespace:   instance.Namespace,
+		InstanceName:        instance.Name,
+	}
+
+	activePlan, err := workflow.PreparePlanExecution(instance, ov, &kudoapi.PlanStatus{Name: planName}, meta, cluster.Client)
+	if err != nil {
+		return err
+	}
+
+	diffs, err := workflow.Diff(activePlan, meta, cluster.Client, cluster.Client, cluster.Discovery, cluster.Config, cluster.Scheme)
+	if err != nil {
+		return fmt.Errorf("failed to diff plan %s of instance %s/%s: %v", planName, instance.Namespace, instance.Name, err)
+	}
+
+	pruned, err := prunedObjects(instance, ov, planName, diffs, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to find the objects plan %s of instance %s/%s prunes: %v", planName, instance.Namespace, instance.Name, err)
+	}
+
+	maskSensitive(diffs, pruned, sensitiveValues(activePlan, ov))
+
+	return printDiff(out, planName, diffs, pruned)
+}
+
+// sensitiveValues returns the current and previous values of all sensitive parameters, longest first so that no
+// value is only partially masked
+func sensitiveValues(activePlan *workflow.ActivePlan, ov *kudoapi.OperatorVersion) []string {
+	values := []string{}
+	for _, p := range ov.Spec.Parameters {
+		p := p
+		if !p.IsSensitive() {
+			continue
+		}
+		for _, params := range []map[string]interface{}{activePlan.Params, activePlan.Previous.Params} {
+			if v, ok := params[p.Name].(string); ok && v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	return values
+}
+
+// maskSensitive replaces the values of sensitive parameters in all objects of a diff with kudoapi.MaskedParameterValue.
+// The data of Secrets is masked entirely, as it is base64 encoded.
+func maskSensitive(diffs []workflow.TaskDiff, pruned []task.ObjectDiff, values []string) {
+	mask := func(od *task.ObjectDiff) {
+		od.Live = maskYAML(od.Live, values)
+		od.Desired = maskYAML(od.Desired, values)
+	}
+	for i := range diffs {
+		for j := range diffs[i].Objects {
+			mask(&diffs[i].Objects[j])
+		}
+	}
+	for i := range pruned {
+		mask(&pruned[i])
+	}
+}
+
+func maskYAML(yml string, values []string) string {
+	if yml == "" {
+		return yml
+	}
+
+	obj := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(yml), &obj); err == nil && obj["kind"] == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			data, ok := obj[field].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for k := range data {
+				data[k] = kudoapi.MaskedParameterValue
+			}
+		}
+		if masked, err := yaml.Marshal(obj); err == nil {
+			yml = string(masked)
+		}
+	}
+
+	for _, v := range values {
+		yml = strings.ReplaceAll(yml, v, kudoapi.MaskedParameterValue)
+	}
+	return yml
+}
+
+// prunedObjects returns the deletions of the objects a plan with Plan.Prune enabled would prune: the objects applied by
+// an earlier pruning plan that are not applied by any task of the plan, except objects of skipped steps. All tasks that
+// apply objects (Apply, Helm and Kustomize tasks) are Differs, so the objects of the plan are complete.
+func prunedObjects(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, planName string, diffs []workflow.TaskDiff, cluster *Cluster) ([]task.ObjectDiff, error) {
+	if !ov.Spec.Plans[planName].Prune {
+		return nil, nil
+	}
+
+	applied := map[string]bool{}
+	skippedSteps := map[string]bool{}
+	for _, td := range diffs {
+		if td.Skipped {
+			skippedSteps[td.Phase+"."+td.Step] = true
+		}
+		for _, od := range td.Objects {
+			if od.Action != task.DiffDelete {
+				applied[od.Object] = true
+			}
+		}
+	}
+	desired := func(live *unstructured.Unstructured) bool { return applied[task.ObjectName(live)] }
+	skipped := func(phase, step string) bool { return skippedSteps[phase+"."+step] }
+
+	orphans, err := workflow.OrphanedObjects(instance, ov, desired, skipped, cluster.Client)
+	if err != nil {
+		return nil, err
+	}
+	pruned := make([]task.ObjectDiff, 0, len(orphans))
+	for _, o := range orphans {
+		pruned = append(pruned, task.DeletionDiff(o))
+	}
+	return pruned, nil
+}
+
+func printDiff(out io.Writer, planName string, diffs []workflow.TaskDiff, pruned []task.ObjectDiff) error {
+	counts := map[task.DiffAction]int{}
+
+	for _, td := range diffs {
+		name := fmt.Sprintf("%s.%s.%s.%s", planName, td.Phase, td.Step, td.Task)
+		switch {
+		case td.Skipped:
+			fmt.Fprintf(out, "# Task %s (%s) is skipped, the 'when' expression of its phase or step is false\n", name, td.Kind)
+			continue
+		case !td.Supported:
+			fmt.Fprintf(out, "# Task %s (%s) can not be diffed, its changes are only known when it is executed\n", name, td.Kind)
+			continue
+		}
+
+		fmt.Fprintf(out, "# Task %s (%s)\n", name, td.Kind)
+		for _, od := range td.Objects {
+			counts[od.Action]++
+			if od.Action == task.DiffUnchanged {
+				continue
+			}
+
+			err := difflib.WriteUnifiedDiff(out, difflib.UnifiedDiff{
+				A:        lines(od.Live),
+				B:        lines(od.Desired),
+				FromFile: fmt.Sprintf("live %s", od.Object),
+				ToFile:   fmt.Sprintf("%s %s", od.Action, od.Object),
+				Context:  3,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(pruned) > 0 {
+		fmt.Fprintf(out, "# Pruned by plan %s, applied by an earlier plan but not by this one\n", planName)
+	}
+	for _, od := range pruned {
+		counts[od.Action]++
+		err := difflib.WriteUnifiedDiff(out, difflib.UnifiedDiff{
+			A:        lines(od.Live),
+			FromFile: fmt.Sprintf("live %s", od.Object),
+			ToFile:   fmt.Sprintf("%s %s", od.Action, od.Object),
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "Plan %s: %d to create, %d to patch, %d to delete, %d unchanged\n",
+		planName, counts[task.DiffCreate], counts[task.DiffPatch], counts[task.DiffDelete], counts[task.DiffUnchanged])
+	return nil
+}
+
+// lines splits a YAML document into lines, an object that does not exist has no lines at all
+func lines(yml string) []string {
+	if yml == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(yml, "\n"))
+}
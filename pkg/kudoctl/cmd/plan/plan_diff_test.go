@@ -0,0 +1,129 @@
+package plan
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery/cached/memory"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	kudofake "github.com/kudobuilder/kudo/pkg/test/fake"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+func TestDiff(t *testing.T) {
+	instance := &kudoapi.Instance{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "kudo.dev/v1beta1", Kind: "Instance"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion: corev1.ObjectReference{Name: "test-1.0"},
+			Parameters:      map[string]string{"REPLICAS": "5", "PASSWORD": "s3cr3t"},
+		},
+		Status: kudoapi.InstanceStatus{
+			AppliedObjects: []kudoapi.AppliedObject{
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "backup"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "config"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "legacy"},
+				{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "metrics"},
+			},
+		},
+	}
+	ov := &kudoapi.OperatorVersion{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "kudo.dev/v1beta1", Kind: "OperatorVersion"},
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1.0", Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Operator: corev1.ObjectReference{Name: "test"},
+			Version:  "1.0",
+			Parameters: []kudoapi.Parameter{
+				{Name: "REPLICAS", Default: convert.StringPtr("3")},
+				{Name: "PASSWORD", Default: convert.StringPtr("changeme"), Sensitive: convert.BoolPtr(true)},
+			},
+			Templates: map[string]string{
+				"config.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  replicas: \"{{ .Params.REPLICAS }}\"\n",
+				"extra.yaml":  "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: extra\ndata:\n  foo: bar\n  url: postgres://admin:{{ .Params.PASSWORD }}@db\n",
+				"secret.yaml": "apiVersion: v1\nkind: Secret\nmetadata:\n  name: credentials\nstringData:\n  password: {{ .Params.PASSWORD }}\n",
+
+				"metrics/Chart.yaml":               "name: metrics\nversion: 0.1.0\n",
+				"metrics/templates/configmap.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Chart.Name }}\ndata:\n  replicas: \"{{ .Values.replicas }}\"\n",
+				"metrics-values.yaml":              "replicas: {{ .Params.REPLICAS }}\n",
+			},
+			Tasks: []kudoapi.Task{
+				{Name: "app", Kind: "Apply", Spec: kudoapi.TaskSpec{ResourceTaskSpec: kudoapi.ResourceTaskSpec{Resources: []string{"config.yaml", "extra.yaml", "secret.yaml"}}}},
+				{Name: "metrics", Kind: "Helm", Spec: kudoapi.TaskSpec{HelmTaskSpec: kudoapi.HelmTaskSpec{Chart: "metrics", ValuesFile: "metrics-values.yaml"}}},
+				{Name: "check", Kind: "Exec", Spec: kudoapi.TaskSpec{ExecTaskSpec: kudoapi.ExecTaskSpec{PodSelector: "app=test", Command: []string{"true"}}}},
+			},
+			Plans: map[string]kudoapi.Plan{
+				"deploy": {Strategy: kudoapi.Serial, Prune: true, Phases: []kudoapi.Phase{
+					{Name: "main", Strategy: kudoapi.Serial, Steps: []kudoapi.Step{
+						{Name: "app", Tasks: []string{"app"}},
+						{Name: "metrics", Tasks: []string{"metrics"}},
+						{Name: "check", Tasks: []string{"check"}},
+					}},
+				}},
+			},
+		},
+	}
+	config := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default", Labels: map[string]string{"kudo.dev/instance": "test"}},
+		Data:       map[string]string{"replicas": "3"},
+	}
+	// applied by an earlier deploy plan, its template was removed
+	legacy := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "legacy",
+			Namespace:   "default",
+			Labels:      map[string]string{"heritage": "kudo", "kudo.dev/instance": "test"},
+			Annotations: map[string]string{"kudo.dev/plan": "deploy"},
+		},
+	}
+	// applied by a plan that doesn't prune
+	backup := legacy.DeepCopy()
+	backup.Name = "backup"
+	backup.Annotations["kudo.dev/plan"] = "backup"
+	// applied by an earlier deploy plan and still rendered by its Helm task, it is not pruned
+	metrics := legacy.DeepCopy()
+	metrics.Name = "metrics"
+	metrics.Data = map[string]string{"replicas": "3"}
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NoError(t, kudoapi.AddToScheme(scheme))
+	cluster := &Cluster{
+		Client:    fake.NewFakeClientWithScheme(scheme, config, legacy, backup, metrics),
+		Discovery: memory.NewMemCacheClient(kudofake.CachedDiscoveryClient()),
+		Scheme:    scheme,
+	}
+
+	var buf bytes.Buffer
+	assert.EqualError(t, Diff(&buf, instance, ov, "missing", cluster), "plan missing does not exist in operator version test-1.0")
+
+	assert.NoError(t, Diff(&buf, instance, ov, "deploy", cluster))
+	assert.NotContains(t, buf.String(), "s3cr3t")
+
+	gp := filepath.Join("testdata", "plandiff.txt.golden")
+	if *updateGolden {
+		t.Logf("updating golden file %s", gp)
+
+		//nolint:gosec
+		if err := ioutil.WriteFile(gp, buf.Bytes(), 0644); err != nil {
+			t.Fatalf("failed to update golden file: %s", err)
+		}
+	}
+
+	g, err := ioutil.ReadFile(gp)
+	if err != nil {
+		t.Fatalf("failed reading .golden: %s", err)
+	}
+
+	assert.Equal(t, string(g), buf.String(), "for golden file: %s", gp)
+}
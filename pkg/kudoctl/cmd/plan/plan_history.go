@@ -3,19 +3,29 @@ package plan
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 
+	"github.com/gosuri/uitable"
 	"github.com/spf13/cobra"
 	"github.com/thoas/go-funk"
 	"github.com/xlab/treeprint"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/clog"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/cmd/output"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
 )
 
 // Options are the configurable options for plans
 type Options struct {
+	Out      io.Writer
 	Instance string
+	UID      string
+	Output   output.Type
 }
 
 var (
@@ -23,6 +33,8 @@ var (
 	DefaultHistoryOptions = &Options{}
 )
 
+const timeLayout = "2006-01-02T15:04:05"
+
 // RunHistory runs the plan history command
 func RunHistory(cmd *cobra.Command, options *Options, settings *env.Settings) error {
 	instanceFlag, err := cmd.Flags().GetString("instance")
@@ -30,21 +42,24 @@ func RunHistory(cmd *cobra.Command, options *Options, settings *env.Settings) er
 		return errors.New("please choose the instance with '--instance=<instanceName>'")
 	}
 
-	err = planHistory(options, settings)
+	kc, err := env.GetClient(settings)
+	if err != nil {
+		clog.Printf("Unable to create KUDO client to talk to kubernetes API server: %v", err)
+		return err
+	}
+
+	err = planHistory(kc, options, settings.Namespace)
 	if err != nil {
 		return fmt.Errorf("client Error: %v", err)
 	}
 	return nil
 }
 
-func planHistory(options *Options, settings *env.Settings) error {
-	namespace := settings.Namespace
-
-	kc, err := env.GetClient(settings)
-	if err != nil {
-		clog.Printf("Unable to create KUDO client to talk to kubernetes API server: %v", err)
+func planHistory(kc *kudo.Client, options *Options, namespace string) error {
+	if err := options.Output.Validate(); err != nil {
 		return err
 	}
+
 	instance, err := kc.GetInstance(options.Instance, namespace)
 	if err != nil {
 		return err
@@ -53,8 +68,72 @@ func planHistory(options *Options, settings *env.Settings) error {
 		return clog.Errorf("instance %s/%s does not exist", namespace, options.Instance)
 	}
 
+	if options.UID != "" {
+		record := instance.RecordedPlanExecution(types.UID(options.UID))
+		if record == nil {
+			return fmt.Errorf("plan execution %s of instance %s/%s not found in the plan history", options.UID, namespace, options.Instance)
+		}
+		if options.Output.IsFormattedOutput() {
+			return output.WriteObject(record, options.Output, options.Out)
+		}
+		printPlanExecution(options.Out, record)
+		return nil
+	}
+
+	if options.Output.IsFormattedOutput() {
+		return output.WriteObject(instance.Status.PlanHistory, options.Output, options.Out)
+	}
+
+	// instances that did not record any plan execution yet only have the last status of each plan
+	if len(instance.Status.PlanHistory) == 0 {
+		printLastPlanRuns(options.Out, instance)
+		return nil
+	}
+
+	fmt.Fprintf(options.Out, "Plan history for \"%s\" in namespace \"%s\":\n", instance.Name, namespace)
+	table := uitable.New()
+	table.AddRow("UID", "Plan", "Status", "Trigger", "Started", "Finished", "Parameter Changes")
+	for _, r := range instance.Status.PlanHistory {
+		table.AddRow(r.UID, r.Name, r.Status, r.Trigger, formatTime(r.StartedTimestamp), formatTime(r.FinishedTimestamp), len(r.ParameterChanges))
+	}
+	fmt.Fprintln(options.Out, table)
+
+	return nil
+}
+
+// printPlanExecution prints the details of a single plan execution
+func printPlanExecution(out io.Writer, r *kudoapi.PlanExecutionRecord) {
+	table := uitable.New()
+	table.Wrap = true
+	table.AddRow("UID:", r.UID)
+	table.AddRow("Plan:", r.Name)
+	table.AddRow("Status:", r.Status)
+	if r.Message != "" {
+		table.AddRow("Message:", r.Message)
+	}
+	table.AddRow("Trigger:", r.Trigger)
+	table.AddRow("OperatorVersion:", r.OperatorVersion)
+	table.AddRow("Started:", formatTime(r.StartedTimestamp))
+	table.AddRow("Finished:", formatTime(r.FinishedTimestamp))
+	fmt.Fprintln(out, table)
+
+	if len(r.ParameterChanges) == 0 {
+		fmt.Fprintln(out, "No parameter changes")
+		return
+	}
+
+	fmt.Fprintln(out, "Parameter changes:")
+	changes := uitable.New()
+	changes.AddRow("Name", "From", "To")
+	for _, c := range r.ParameterChanges {
+		changes.AddRow(c.Name, formatValue(c.From), formatValue(c.To))
+	}
+	fmt.Fprintln(out, changes)
+}
+
+// printLastPlanRuns prints the last run of each plan of the instance
+func printLastPlanRuns(out io.Writer, instance *kudoapi.Instance) {
 	tree := treeprint.New()
-	timeLayout := "2006-01-02T15:04:05"
 
 	plans, _ := funk.Keys(instance.Status.PlanStatus).([]string)
 	sort.Strings(plans)
@@ -76,7 +155,19 @@ func planHistory(options *Options, settings *env.Settings) error {
 		tree.AddBranch(historyDisplay)
 	}
 
-	fmt.Println(tree.String())
+	fmt.Fprintln(out, tree.String())
+}
 
-	return nil
+func formatTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(timeLayout)
+}
+
+func formatValue(v *string) string {
+	if v == nil {
+		return "<unset>"
+	}
+	return *v
 }
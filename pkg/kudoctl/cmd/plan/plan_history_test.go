@@ -0,0 +1,120 @@
+package plan
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/client/clientset/versioned/fake"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/cmd/output"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+func TestHistory(t *testing.T) {
+	instance := &kudoapi.Instance{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "kudo.dev/v1beta1",
+			Kind:       "Instance",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Status: kudoapi.InstanceStatus{
+			PlanStatus: map[string]kudoapi.PlanStatus{
+				"deploy": {Name: "deploy", Status: kudoapi.ExecutionComplete, LastUpdatedTimestamp: &metav1.Time{Time: testTime}},
+				"backup": {Name: "backup", Status: kudoapi.ExecutionNeverRun},
+			},
+		},
+	}
+
+	withHistory := instance.DeepCopy()
+	withHistory.Status.PlanHistory = []kudoapi.PlanExecutionRecord{
+		{
+			Name:              "deploy",
+			UID:               "8a1c0f3e-0000-0000-0000-000000000001",
+			Trigger:           kudoapi.PlanTriggerInstall,
+			Status:            kudoapi.ExecutionComplete,
+			OperatorVersion:   "test-1.0",
+			StartedTimestamp:  &metav1.Time{Time: testTime},
+			FinishedTimestamp: &metav1.Time{Time: testTime.Add(time.Minute)},
+			ParameterChanges:  []kudoapi.ParameterChange{{Name: "replicas", To: convert.StringPtr("1")}},
+		},
+		{
+			Name:              "deploy",
+			UID:               "8a1c0f3e-0000-0000-0000-000000000002",
+			Trigger:           kudoapi.PlanTriggerParameterUpdate,
+			Status:            kudoapi.ExecutionFatalError,
+			Message:           "step deploy.main.app failed",
+			OperatorVersion:   "test-1.0",
+			StartedTimestamp:  &metav1.Time{Time: testTime.Add(time.Hour)},
+			FinishedTimestamp: &metav1.Time{Time: testTime.Add(time.Hour + time.Minute)},
+			ParameterChanges: []kudoapi.ParameterChange{
+				{Name: "image", From: convert.StringPtr("nginx:1.18")},
+				{Name: "replicas", From: convert.StringPtr("1"), To: convert.StringPtr("3")},
+			},
+		},
+	}
+
+	var tests = []struct {
+		name         string
+		instance     *kudoapi.Instance
+		uid          string
+		output       output.Type
+		errorMessage string
+		goldenFile   string
+	}{
+		{name: "nonexisting instance", errorMessage: "instance default/test does not exist"},
+		{name: "no history", instance: instance, goldenFile: "planhistory_nohistory.txt"},
+		{name: "history", instance: withHistory, goldenFile: "planhistory.txt"},
+		{name: "history yaml output", instance: withHistory, output: "yaml", goldenFile: "planhistory.yaml"},
+		{name: "plan execution", instance: withHistory, uid: "8a1c0f3e-0000-0000-0000-000000000002", goldenFile: "planhistory_execution.txt"},
+		{name: "unknown plan execution", instance: withHistory, uid: "unknown", errorMessage: "plan execution unknown of instance default/test not found in the plan history"},
+		{name: "invalid output", instance: withHistory, output: "invalid", errorMessage: output.InvalidOutputError},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			kc := kudo.NewClientFromK8s(fake.NewSimpleClientset(), kubefake.NewSimpleClientset())
+			if tt.instance != nil {
+				_, err := kc.InstallInstanceObjToCluster(tt.instance, "default")
+				if err != nil {
+					t.Errorf("%s: error when setting up a test - %v", tt.name, err)
+				}
+			}
+
+			err := planHistory(kc, &Options{Out: &buf, Instance: "test", UID: tt.uid, Output: tt.output}, "default")
+			if tt.errorMessage != "" {
+				assert.EqualError(t, err, tt.errorMessage)
+				return
+			}
+			assert.NoError(t, err)
+
+			gp := filepath.Join("testdata", tt.goldenFile+".golden")
+			if *updateGolden {
+				t.Logf("updating golden file %s", tt.goldenFile)
+
+				//nolint:gosec
+				if err := ioutil.WriteFile(gp, buf.Bytes(), 0644); err != nil {
+					t.Fatalf("failed to update golden file: %s", err)
+				}
+			}
+
+			g, err := ioutil.ReadFile(gp)
+			if err != nil {
+				t.Fatalf("failed reading .golden: %s", err)
+			}
+
+			assert.Equal(t, string(g), buf.String(), "for golden file: %s, for test %s", gp, tt.name)
+		})
+	}
+}
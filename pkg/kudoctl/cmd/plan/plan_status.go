@@ -110,6 +110,9 @@ func status(kc *kudo.Client, options *StatusOptions, ns string) error {
 					phaseBranchName := planBranchName.AddBranch(phaseDisplay)
 					for _, steps := range phase.Steps {
 						stepsDisplay := fmt.Sprintf("Step %s [%s]%s", steps.Name, steps.Status, printMessageIfAvailable(steps.Message))
+						if steps.Attempts > 0 {
+							stepsDisplay = fmt.Sprintf("%s, %d failed attempt(s)", stepsDisplay, steps.Attempts)
+						}
 						phaseBranchName.AddBranch(stepsDisplay)
 					}
 				}
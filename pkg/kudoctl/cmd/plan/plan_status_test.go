@@ -91,9 +91,10 @@ func TestStatus(t *testing.T) {
 						Status: kudoapi.ExecutionFatalError,
 						Steps: []kudoapi.StepStatus{
 							{
-								Name:    "deploy",
-								Status:  kudoapi.ExecutionFatalError,
-								Message: "error detail",
+								Name:     "deploy",
+								Status:   kudoapi.ExecutionFatalError,
+								Message:  "error detail",
+								Attempts: 3,
 							},
 						},
 					},
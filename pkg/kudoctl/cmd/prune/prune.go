@@ -0,0 +1,138 @@
+package prune
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
+)
+
+type CmdOpts struct {
+	Out    io.Writer
+	Client *kudo.Client
+
+	Namespace string
+	// Keep is the number of the newest operator versions of each operator that are kept even if they are unused
+	Keep   int
+	DryRun bool
+}
+
+// Run deletes the unused operator versions of a namespace and the operators that don't have any operator version left
+func Run(opts CmdOpts) error {
+	if opts.Keep < 0 {
+		return errors.New("the number of operator versions to keep must not be negative")
+	}
+
+	ovs, err := opts.Client.ListOperatorVersions(opts.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list operator versions in namespace %s: %v", opts.Namespace, err)
+	}
+	// Only the instances of the namespace are listed, so that pruning doesn't require cluster-wide permissions. The
+	// rare instances of other namespaces that reference these operator versions are guarded by the admission webhook,
+	// which denies deleting an operator version that is still in use.
+	instances, err := opts.Client.ListInstances(opts.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list instances in namespace %s: %v", opts.Namespace, err)
+	}
+	operators, err := opts.Client.ListOperators(opts.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list operators in namespace %s: %v", opts.Namespace, err)
+	}
+
+	dryRun := ""
+	if opts.DryRun {
+		dryRun = " (dry run)"
+	}
+
+	unused := UnusedOperatorVersions(ovs, instances, opts.Keep)
+	remaining := map[string]int{}
+	for _, ov := range ovs {
+		remaining[ov.Spec.Operator.Name]++
+	}
+	for _, ov := range unused {
+		if !opts.DryRun {
+			if err := opts.Client.DeleteOperatorVersion(ov.Name, ov.Namespace); err != nil {
+				return fmt.Errorf("failed to delete operatorversion %s/%s: %v", ov.Namespace, ov.Name, err)
+			}
+		}
+		remaining[ov.Spec.Operator.Name]--
+		fmt.Fprintf(opts.Out, "operatorversion.kudo.dev/%s deleted%s\n", ov.Name, dryRun)
+	}
+
+	deleted := len(unused)
+	for _, o := range operators {
+		if remaining[o.Name] > 0 {
+			continue
+		}
+		if !opts.DryRun {
+			if err := opts.Client.DeleteOperator(o.Name, o.Namespace); err != nil {
+				return fmt.Errorf("failed to delete operator %s/%s: %v", o.Namespace, o.Name, err)
+			}
+		}
+		deleted++
+		fmt.Fprintf(opts.Out, "operator.kudo.dev/%s deleted%s\n", o.Name, dryRun)
+	}
+
+	if deleted == 0 {
+		fmt.Fprintf(opts.Out, "No unused operator versions found in namespace %s\n", opts.Namespace)
+	}
+	return nil
+}
+
+// UnusedOperatorVersions returns the operator versions that can be deleted, newest versions first. An operator version
+// is kept if:
+// - it is referenced by an instance
+// - it is one of the 'keep' newest versions of its operator
+// - it is referenced by a KudoOperator task of a kept operator version, and could be installed by a future plan
+func UnusedOperatorVersions(ovs []kudoapi.OperatorVersion, instances []kudoapi.Instance, keep int) []*kudoapi.OperatorVersion {
+	// sorted by operator name, and from the newest to the oldest version
+	sorted := kudoapi.ToSortableOperatorList(ovs)
+	sorted.Sort()
+
+	used := map[string]bool{}
+	for _, i := range instances {
+		used[fmt.Sprintf("%s/%s", i.OperatorVersionNamespace(), i.Spec.OperatorVersion.Name)] = true
+	}
+
+	kept := map[*kudoapi.OperatorVersion]bool{}
+	queue := []*kudoapi.OperatorVersion{}
+	versions := map[string]int{}
+	for _, o := range sorted {
+		ov := o.(*kudoapi.OperatorVersion)
+		if versions[ov.OperatorName()] < keep || used[fmt.Sprintf("%s/%s", ov.Namespace, ov.Name)] {
+			kept[ov] = true
+			queue = append(queue, ov)
+		}
+		versions[ov.OperatorName()]++
+	}
+
+	// keep the operator versions a KudoOperator task would resolve to, see InClusterResolver
+	for len(queue) > 0 {
+		ov := queue[0]
+		queue = queue[1:]
+		for _, t := range ov.Spec.Tasks {
+			if t.Kind != task.KudoOperatorTaskKind {
+				continue
+			}
+			spec := t.Spec.KudoOperatorTaskSpec
+			// The ignored error here is from the cast to OperatorVersion and we can ignore it, as we handle the nil case below
+			child, _ := sorted.FindFirstMatch(spec.Package, spec.OperatorVersion, spec.AppVersion).(*kudoapi.OperatorVersion) // nolint:errcheck
+			if child != nil && !kept[child] {
+				kept[child] = true
+				queue = append(queue, child)
+			}
+		}
+	}
+
+	unused := []*kudoapi.OperatorVersion{}
+	for _, o := range sorted {
+		ov := o.(*kudoapi.OperatorVersion)
+		if !kept[ov] {
+			unused = append(unused, ov)
+		}
+	}
+	return unused
+}
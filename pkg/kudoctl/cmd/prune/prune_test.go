@@ -0,0 +1,168 @@
+package prune
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/client/clientset/versioned/fake"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
+)
+
+func operatorVersion(operator, version string, tasks ...kudoapi.Task) *kudoapi.OperatorVersion {
+	return &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: kudoapi.OperatorVersionName(operator, "", version), Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Operator: v1.ObjectReference{Name: operator},
+			Version:  version,
+			Tasks:    tasks,
+		},
+	}
+}
+
+func instance(name, ns, ovName, ovNamespace string) *kudoapi.Instance {
+	return &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Spec:       kudoapi.InstanceSpec{OperatorVersion: v1.ObjectReference{Name: ovName, Namespace: ovNamespace}},
+	}
+}
+
+func names(ovs []*kudoapi.OperatorVersion) []string {
+	result := []string{}
+	for _, ov := range ovs {
+		result = append(result, ov.Name)
+	}
+	return result
+}
+
+func TestUnusedOperatorVersions(t *testing.T) {
+	childTask := kudoapi.Task{
+		Name: "zookeeper",
+		Kind: "KudoOperator",
+		Spec: kudoapi.TaskSpec{KudoOperatorTaskSpec: kudoapi.KudoOperatorTaskSpec{Package: "zookeeper", OperatorVersion: "0.2.0"}},
+	}
+	ovs := []kudoapi.OperatorVersion{
+		*operatorVersion("kafka", "1.0.0"),
+		*operatorVersion("kafka", "1.10.0", childTask),
+		*operatorVersion("kafka", "1.2.0"),
+		*operatorVersion("kafka", "1.9.0"),
+		*operatorVersion("zookeeper", "0.1.0"),
+		*operatorVersion("zookeeper", "0.2.0"),
+		*operatorVersion("zookeeper", "0.3.0"),
+	}
+
+	tests := []struct {
+		name      string
+		instances []kudoapi.Instance
+		keep      int
+		want      []string
+	}{
+		{
+			name: "keep the newest version of each operator",
+			keep: 1,
+			want: []string{"kafka-1.9.0", "kafka-1.2.0", "kafka-1.0.0", "zookeeper-0.1.0"},
+		},
+		{
+			name: "keep the two newest versions of each operator",
+			keep: 2,
+			want: []string{"kafka-1.2.0", "kafka-1.0.0", "zookeeper-0.1.0"},
+		},
+		{
+			name:      "keep used versions",
+			instances: []kudoapi.Instance{*instance("kafka", "default", "kafka-1.0.0", ""), *instance("zk", "other", "zookeeper-0.1.0", "default")},
+			keep:      1,
+			want:      []string{"kafka-1.9.0", "kafka-1.2.0"},
+		},
+		{
+			name:      "instances of other namespaces reference their own operator versions",
+			instances: []kudoapi.Instance{*instance("kafka", "other", "kafka-1.0.0", "")},
+			keep:      0,
+			want:      []string{"kafka-1.10.0", "kafka-1.9.0", "kafka-1.2.0", "kafka-1.0.0", "zookeeper-0.3.0", "zookeeper-0.2.0", "zookeeper-0.1.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, names(UnusedOperatorVersions(ovs, tt.instances, tt.keep)))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	objs := []runtime.Object{
+		&kudoapi.Operator{ObjectMeta: metav1.ObjectMeta{Name: "kafka", Namespace: "default"}},
+		&kudoapi.Operator{ObjectMeta: metav1.ObjectMeta{Name: "zookeeper", Namespace: "default"}},
+		operatorVersion("kafka", "1.0.0"),
+		operatorVersion("kafka", "2.0.0"),
+		operatorVersion("zookeeper", "0.1.0"),
+		instance("kafka", "default", "kafka-1.0.0", ""),
+	}
+
+	tests := []struct {
+		name       string
+		keep       int
+		dryRun     bool
+		wantOut    string
+		wantOVs    int
+		wantOpsLen int
+	}{
+		{
+			name:       "dry run",
+			keep:       0,
+			dryRun:     true,
+			wantOut:    "operatorversion.kudo.dev/kafka-2.0.0 deleted (dry run)\noperatorversion.kudo.dev/zookeeper-0.1.0 deleted (dry run)\noperator.kudo.dev/zookeeper deleted (dry run)\n",
+			wantOVs:    3,
+			wantOpsLen: 2,
+		},
+		{
+			name:       "delete unused operator versions and operators",
+			keep:       0,
+			wantOut:    "operatorversion.kudo.dev/kafka-2.0.0 deleted\noperatorversion.kudo.dev/zookeeper-0.1.0 deleted\noperator.kudo.dev/zookeeper deleted\n",
+			wantOVs:    1,
+			wantOpsLen: 1,
+		},
+		{
+			name:       "nothing to delete",
+			keep:       1,
+			wantOut:    "No unused operator versions found in namespace default\n",
+			wantOVs:    3,
+			wantOpsLen: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			kcs := fake.NewSimpleClientset(objs...)
+			out := &bytes.Buffer{}
+			opts := CmdOpts{
+				Out:       out,
+				Client:    kudo.NewClientFromK8s(kcs, kubefake.NewSimpleClientset()),
+				Namespace: "default",
+				Keep:      tt.keep,
+				DryRun:    tt.dryRun,
+			}
+
+			assert.NoError(t, Run(opts))
+			assert.Equal(t, tt.wantOut, out.String())
+
+			ovs, _ := kcs.KudoV1beta1().OperatorVersions("default").List(context.TODO(), metav1.ListOptions{})
+			assert.Equal(t, tt.wantOVs, len(ovs.Items))
+			operators, _ := kcs.KudoV1beta1().Operators("default").List(context.TODO(), metav1.ListOptions{})
+			assert.Equal(t, tt.wantOpsLen, len(operators.Items))
+		})
+	}
+}
+
+func TestRun_NegativeKeep(t *testing.T) {
+	err := Run(CmdOpts{Keep: -1})
+	assert.EqualError(t, err, "the number of operator versions to keep must not be negative")
+}
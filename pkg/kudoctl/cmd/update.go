@@ -3,12 +3,15 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/clog"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/cmd/params"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/cmd/plan"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/env"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
 )
@@ -20,7 +23,10 @@ var (
   kubectl kudo update --instance dev-flink -p param=value
 
   # Update dev-flink instance in namespace services with setting parameter param with value value
-  kubectl kudo update --instance dev-flink -n services -p param=value`
+  kubectl kudo update --instance dev-flink -n services -p param=value
+
+  # Show the changes an update of dev-flink would make to the cluster without updating it
+  kubectl kudo update --instance dev-flink -p param=value --dry-run`
 )
 
 type updateOptions struct {
@@ -28,6 +34,8 @@ type updateOptions struct {
 	Parameters   map[string]string
 	Wait         bool
 	WaitTime     int64
+	DryRun       bool
+	Out          io.Writer
 }
 
 // defaultOptions initializes the install command options to its defaults
@@ -50,6 +58,7 @@ func newUpdateCmd() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("could not parse parameters: %v", err)
 			}
+			options.Out = cmd.OutOrStdout()
 			return runUpdate(args, options, &Settings)
 		},
 	}
@@ -59,6 +68,7 @@ func newUpdateCmd() *cobra.Command {
 	updateCmd.Flags().StringArrayVarP(&parameterFiles, "parameter-file", "P", nil, "YAML file with parameters")
 	updateCmd.Flags().BoolVar(&options.Wait, "wait", false, "Specify if the CLI should wait for the update to complete before returning (default \"false\")")
 	updateCmd.Flags().Int64Var(&options.WaitTime, "wait-time", 300, "Specify the max wait time in seconds for CLI for the update to complete before returning (default \"300\")")
+	updateCmd.Flags().BoolVar(&options.DryRun, "dry-run", false, "Show the changes the triggered plan would make to the cluster without updating the instance (default \"false\")")
 
 	return updateCmd
 }
@@ -102,6 +112,10 @@ func update(instanceToUpdate string, kc *kudo.Client, options *updateOptions, se
 		return fmt.Errorf("instance %s in namespace %s does not exist in the cluster", instanceToUpdate, settings.Namespace)
 	}
 
+	if options.DryRun {
+		return updateDryRun(instance, kc, options, settings)
+	}
+
 	// Update arguments
 	err = kc.UpdateInstance(instanceToUpdate, settings.Namespace, nil, options.Parameters, nil, options.Wait, time.Duration(options.WaitTime)*time.Second)
 	if err != nil {
@@ -110,3 +124,41 @@ func update(instanceToUpdate string, kc *kudo.Client, options *updateOptions, se
 	clog.Printf("Instance %s was updated.", instanceToUpdate)
 	return nil
 }
+
+// updateDryRun prints the diff of the plan that the parameter update would trigger, without updating the instance
+func updateDryRun(instance *kudoapi.Instance, kc *kudo.Client, options *updateOptions, settings *env.Settings) error {
+	ov, err := kc.GetOperatorVersion(instance.Spec.OperatorVersion.Name, settings.Namespace)
+	if err != nil {
+		return fmt.Errorf("getting the operator version of instance %s: %w", instance.Name, err)
+	}
+	if ov == nil {
+		return fmt.Errorf("operator version %s of instance %s does not exist in the cluster", instance.Spec.OperatorVersion.Name, instance.Name)
+	}
+
+	updated := instance.DeepCopy()
+	if updated.Spec.Parameters == nil {
+		updated.Spec.Parameters = map[string]string{}
+	}
+	for name, value := range options.Parameters {
+		updated.Spec.Parameters[name] = value
+	}
+
+	changed, err := kudoapi.GetParamDefinitions(kudoapi.ParameterDiff(instance.Spec.Parameters, updated.Spec.Parameters), ov)
+	if err != nil {
+		return err
+	}
+	planName, err := kudoapi.TriggeredByParameterUpdate(changed, ov)
+	if err != nil {
+		return err
+	}
+	if planName == nil {
+		clog.Printf("Updating instance %s would not change any parameter and trigger no plan.", instance.Name)
+		return nil
+	}
+
+	cluster, err := plan.NewCluster(settings)
+	if err != nil {
+		return err
+	}
+	return plan.Diff(options.Out, updated, ov, *planName, cluster)
+}
@@ -6,29 +6,22 @@ import (
 	"strings"
 
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
-	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier/plan"
-	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier/task"
+	pkgverifier "github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier/template"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
 	"github.com/kudobuilder/kudo/pkg/version"
 )
 
+// verifiers check the package-only metadata that is not part of an OperatorVersion, in addition to the shared
+// verifiers
 var verifiers = []packages.Verifier{
-	DuplicateVerifier{},
-	InvalidCharVerifier{";,"},
 	VersionVerifier{},
-	task.BuildVerifier{},
-	task.ReferenceVerifier{},
-	plan.ReferenceVerifier{},
-	template.ParametersVerifier{},
-	template.ReferenceVerifier{},
-	template.RenderVerifier{},
 	template.NamespaceVerifier{},
 }
 
 // PackageFiles verifies operator package files
 func PackageFiles(pf *packages.Files) verifier.Result {
-	res := verifier.NewResult()
+	res := pkgverifier.Verify(pf)
 	for _, vv := range verifiers {
 		res.Merge(vv.Verify(pf))
 	}
@@ -44,41 +37,6 @@ func PrintResult(res verifier.Result, out io.Writer) {
 	}
 }
 
-// DuplicateVerifier provides verification that there are no duplicates disallowing casing (Kudo and kudo are duplicates)
-type DuplicateVerifier struct{}
-
-func (DuplicateVerifier) Verify(pf *packages.Files) verifier.Result {
-	res := verifier.NewResult()
-	names := map[string]bool{}
-	for _, param := range pf.Params.Parameters {
-		name := strings.ToLower(param.Name)
-		if names[name] {
-			res.AddParamError(param.Name, "has a duplicate")
-		}
-		names[name] = true
-	}
-	return res
-}
-
-type InvalidCharVerifier struct {
-	InvalidChars string
-}
-
-func (v InvalidCharVerifier) Verify(pf *packages.Files) verifier.Result {
-	res := verifier.NewResult()
-	for _, param := range pf.Params.Parameters {
-		name := strings.ToLower(param.Name)
-		for _, char := range name {
-			if strings.Contains(v.InvalidChars, strings.ToLower(string(char))) {
-				res.AddParamError(param.Name, fmt.Sprintf("contains invalid character %q", char))
-			}
-		}
-
-	}
-
-	return res
-}
-
 // VersionVerifier verifies the version in operator.yaml, kubernetesVersion, operatorVersion and kudoVersion
 type VersionVerifier struct{}
 
@@ -1,7 +1,6 @@
 package verify
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,72 +8,6 @@ import (
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
 )
 
-func TestDuplicateVerifier(t *testing.T) {
-	tests := []struct {
-		name             string
-		params           []packages.Parameter
-		expectedWarnings []string
-		expectedErrors   []string
-	}{
-		{"no warning or error", []packages.Parameter{
-			{Name: "Foo"},
-			{Name: "Fighters"},
-		}, []string{}, []string{}},
-		{"duplicate parameter", []packages.Parameter{
-			{Name: "Foo"},
-			{Name: "Foo"},
-		}, []string{}, []string{"parameter \"Foo\" has a duplicate"}},
-		{"duplicate with different casing", []packages.Parameter{
-			{Name: "Foo"},
-			{Name: "foo"},
-		}, []string{}, []string{"parameter \"foo\" has a duplicate"}},
-	}
-
-	verifier := DuplicateVerifier{}
-	for _, tt := range tests {
-		res := verifier.Verify(packageFileForParams(tt.params))
-		assert.Equal(t, tt.expectedWarnings, res.Warnings)
-		assert.Equal(t, tt.expectedErrors, res.Errors)
-	}
-}
-
-func TestInvalidCharVerifier(t *testing.T) {
-	tests := []struct {
-		name             string
-		params           []packages.Parameter
-		expectedWarnings []string
-		expectedErrors   []string
-	}{
-		{"no warning or error", []packages.Parameter{
-			{Name: "Foo"},
-			{Name: "Fighters"},
-		}, []string{}, []string{}},
-		{"invalid character", []packages.Parameter{
-			{Name: "Foo:"},
-			{Name: "Fighters,"},
-		}, []string{}, []string{
-			fmt.Sprintf("parameter %q %s", "Foo:", "contains invalid character ':'"),
-			fmt.Sprintf("parameter %q %s", "Fighters,", "contains invalid character ','"),
-		}},
-	}
-
-	verifier := InvalidCharVerifier{InvalidChars: ":,"}
-	for _, tt := range tests {
-		res := verifier.Verify(packageFileForParams(tt.params))
-		assert.Equal(t, tt.expectedWarnings, res.Warnings, tt.name)
-		assert.Equal(t, tt.expectedErrors, res.Errors, tt.name)
-	}
-}
-
-func packageFileForParams(params []packages.Parameter) *packages.Files {
-	p := packages.ParamsFile{
-		Parameters: params,
-	}
-	return &packages.Files{
-		Params: &p,
-	}
-}
-
 func TestK8sVersionVerifier(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -80,7 +80,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _configCrdsKudoDev_instancesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x3a\x6b\x73\xdb\xb6\x96\xdf\xfd\x2b\xce\xa8\x3b\x63\x3b\x95\xa8\xd8\xed\x74\x5b\xcd\x66\x33\x59\x27\xe9\x78\x9b\x87\x27\x76\xba\xd3\xb5\xdd\x5b\x88\x3c\x12\x51\x93\x00\x83\x87\x64\xb5\xe9\x7f\xbf\x73\x0e\x40\x8a\xb2\xa9\x47\xdc\xa6\xf7\xc3\xe5\x17\x5b\x20\x70\xde\x6f\x70\x6f\x30\x18\xec\x89\x4a\xfe\x88\xc6\x4a\xad\x46\x20\x2a\x89\xb7\x0e\x15\xfd\xb2\xc9\xcd\xb7\x36\x91\x7a\x38\x3b\xda\xbb\x91\x2a\x1b\xc1\x89\xb7\x4e\x97\xef\xd0\x6a\x6f\x52\x7c\x8e\x13\xa9\xa4\x93\x5a\xed\x95\xe8\x44\x26\x9c\x18\xed\x01\x08\xa5\xb4\x13\xb4\x6c\xe9\x27\x40\xaa\x95\x33\xba\x28\xd0\x0c\xa6\xa8\x92\x1b\x3f\xc6\xb1\x97\x45\x86\x86\x81\xd7\xa8\x67\x8f\x93\xaf\x93\xa3\x3d\x80\xd4\x20\x1f\xbf\x90\x25\x5a\x27\xca\x6a\x04\xca\x17\xc5\x1e\x80\x12\x25\x8e\x40\x2a\xeb\x84\x4a\xd1\x26\x37\x3e\xd3\x49\x86\xb3\x3d\x5b\x61\x4a\xc8\xa6\x46\xfb\x6a\x04\xcd\x7a\x38\x12\xe9\x08\x3c\x9c\xc6\xd3\xbc\x54\x48\xeb\x7e\x58\x59\x7e\x25\xad\xe3\x57\x55\xe1\x8d\x28\x5a\xd8\x78\xd5\x4a\x35\xf5\x85\x30\xcb\xf5\x3d\x00\x9b\xea\x0a\x47\xf0\x86\x50\x55\x22\xc5\x6c\x0f\x20\xb2\xc5\xa8\x07\x91\xf0\xd9\xd1\x18\x9d\x38\x0a\x80\xd2\x1c\x4b\x11\x08\x03\xd0\x15\xaa\x67\x67\xa7\x3f\x7e\x75\xbe\xb2\x0c\x90\xa1\x4d\x8d\xac\x1c\x4b\xa8\xa6\x11\xa4\x05\x97\x23\x84\xcd\x30\xd1\x86\x7f\x36\x94\xc2\xb3\xb3\xd3\xa4\x01\x51\x19\x5d\xa1\x71\xb2\x16\x43\x78\x5a\x4a\x6f\xad\xde\x41\xb8\x4f\x34\x85\x5d\x90\x91\xb6\x31\x20\x8e\xcc\x61\x16\xd9\x00\x3d\x01\x97\x4b\x0b\x06\x2b\x83\x16\x55\xd0\x3f\x2d\x0b\x05\x7a\xfc\x2b\xa6\x2e\x81\x73\x34\x74\x10\x6c\xae\x7d\x91\x91\x59\xcc\xd0\x38\x30\x98\xea\xa9\x92\xbf\x35\xd0\x2c\x38\xcd\x68\x0a\xe1\xd0\x3a\x90\xca\xa1\x51\xa2\x80\x99\x28\x3c\xf6\x41\xa8\x0c\x4a\xb1\x00\x83\x04\x17\xbc\x6a\x41\xe0\x2d\x36\x81\xd7\xda\x90\x40\x26\x7a\x04\xb9\x73\x95\x1d\x0d\x87\x53\xe9\x6a\x83\x4e\x75\x59\x7a\x25\xdd\x62\xc8\xb6\x29\xc7\xde\x69\x63\x87\x19\xce\xb0\x18\x5a\x39\x1d\x08\x93\xe6\xd2\x61\xea\xbc\xc1\xa1\xa8\xe4\x80\x89\x55\x6c\xd4\x49\x99\x7d\x61\xa2\x0b\xd8\xfd\x15\xe1\xb9\x05\xd9\x81\x75\x46\xaa\x69\xeb\x05\x1b\xde\x06\x29\x93\x05\x92\x4e\x45\x3c\x1a\xb8\x58\x0a\x93\x96\x48\x1e\xef\x5e\x9c\x5f\x40\x8d\x3a\x08\x3c\xc8\x76\xb9\xd5\x2e\xc5\x4c\x22\x92\x6a\x82\x26\xec\x9c\x18\x5d\x32\x14\x54\x59\xa5\xa5\x72\xfc\x23\x2d\x24\x2a\x07\xd6\x8f\x4b\xe9\x48\x7f\x1f\x3c\x5a\x47\x1a\x48\xe0\x84\x3d\x19\xc6\x08\xbe\xca\x84\xc3\x2c\x81\x53\x05\x27\xa2\xc4\xe2\x44\x58\xfc\xec\x42\x26\x69\xda\x01\x09\x6f\x37\x31\xb7\x83\xd0\xdd\xcd\x41\x4e\xad\x17\x75\xc4\x58\xa3\x93\xda\xd5\xce\x2b\x4c\x57\x4c\x3f\x43\x2b\x0d\x99\xaa\x13\x0e\xc9\xc0\xeb\x9d\xc9\x0a\xb0\x6e\xa7\x8b\xae\x6e\x84\xd3\xa6\xd3\xfb\xee\xd1\xf1\x76\x75\x37\x93\x2d\x27\x12\xc9\x58\x0c\x4e\xd0\x20\xc5\x03\xa7\xc9\x76\xc2\xab\xf4\xde\x99\xe8\x7f\xf7\x10\xad\xa7\x11\x36\x04\x88\x4e\x32\x9f\x9d\x9d\xd6\x41\x21\xc4\x02\xac\xa9\xeb\xc0\xbb\x41\x85\xf5\x33\x91\x58\x64\x67\xc2\xe5\x3b\xe0\xde\x3f\x9d\x04\x64\xec\x3a\x2c\x8a\x4a\x62\x8a\x2b\xd1\x87\x83\x23\x8a\x2c\x2e\x92\x95\x19\x8c\xef\xfa\xc1\x41\xa2\xef\x2d\xa3\x93\x13\x52\x81\x20\x67\x94\x19\xfc\xef\xf9\xdb\x37\xc3\xef\x75\xa0\x0c\x44\x9a\xa2\xb5\xc1\x08\x4a\x54\xae\x0f\xd6\xa7\x39\x08\x5b\xdb\xc7\x39\xbd\x49\x4a\xa1\xe4\x04\xad\x4b\x22\x34\x34\xf6\xf2\xf8\x3a\x81\x97\xda\x00\xde\x8a\xb2\x2a\xb0\x0f\x32\xc8\xab\xf1\xe4\x5a\xa9\xd2\x06\x66\x9a\xb3\x30\x97\x2e\x67\x92\x2a\x9d\x45\xa2\xe7\x4c\xac\x13\x37\x08\x3a\x12\xeb\x11\x0a\x79\x83\x23\xe8\x91\x45\xb4\x50\xff\x4e\x59\xe8\x8f\x1e\x1c\xcc\x73\x34\x08\x3d\xfa\xd9\x0b\x08\x9b\x90\x4b\x6b\xb5\x06\x97\x88\x5d\x2e\x1c\x38\x23\xa7\x53\x24\xdb\xe7\x28\x42\x9e\x7a\x08\xda\x10\xfd\x4a\xb7\x36\x33\x08\x92\x67\x34\xd5\xec\x1e\x21\x97\xc7\xd7\x3d\x38\x58\xe5\x0b\xa4\xca\xf0\x16\x8e\x41\xaa\xc0\x59\xa5\xb3\xc3\x04\x2e\x58\x33\x0b\xe5\xc4\x2d\xc1\x4c\x73\x6d\x51\x81\x56\xc5\x82\x28\xce\xc5\x0c\xc1\xea\x12\x61\x8e\x45\x31\x08\x7e\x9a\xc1\x5c\x2c\x88\x87\x5a\x94\xa4\x55\x01\x95\x30\xee\x4e\x42\xba\x78\xfb\xfc\xed\x28\x60\x23\xb5\x4d\x15\xa1\xa0\x90\x37\x91\x94\x6e\x28\xcf\x84\xd0\xc9\x3a\x27\x42\x7c\x50\x92\xd3\x90\xe6\x42\x4d\x31\x50\x8b\x30\xf1\x14\xc4\x92\xfd\x87\xd8\xfa\xfd\xec\xd0\x6d\xe6\x9c\x25\xee\x3a\xd7\xbf\x2c\x06\xef\xc8\x1c\x17\x3e\x3b\x30\xf7\xa6\x65\x77\x1b\x99\xa3\xea\xd1\x28\x74\xc8\xfc\x65\x3a\xb5\xc4\x5a\x8a\x95\xb3\x43\x3d\x43\x33\x93\x38\x1f\xce\xb5\xb9\x91\x6a\x3a\x20\xc3\x1a\x04\x6d\xdb\x21\x57\x82\xc3\x2f\xf8\xcf\x83\x79\xe1\xfa\x6e\x57\x86\x78\xf3\xdf\xc1\x15\xe1\xb1\xc3\x07\x31\x55\x97\x13\xbb\xc7\xfa\xfd\xf3\x3a\xd1\xdc\x39\x4b\x6e\x31\xcf\x65\x9a\xd7\xb5\x60\x2b\x92\x95\x22\x0b\xa1\x4e\xa8\xc5\x67\x37\x5a\x12\x9d\x37\x84\x7b\x31\x88\xcd\xc7\x40\xa8\x8c\xfe\xb7\xd2\x3a\x5a\x7f\x90\xac\xbc\xdc\xc9\x51\xdf\x9f\x3e\xff\x7b\x4c\xd9\xcb\x07\x79\xe5\x9a\x8a\x88\x9e\x4a\x18\x51\xa2\x43\xd3\x51\x12\x88\x2c\xe3\x66\x4f\x14\x67\x1b\x0b\x87\x07\xe3\x2e\x84\x7a\x71\x8b\xa9\x77\xdb\xcb\xa2\xfd\x0b\x4e\x61\xc2\x20\xb8\xb9\xa6\x80\x4f\x05\x11\x41\x00\xac\x41\x40\x2a\x14\x15\xaf\x4d\xde\x1a\x01\x1c\x1d\x52\x9e\x91\x06\x53\x47\x19\x24\x37\xda\x4f\xf3\x58\xde\x72\x72\x80\x54\x1b\x83\xb6\xd2\x2a\xa3\xb4\xd1\xc8\xa3\x0e\xf4\xed\xba\x30\x39\x6b\xa4\x05\xa5\xa8\x00\x8e\x0f\xe1\x1e\x6c\x8b\x8e\xeb\xf7\x68\x10\xab\xe7\xdb\x1c\xf3\x2f\x0e\x83\x21\xdd\xfc\x5f\x2e\x0b\x6c\xa8\x85\x83\xa3\xc3\x9a\x13\x0b\xb9\xa8\x2a\x54\x96\x92\xb0\x59\x80\x93\x25\x82\x00\x6f\xd1\xc4\xb4\x64\x43\xbe\x0b\xc4\xf5\x41\x2c\xc9\x3a\x38\x3e\x6c\x25\x72\x16\x18\xbb\xaa\xa5\xa6\x21\x6b\x5a\x49\x2b\x9d\x0f\x2d\x3c\xcc\x73\x54\x2d\xbb\x80\x4c\xa3\x55\xfb\xfb\xae\xce\x80\x98\x4c\x13\x42\x87\x46\xea\x4c\xa6\x30\x16\xe9\x8d\xaf\xb8\x7a\x69\xf0\x90\x35\x1b\x99\xd5\x7d\x0c\xde\x4a\xcb\x42\x89\x7b\x27\xb2\xc0\x04\x9e\x35\xf6\x55\x2c\x62\x75\xa3\x99\x4b\xa3\x75\xc9\x94\xa5\x24\xb9\x82\xd3\xb9\x5a\x01\x1a\xbc\x9d\xf8\x33\x5e\x29\x56\x5c\x21\x94\xbd\x93\x9d\xe1\x8d\x76\x38\x82\x15\xa9\x47\x61\xd7\x15\x3e\x0b\x84\x0b\x18\xc2\xb0\xc6\x16\x6c\xa8\x87\x4e\xcf\xe1\xe4\xfd\xbb\x77\x2f\xde\x5c\xbc\xfa\x29\x5a\x1d\xb5\x48\x6f\xb9\x20\x6f\xb5\xe3\xad\xf9\x07\x1c\x9c\x9e\x1c\x92\x68\x32\xad\x30\x94\x3d\x41\x1e\x91\x9a\x7e\xbb\xde\x98\xcb\xa2\x20\xfb\x4d\x0b\x14\x86\x20\xbf\x10\x69\x7e\xd7\xc6\x73\x41\xba\xf6\x4a\x7e\xf0\x08\x14\x78\xac\xae\x2b\x58\xd6\x23\xb1\xc2\x47\xc6\x14\x8c\x06\x4b\x95\x48\x17\x10\x70\x09\x25\x40\xe1\x9c\x8e\xdf\x8f\x26\x9b\x9b\x84\x2a\xda\x6c\x77\x58\xdc\x12\x4e\xa9\x7a\xf6\x9d\x60\xef\x78\x7b\xa3\xad\x73\x3e\x01\xa9\xa8\x48\xa1\xa1\x21\x6b\x1a\x31\x8e\xb7\xba\x28\xb4\x7f\x58\xcf\xb1\x5b\x74\x27\x19\x73\xab\x4e\xd0\x82\x21\xe4\xba\xc8\x6c\xad\x83\xd3\xe7\x71\x06\xd1\x07\xa9\xd2\xc2\xb3\xe9\xbc\x7f\x7f\xfa\xdc\x26\x00\xff\x83\xa9\xf0\x96\xaa\x55\xb2\x80\x7d\x07\x6f\xdf\xbc\xfa\x89\x1c\x37\xec\x88\xea\x27\xf0\x0a\x44\x21\xc3\x24\x24\x10\xcc\xa7\x43\x25\xcb\x98\x1b\x19\x48\xe5\xa8\x83\x27\x7b\xcd\xb1\xa8\x28\x14\xdd\x20\x58\x6f\x22\x75\x04\x98\xdf\x72\xd2\x80\x4c\x73\x85\x3b\x45\x47\x76\x39\x29\xb8\xaf\xff\x0b\x73\xc8\xba\x76\xbb\x43\xd7\xdd\x0d\x77\x50\x71\xbb\xe5\xd6\xe3\x18\x9d\xee\xf5\xdc\x3b\xb6\xdc\x29\x79\x70\x6b\x20\xd9\x7e\xa4\xc3\xb2\xd3\x06\x57\xa8\xeb\x9d\xd4\x20\xea\x46\x87\x48\x74\x42\x16\x96\x23\x13\xb9\xb3\xa0\x2e\xc7\x35\xcd\x53\x08\x49\x6d\xf3\x94\x3c\x98\x83\x7a\x7c\x9a\xc0\x60\x30\x88\x0d\x8e\x33\x9e\x7a\xd4\xa8\xcd\x2c\x46\xe2\x18\xfa\xc9\x3e\x44\xb0\x09\x63\xc4\x02\x44\x18\xdd\x84\x28\x51\x09\x97\x43\x12\xc4\x9b\x2c\x19\x4d\x60\xb5\xc9\x64\x9b\x79\xa9\x75\x14\x6f\x40\xf8\x3b\x33\x3a\x1c\xc2\xbb\x66\x7c\xd4\x12\x78\x8c\xff\x9c\x15\x27\x5a\xef\xdb\x55\x9e\x92\xfa\xf0\x0f\x4a\xcf\x55\x17\x09\x8c\x53\x18\x1c\xc1\x55\xef\xd9\x4c\xc8\x42\x8c\x0b\xbc\xea\xf5\xe1\xaa\x77\x66\xf4\xd4\xa0\xb5\x52\x4d\x69\x81\xcc\xf3\xaa\xf7\x1c\xa7\x46\x64\x98\x5d\xf5\x6a\xd0\x5f\x56\xc2\xa5\xf9\x6b\x34\x53\xfc\x01\x17\x4f\x18\xe0\xca\xab\x73\x67\x84\xc3\xe9\xe2\x49\x49\x7b\x9a\x77\x85\xb4\xee\x62\x51\xe1\x13\x4e\xcb\xad\xc5\xd7\xa2\x5a\x01\xd4\xa8\xd5\xc2\xe5\x75\x89\x4e\xcc\x8e\x92\xa5\xaa\x7f\xf9\xd5\x6a\x35\xba\xea\x2d\x79\xea\xeb\x92\x0c\xa6\x72\x8b\xab\x1e\xac\x50\x30\xba\xea\x31\x0d\xf5\x7a\x4d\xf4\xe8\xaa\x47\xd8\x68\xd9\x68\xa7\xc7\x7e\x32\xba\xea\x8d\x17\x0e\x6d\xff\xa8\x6f\xb0\xea\x93\x5b\x3e\x59\x62\xb8\xea\xfd\x02\x57\xaa\x26\x5a\xbb\x1c\x4d\xd0\xb4\x85\x3f\x7a\x5d\x11\x78\x63\x7c\x06\x28\x84\x75\x17\x46\x28\x2b\xeb\x91\x7a\xf7\xbe\x3b\x06\x7f\xff\x58\x3d\x74\xa6\x37\xa1\xe4\x88\x33\x82\x28\x2c\xd7\xec\x26\xeb\x35\xba\x64\xa7\x08\x56\xc1\x83\x0c\xc5\xcc\xd4\x2d\x7d\xc8\x51\x63\x0c\xf5\x05\x81\xf2\x2a\x43\x53\x2c\x38\x9f\x2f\xbd\x8d\x8b\x8c\x2c\x01\x38\x9d\x84\x78\x16\x5b\xf4\x1b\xb2\x3a\x0a\x98\xa8\xc0\xdb\xba\xb2\x60\xba\x1a\x88\xe4\x6d\xc1\x4b\x22\x18\x0e\xad\x29\x55\xd8\x64\x8a\xdd\x61\x0f\xc8\xf5\x4a\xe1\x46\x40\x45\xe1\x80\x20\xae\xd9\xb7\x25\x9f\x00\x94\x68\xad\x98\xee\x26\xf0\xb8\x37\xe4\x96\xdc\x97\x42\x81\x41\x91\x11\x9d\xcb\x77\x2a\x93\xa9\xe0\xca\xa9\x0e\x3e\x62\xac\x7d\x08\x07\x4b\xf9\x47\x11\x97\x62\x41\xf2\xa5\x72\x81\x0c\xb6\xce\x23\x6b\x88\x29\xc5\xed\x2b\x54\x53\x97\x8f\xe0\xab\xe3\xff\xfc\xe6\xdb\x87\xf2\x5c\x07\xeb\xef\x51\xa1\x11\xdd\xa5\x7c\x07\xfb\xf7\x8f\xb5\x66\xda\xcc\x5f\x52\x8f\x77\x93\xe9\x72\x4f\x18\x4c\xad\xd8\xe1\x5c\x58\x2a\xba\x61\x2c\x2c\x66\xe0\x2b\x92\x07\x85\xc2\xba\x1c\xe3\x2e\xb4\x13\x98\xb4\xad\x42\xf2\xe8\xb8\x0f\xe3\x28\xda\xfb\xb1\xed\xf2\xf6\x3a\xe9\x20\x59\x5a\xf8\xae\x7f\x87\x1e\x2a\x63\x3d\xa7\x05\x6e\x32\xb8\xe8\xa3\xaa\x32\xce\x91\xd6\xe4\x8a\x65\xf9\xb8\xcd\x4a\xa5\x72\xdf\x7c\xbd\x4e\xa9\x52\xc9\xd2\x97\x23\x78\xbc\x51\x9d\x94\x74\xa6\x68\x3a\xf7\x18\x14\x76\x47\x1d\x86\xad\xcb\x04\x29\x28\x38\x4d\x8d\x28\x4b\xe1\x64\x0a\x32\xa3\x16\x7d\x22\xb9\x99\x6a\x0c\x39\x74\xc9\x7c\xb0\x6e\x3e\x1a\xd9\xed\xdb\x18\x6d\x5a\xa6\x7d\x66\x74\xe6\x53\x2a\xc2\xf5\x64\x39\xf2\x6e\x85\xa1\x45\x85\xc1\xf6\x43\x09\x01\x78\x4b\xa2\x6e\xae\x86\xc2\xed\x11\x0a\xea\x12\x6c\x44\x59\x97\xdc\x21\x11\xcd\x73\xe4\xa8\xcb\x17\x5d\xf1\x8c\x61\xaa\xac\xcc\xb8\x76\x16\x30\xf5\xc2\x08\xe5\x10\x33\xbe\x6b\x83\x8b\x7a\x6f\x2b\xb0\x89\xe5\x55\x49\x53\xc3\x5d\x2c\xcb\x7b\x22\x31\x5e\xaf\xb0\x7f\xee\xe0\x98\x47\x8f\x8f\x37\x68\xba\xd9\xb5\x66\x4b\x25\x9c\x43\xa3\x46\xf0\xf3\xe5\xb3\xc1\xff\x8b\xc1\x6f\xd7\x07\xf1\x9f\xc7\x83\xef\xfe\xd1\x1f\x5d\x3f\x6a\xfd\xbc\x3e\x7c\xfa\x1f\x0f\x0d\x01\x9b\xea\xfa\x3b\x26\x13\xd3\xc3\x72\xe0\x1c\xb4\xd8\xe7\xdc\xa1\x27\x70\x61\x3c\xf6\xe1\xa5\x28\x2c\xf6\xe1\xbd\xe2\xa0\xbf\x4e\x50\xa8\x7c\xb9\x0e\xe9\x00\x7a\x04\xaa\x2b\x81\xc6\xd7\x8c\x63\xfd\xfb\x88\xfb\xa1\x22\xe1\x0d\xbb\x08\x84\x2b\x13\x3d\x69\xc7\x8f\xd6\x95\x1b\x8f\xdb\xc9\x51\x74\x12\x2b\xbb\x24\xd5\xe5\xb0\x75\x25\x47\x25\xe5\x6b\xa1\x16\xb0\x0c\x56\xa1\x0e\xbb\x6b\xc9\x36\x74\x0d\xa9\xd1\xd6\x36\x83\x3c\xcb\x37\x07\xd0\x14\x6b\x21\x04\x8e\x63\xd7\x22\xcc\x58\x3a\x23\xcc\xa2\x55\x50\xd7\x43\x16\x6f\x71\xe2\x0b\x38\xb0\x88\x90\x28\x9d\xe1\xfd\x98\x79\x18\x22\xa3\x18\xcb\x42\x3a\x1e\xdf\x67\xc8\x2d\x88\x8c\xa5\x6f\x59\x69\xe3\x84\x72\xc1\x9d\x0c\x4e\xf1\x96\xda\xd4\x92\xca\x29\xe4\xb6\xe8\x20\x53\xf6\xe8\xe8\xf8\xab\x73\x3f\xce\x74\x29\xa4\x7a\x59\xba\xe1\xe1\xd3\x83\x0f\x5e\x14\x7c\xd5\x40\xbd\xe8\xcb\xd2\x1d\xee\x90\xe4\x8e\xbe\xd9\xea\x27\x07\x97\xc1\x1b\xae\x0f\x2e\x07\xf1\xbf\x47\xf5\xd2\xe1\xd3\x83\xab\x64\xe3\xfb\xc3\x47\x44\x5a\xcb\xc7\xae\x2f\x07\x4b\x07\x4b\xae\x1f\x1d\x3e\x6d\xbd\x3b\x7c\xa0\xbb\x19\xfc\xe0\xa5\xc1\xce\xd6\x75\xd0\x51\xc6\x75\x6e\x8b\x05\x46\xe7\xbb\x10\x9c\x3b\x5f\x05\x15\x77\xbe\x22\xaa\xd7\xce\x06\x3b\xc7\x7f\xf5\x4b\xee\x71\x3a\x46\x83\xe7\x6b\xa2\xca\xae\x63\xc9\xd5\x7e\xee\xac\x81\x08\xed\xcf\x16\xf8\x02\xb0\x89\x49\x71\xa2\x78\xa5\xc8\x20\x6d\x18\x37\x86\xdb\x40\xce\xd4\x21\x97\x2c\x93\x93\xad\x0b\xf7\x8e\xe7\x23\xbc\xc1\x19\x9a\x66\x4e\x04\x1f\xd7\xed\x5c\x9e\xd8\xf2\xcc\xc2\x96\x17\xc6\x68\xc3\x07\xfe\x6b\xc0\xcf\x7f\xf3\xf2\x19\x86\xa9\xd5\x0a\xa8\x9f\xb7\xe1\x5a\x87\x74\xb6\x6e\xc3\x97\x01\xe7\xa0\xfe\x3b\xf8\xf2\xcf\xef\xbc\xb7\x3e\x5b\x4b\xd5\x47\x78\x29\x9c\x28\x00\x59\x08\xab\x6c\x9c\x68\x0a\x90\x8e\x1b\xbd\x8f\x0f\x6d\x9d\xde\x87\x2f\x1f\x96\x9f\x22\xfd\xc9\x9e\x41\xf9\x82\x23\xeb\x08\x9c\xf1\x9f\xab\xb1\xd8\x7a\x7e\xfd\xad\xdc\x0e\x87\xab\x5c\xd8\x75\x22\xdb\x30\x4f\x09\xcf\x8a\x17\x9e\x11\xa4\x5d\xbc\x90\xf6\xad\x05\xb9\x4d\x8d\xe1\xd9\x22\xb2\x1d\x79\x0f\xcf\x26\xf1\x7d\x12\xa0\xcd\x95\x52\x78\x3e\xc7\x24\xf4\x93\xc9\xc4\x6a\x0b\x95\x5b\xd4\xde\xc1\xca\xb9\xc3\x6a\x07\xdd\x13\xee\x2d\x60\x77\x33\x80\xf0\xec\x64\x06\xe1\xd9\x51\x38\xe1\xd9\x6e\x12\x0f\x00\xba\x8b\x79\x84\xe7\x73\x1a\xc9\x27\x13\xbe\x31\xc9\xdf\xdf\xd8\x95\xf0\x3f\x09\xd8\x36\x30\x9f\xd0\x8f\xfc\x65\xa2\xdb\x2a\xae\xb5\xb7\x0d\xff\xb6\xf7\x0d\x5b\x85\xb6\xd1\x14\x56\xdb\xca\x42\xa6\x18\xbf\x8d\xa2\xfe\x5a\xf1\x55\x2c\x5f\x19\x53\x43\x33\x27\x76\xf8\xdb\x46\x12\x45\xd8\x5c\x37\x39\xda\x64\x68\x78\xb6\x80\x1f\x7c\xf8\x7a\x41\xc1\x42\x94\x05\x8f\x87\x96\x33\x00\x2b\xa7\x4a\x4e\x64\x2a\x94\x83\x39\x5f\xd5\x32\x78\xe9\xf6\x79\x3e\xf9\x27\x2f\x4c\xee\x2d\x86\x51\x53\xab\x6a\xb0\x4e\x1b\x0a\x64\xad\x15\x3f\x6e\xfa\xb8\xda\xb4\xa2\xe9\xc3\xef\x7f\xec\x2d\xbd\x20\x4c\x40\x43\xb3\xb4\xf2\x69\x74\x2f\x54\x49\xf5\x97\xcf\xfc\xb3\x75\x77\x02\x97\xd7\x7b\x01\x31\x66\x3f\xd6\x9f\x37\xd3\xe2\x3f\x03\x00\x00\xff\xff\x10\xff\x66\xd6\x46\x2e\x00\x00")
+var _configCrdsKudoDev_instancesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x3d\x6b\x73\x23\xb7\x91\xdf\xf5\x2b\xba\x98\x54\x49\x72\xc8\x91\x25\xe7\x7c\x09\x2b\x8e\x4f\xa7\xdd\x4d\x74\xde\x87\x6e\xa5\x75\x2a\xb7\xda\x5c\xc0\x41\x93\x44\x34\x03\x8c\x01\x8c\x24\xc6\xf6\x7f\xbf\x6a\x3c\xe6\x41\x91\x9c\x21\xa5\xf5\xad\x2b\x96\x3f\x98\x33\x78\x35\x1a\xfd\xee\xc6\xec\xde\x68\x34\xda\x63\x85\xf8\x16\xb5\x11\x4a\x8e\x81\x15\x02\xef\x2d\x4a\x7a\x32\xc9\xcd\xef\x4c\x22\xd4\xd1\xed\xf1\xde\x8d\x90\x7c\x0c\x67\xa5\xb1\x2a\x7f\x8b\x46\x95\x3a\xc5\x67\x38\x15\x52\x58\xa1\xe4\x5e\x8e\x96\x71\x66\xd9\x78\x0f\x80\x49\xa9\x2c\xa3\xd7\x86\x1e\x01\x52\x25\xad\x56\x59\x86\x7a\x34\x43\x99\xdc\x94\x13\x9c\x94\x22\xe3\xa8\xdd\xe4\x71\xe9\xdb\xcf\x93\xdf\x26\xc7\x7b\x00\xa9\x46\x37\xfc\x4a\xe4\x68\x2c\xcb\x8b\x31\xc8\x32\xcb\xf6\x00\x24\xcb\x71\x0c\x42\x1a\xcb\x64\x8a\x26\xb9\x29\xb9\x4a\x38\xde\xee\x99\x02\x53\x5a\x6c\xa6\x55\x59\x8c\xa1\x7a\xef\x87\x04\x38\xfc\x1e\xce\xc3\xe8\x3d\x00\x80\x4c\x18\xfb\x4d\xeb\xf5\x4b\x61\xec\x1e\x00\x40\x91\x95\x9a\x65\x8d\xd5\xf6\x00\x00\x8c\x90\xb3\x32\x63\xba\x7e\xbf\x07\x60\x52\x55\xe0\x18\x5e\xd3\x52\x05\x4b\x91\xef\x01\x84\x6d\xb9\xa5\x47\x01\xf0\xdb\xe3\x09\x5a\x76\xec\x27\x4a\xe7\x98\x3b\x7c\x01\x00\xa8\x02\xe5\xe9\xc5\xf9\xb7\x5f\x5c\xb6\x5e\x03\x70\x34\xa9\x16\x85\x75\x18\x8a\x30\x82\x30\x60\xe7\x08\xbe\x33\x4c\x95\x76\x8f\x15\xa4\x70\x7a\x71\x9e\x54\x53\x14\x5a\x15\xa8\xad\x88\x68\x00\x00\x00\x68\x1c\x7a\xe3\xed\xd2\x82\xfb\x04\x93\xef\x05\x9c\x4e\x1b\xfd\xc2\x61\x73\xc8\xc3\x36\x40\x4d\xc1\xce\x85\x01\x8d\x85\x46\x83\xd2\x9f\x3f\xbd\x66\x12\xd4\xe4\x1f\x98\xda\x04\x2e\x51\xd3\x40\x30\x73\x55\x66\x9c\xc8\xe2\x16\xb5\x05\x8d\xa9\x9a\x49\xf1\xcf\x6a\x36\x03\x56\xb9\x65\x32\x66\xd1\x58\x10\xd2\xa2\x96\x2c\x83\x5b\x96\x95\x38\x04\x26\x39\xe4\x6c\x01\x1a\x69\x5e\x28\x65\x63\x06\xd7\xc5\x24\xf0\x4a\x69\x42\xc8\x54\x8d\x61\x6e\x6d\x61\xc6\x47\x47\x33\x61\x23\x41\xa7\x2a\xcf\x4b\x29\xec\xe2\xc8\xd1\xa6\x98\x94\x56\x69\x73\xc4\xf1\x16\xb3\x23\x23\x66\x23\xa6\xd3\xb9\xb0\x98\xda\x52\xe3\x11\x2b\xc4\xc8\x01\x2b\x1d\x51\x27\x39\xff\x95\x0e\x2c\x60\xf6\x5b\xc8\xb3\x0b\xa2\x03\x63\xb5\x90\xb3\x46\x83\x23\xbc\x0d\x58\x26\x0a\x04\x61\x80\x85\xa1\x7e\x17\x35\x32\xe9\x15\xe1\xe3\xed\xf3\xcb\x2b\x88\x4b\x7b\x84\x7b\xdc\xd6\x5d\x4d\x8d\x66\x42\x91\x90\x53\xd4\xbe\xe7\x54\xab\xdc\xcd\x82\x92\x17\x4a\x48\xeb\x1e\xd2\x4c\xa0\xb4\x60\xca\x49\x2e\x2c\x9d\xdf\x77\x25\x1a\x4b\x27\x90\xc0\x99\xe3\x64\x98\x20\x94\x05\x67\x16\x79\x02\xe7\x12\xce\x58\x8e\xd9\x19\x33\xf8\xd1\x91\x4c\xd8\x34\x23\x42\x5e\x3f\x34\x37\x85\xd0\x72\x67\x8f\xa7\x46\x43\x94\x18\x00\x1b\x59\xed\xb2\xc0\xb4\x45\xfa\x1c\x8d\xd0\x44\xaa\x96\x59\x04\x35\xad\x7a\x26\xad\xc9\x56\x33\x5d\x60\x75\xcd\xac\xd2\x2b\xb9\xef\x01\x1c\x6f\xda\xbd\x1d\xd8\x62\x2a\xd0\x00\x03\x8d\x53\xd4\x28\x89\x14\x14\xb0\xd8\x94\x3e\x18\x13\xf8\xef\xc1\x42\xeb\x61\xdc\x24\x20\x56\x82\x79\x7a\x71\x1e\x85\x82\x97\x05\x18\xa1\xb3\xc9\xca\xd1\x6b\x8e\x30\xfe\x4d\x05\x66\xfc\x82\xd9\x79\x8f\xb5\xf7\xcf\xa7\x7e\x31\xed\xf8\x44\x01\x83\x42\x60\x8a\x2d\xe9\xe3\x84\x23\x32\x1e\x5e\x12\x95\x69\x0c\x6d\x43\xcf\x20\x1e\x98\x86\x74\xb2\x4c\x48\x60\xc4\x8c\x82\xc3\x7f\x5d\xbe\x79\x7d\xf4\x27\xe5\x21\x03\x96\xa6\x68\x8c\x27\x82\x1c\xa5\x1d\x82\x29\xd3\x39\x30\x13\xe9\xe3\x92\x5a\x92\x9c\x49\x31\x45\x63\x93\x30\x1b\x6a\xf3\xfe\xe4\x43\x02\x2f\x94\x06\xbc\x67\x79\x91\xe1\x10\x84\xc7\x57\xc5\xc9\xf1\x50\x85\xf1\x9b\xa9\xc6\xc2\x9d\xb0\x73\x07\x52\xa1\x78\x00\xfa\xce\x01\x6b\xd9\x0d\x82\x0a\xc0\x96\x08\x99\xb8\xc1\x31\x0c\x88\x22\x1a\x4b\x7f\x4f\x5a\xe8\xc7\x01\x1c\xdc\xcd\x51\x23\x0c\xe8\x71\xe0\x17\xac\x44\x2e\xbd\x8b\x27\x58\x8d\x04\x3b\x67\x16\xac\x16\xb3\x19\x12\xed\x53\x23\x12\xa7\x1e\x82\xd2\x04\xbf\x54\x8d\xce\x6e\x0a\x61\x22\x3d\x22\x7f\x00\xc8\xfb\x93\x0f\x03\x38\x68\xef\x0b\x84\xe4\x78\x0f\x27\x20\xa4\xdf\x59\xa1\xf8\x61\x02\x57\xf4\xd3\x2c\xa4\x65\xf7\x20\x0c\xa4\x73\x65\x50\x82\x92\xd9\x02\xac\x82\x39\xbb\x45\x30\x2a\x47\xb8\xc3\x2c\x1b\x79\x3e\xe5\x70\xc7\x16\xb4\x87\x88\x4a\x3a\x55\x06\x05\xd3\x76\x49\x21\x5d\xbd\x79\xf6\x66\xec\x57\xa3\x63\x9b\x49\x10\x06\xa4\xb2\x30\x15\xa4\x6e\x48\xcf\x78\xd1\xe9\xce\x9c\x00\x29\xdd\x48\xb0\x0a\xd2\x39\x93\x33\xf4\xd0\x22\x4c\x4b\x12\x62\xc9\xfe\x2e\xb4\xfe\x50\x3b\x6c\xd0\x12\xcb\xcc\xf5\xff\x26\x83\x7b\x6e\xce\x19\x3e\x3d\x36\xf7\xba\x41\x77\x1b\x37\x47\xd6\xa3\x96\x68\xd1\xed\x8f\xab\xd4\xd0\xd6\x52\x2c\xac\x39\x52\xb7\xa8\x6f\x05\xde\x1d\xdd\x29\x7d\x23\xe4\x6c\x44\x84\x35\xf2\xa7\x6d\x8e\x9c\x25\x78\xf4\x2b\xf7\xbf\x9d\xf7\xe2\xec\xbb\xbe\x1b\x72\x9d\x7f\x8a\x5d\xd1\x3a\xe6\x68\xa7\x4d\x45\x73\xa2\xbf\xac\xdf\xbf\x8c\x8a\x66\x69\x2c\x58\x05\x77\x73\x91\xce\xa3\x2d\xd8\x90\x64\x39\xe3\x5e\xd4\x31\xb9\xf8\xe8\x44\x4b\xa8\x2b\x35\xad\xbd\x18\x05\xe7\x63\xc4\x24\xa7\xdf\x46\x18\x4b\xef\x77\xc2\x55\x29\x7a\x31\xea\xbb\xf3\x67\x3f\x0d\x29\x97\x62\x27\xae\x5c\x63\x11\x01\x00\x09\x49\x96\xa3\x45\xbd\xc2\x24\x60\x9c\x3b\x67\x8f\x65\x17\x1b\x0d\x87\xc7\xaf\xfd\x42\xab\x7c\xf7\xf5\x5b\x87\x71\x11\x27\xbd\x74\x94\x5a\x13\x65\x70\x64\x9c\xba\x24\xad\x50\x2f\x9f\xc0\xf3\x7b\x96\xda\x6c\x01\x4a\xba\x36\x61\x83\x0a\x30\x90\x97\xc6\x19\xc4\x06\x57\xda\x35\x9b\x2d\x2a\xe7\x09\x4f\xc5\xec\x15\x2b\xbe\xc1\xc5\x5b\x9c\xae\xee\xb4\xb4\x83\xb3\xf6\x18\x30\x98\x11\x0d\x00\x83\x1b\x5c\x78\xd0\xab\x2e\x51\x1d\xc9\x65\xe1\x23\x56\x1a\xa9\xfd\x01\x07\x00\x5a\x6e\x7d\xe3\x12\xd0\x57\x73\xa4\xfe\x60\x55\x80\x37\xd9\x30\xb2\x83\xeb\xba\x14\xc9\x27\xa0\x4e\x82\x25\x71\xca\x39\x28\x3b\x47\x0d\xa5\xc1\x69\x99\x05\xaa\x49\x1a\xb6\xf4\xd0\xa9\xfb\x21\x89\x92\xaf\xf7\x1f\x8b\x13\x55\x78\x7e\xe8\x8d\x17\x2f\xb9\x17\x70\x37\x47\x07\x26\xa1\xa7\x26\x1e\xa5\x1d\xa9\xd3\xb9\x45\x3a\x0f\x16\x55\x27\xa0\x13\xa5\x32\x64\x72\x4d\x3f\xf2\x2a\xc9\x28\x5e\x07\xe7\x88\xd6\xdc\xdb\x34\xff\x4a\x81\x01\x00\x00\x60\x30\xd5\x68\xb7\x60\xa8\xcb\xc6\x80\x55\xdc\xe4\xdb\x3f\x39\x56\x0a\xcb\xfb\xed\xd6\x8c\xe5\xfc\xfa\x04\xe0\x55\x38\xb1\xe8\xaf\x84\x7e\x37\xb8\xf8\x85\xf5\x3e\x55\xd6\x0b\x94\xf6\x73\xe4\xbb\x8d\xcd\xab\x35\xb0\x53\xeb\x60\xd0\x9a\x86\xaa\x77\xe4\x4b\x60\x18\x50\xd3\x80\x10\x03\x4a\xd7\x62\xc9\x74\x73\x22\x9c\xd6\x33\x42\xca\x24\xa0\x70\x38\xf6\x8a\x1a\x84\x6c\x00\xe1\xb0\x2d\x97\xa0\x4a\xe0\x2f\x73\x94\xcd\x90\x4a\x88\x24\x06\x37\xcf\x0c\x5b\x0b\x36\x22\xda\xd1\x2b\xf6\xe6\x44\x91\xb1\x2a\xfe\x51\x43\x44\x4f\x86\xb8\x82\xfc\xd2\x86\xa1\x11\xc2\x6a\xc0\x15\x9a\x64\x2b\x4b\x29\x63\xf2\xf9\x3d\xa6\xa5\xed\x0e\x20\xed\x5f\x39\x67\x9f\x69\x04\x7b\xa7\x08\x04\x03\xcc\xcd\x00\x18\xa7\x70\x38\x9b\x60\xed\xe1\x8f\x01\x8e\x0f\x41\x48\x2e\x34\x3a\x4b\xc8\xce\xb5\x2a\x67\xf3\x08\xb1\x93\x93\xa9\xd2\x1a\x4d\xa1\x24\x27\x07\xbb\xde\x55\x38\xae\x66\x04\x2d\xa9\xd1\x0d\x39\x2b\x00\x4e\x0e\xe1\xc1\xdc\x06\xad\x8b\x74\xaa\xe9\x8a\xf1\xcd\x1d\xbb\x27\x27\x66\x1c\x93\xc3\x5f\xe6\x22\xc3\x0a\x5a\x38\x38\x3e\xac\x4f\x65\xce\x8a\x02\xa5\x01\xbc\x45\xbd\x00\x2b\x72\x04\x06\xa5\x41\x1d\x4f\xb6\x79\x20\x43\x60\x35\x58\x07\x27\x87\x35\x42\x3c\xc2\x9c\x53\x63\x28\xbc\xca\xab\xa0\xbb\x11\xb6\xf4\xc9\x0e\x62\x6d\xd9\x24\x6d\x3a\x57\xb9\xbf\x6f\xc3\x52\x80\xc9\x2c\xa1\xe5\x50\x0b\xc5\x45\x0a\x13\x96\xde\x94\x05\x08\xd3\x58\x87\x04\x9f\x16\x3c\x46\x7c\xf1\x5e\x18\x87\x94\xd0\x77\x2a\x32\x47\xee\xde\xbd\x21\x30\x29\x64\xce\xcb\x0c\x39\x1c\x28\x0d\xba\x94\x52\xc8\xd9\xa1\x87\x37\x1c\x6b\x4a\x68\xcc\xa8\xcb\x64\x51\x61\xb9\x03\xc5\x67\x6e\x8c\x47\x70\x02\xaf\x95\xc5\x31\xb4\x7a\xf8\xa6\x2a\x34\xea\xd6\x23\x0e\x05\x26\xf9\x3a\xd2\x30\x3e\x90\x74\x7e\x09\x67\xef\xde\xbe\x7d\xfe\xfa\xea\xe5\x5f\x03\x11\x22\x4f\xe0\x8d\x8b\x64\xae\x61\xb3\x83\xf3\xb3\x43\x10\x84\x53\x89\x3e\x5e\xe4\xd1\x13\xa0\x19\x36\x03\x35\x77\x22\xcb\xdc\xbe\x33\x64\x9a\x66\x7e\xce\xd2\xf9\x32\xc9\xcf\x99\x01\x06\xa5\x14\xdf\x95\x08\xe4\xb1\x19\x15\x43\x7f\x15\xbf\xba\x21\x13\x04\x8d\xa3\xfa\x84\x84\xf5\x0b\xb8\xd8\x13\x03\x89\x77\x34\x7c\x7f\xcb\xe8\xaa\x3f\x93\x1e\xde\x64\x38\x08\x8a\x4e\xb1\xcc\xac\x17\x43\x0a\x8c\x55\x45\x1b\x2b\x95\xcd\x50\xd1\x08\xed\x28\x71\x26\x05\xfd\x72\xf1\xcb\xd2\x80\x30\x60\xbc\x4d\x71\x76\xfa\xfa\xec\xf9\xcb\x97\xcf\x9f\xb9\x63\x64\x72\x01\x85\x28\x10\x0a\xc5\x4d\x25\xd7\x68\x20\xd3\x08\x1a\x73\x75\x8b\x3c\xd9\xdb\x45\x3d\x15\x81\x85\xc7\xbb\xf8\xe1\x1e\xec\x1e\xc8\xab\xa8\xf5\xd2\x8d\x80\x94\x15\x14\x33\xf0\x68\xac\x22\xf8\xf4\x40\x68\x54\xe5\x6e\xc1\xea\x40\x1b\x3d\xe0\xb9\xf2\x3d\xc3\xcb\x09\x92\xcc\x60\x16\x52\x56\x9a\x10\x56\x6d\x53\x69\x02\xe7\x36\x9e\xce\x64\xe1\x3a\x30\x9e\x0b\x43\xe6\x0c\xdc\xe1\x64\xae\xd4\x4d\x08\x53\xae\x24\x8b\xe4\xe3\x45\x39\x88\x65\x84\x01\xe6\x66\xf3\x7c\x3d\x57\xe4\x22\x07\x96\x3a\x7f\x16\x72\x71\x43\x10\x32\xcd\x4a\x27\x09\xde\xbd\x3b\x7f\x66\x12\x80\xff\x44\xb7\x65\xb8\x43\x62\xe8\x7d\x0b\x6f\x5e\xbf\xfc\x2b\xd0\x1b\xd7\x23\x70\x33\x4d\x2f\x81\x65\xc2\x67\x04\x3d\xc0\x6e\xb4\x90\xb3\xb8\x72\x75\xa4\x94\x25\x94\xd6\x61\x63\x8e\x59\x41\x8a\xe6\x06\xc1\x94\x3a\x40\x47\x13\xbb\x56\x6f\x36\x72\x05\x52\x59\x98\xa1\x75\x5e\x79\xe6\xf2\x5b\x4f\x1a\x4b\x89\x5c\x67\x3a\x34\xf4\x65\xec\x17\x09\xc9\x11\x81\xa9\xf4\x04\xcb\xb2\xc5\x30\x2a\x0f\x29\x66\x73\x12\xfb\x5e\x1f\x3c\x04\x58\x58\xcc\xbb\xa3\x22\x71\xc9\x5a\x4f\x06\x93\x60\xc2\x88\x10\x95\x04\x06\xa9\x56\x44\x89\x94\x57\x34\x42\x05\xa9\xb1\x82\xc6\xbc\x45\xb7\x9d\xc6\x76\x9a\xb2\x29\x99\x9c\x68\xa7\xdc\xee\x92\xad\xe4\x34\x75\xc8\x71\x78\x50\x6b\x2e\x89\x9a\x7a\xc7\x40\x4c\x8c\x10\x5e\xa8\x4c\xa4\x8b\xbe\xa1\x98\xf6\xa8\x4a\xfd\x39\x36\x8e\xb6\xc6\xba\xdd\x51\x70\x34\x43\x60\xd2\x3b\x2a\xd1\xa4\xa8\xa5\x73\xad\xc0\x13\x78\x86\x53\x56\x66\x2e\x13\x0b\x97\x37\xa2\x48\xf6\x76\xf4\x56\xe8\x1c\xfb\xed\x8e\x0e\xdc\xb1\xf4\xd2\xd1\x47\x8b\x8e\x4e\x97\x33\xcd\x61\x2a\x6e\xe3\x49\x4e\x95\xce\x99\x0d\xe4\x39\xf8\x1c\x4e\xe0\x33\xfa\x6f\x30\x04\xa5\x81\x41\xa1\x31\xb8\x32\xd5\x36\x5d\x72\x0a\x06\xff\xc1\x99\xc8\x16\x83\x9d\xb7\xb5\xc9\x29\x6d\x6d\xcb\x91\x9d\xe0\x14\x1e\x76\x79\xd3\xf6\xc1\x2c\xd9\xaa\x4e\x4d\xec\x0c\x13\x9d\x68\x2f\x98\x2e\xc2\xd1\x2f\x67\xdc\xda\xf6\xe6\x8e\x70\x6c\xf2\xfa\x46\xee\x6c\x57\x36\x10\x1c\x2b\x1b\x08\x94\x6d\x3d\x40\xdf\xc8\xb4\x66\xcb\xfe\xa5\x41\x69\x84\x15\xb7\x58\xbb\x04\xde\xeb\xeb\x92\x92\xeb\xc6\xad\xc2\x63\xcf\x10\x8e\x57\x0c\xc6\x2a\xdd\x0c\x07\x3b\x4b\xa7\x02\xb3\x61\xbd\x7a\x11\xb8\xe4\xca\xb8\x54\x64\x48\x65\x9a\x56\x78\xd9\x25\x54\x0d\x56\x25\x29\x57\x35\x60\x73\xaf\xd1\x26\x1e\x36\x0e\x7f\xa8\x20\xa2\xe7\x3f\x8e\xaa\xd5\x47\xf5\xea\x5e\xcf\xbb\x41\x95\xf0\xe5\xd1\x2a\x58\x1f\x94\x5a\x4b\x2c\x6b\xce\x6f\x95\x6d\xb5\xba\x32\xc2\x75\x6c\xd5\x46\xa8\x49\x70\x8e\x1e\x14\x47\xf4\xac\x8d\x60\x45\x91\x09\xe4\x6f\x1c\x50\x5d\x7a\xf3\xb4\xd5\x79\x39\xb2\x5f\x1d\x3a\x0f\x7b\x34\x71\xf6\x88\x33\x1a\xbf\x00\xcb\xcc\x8d\x79\x18\x51\xb8\x70\x2a\xd8\x39\x1a\xf4\x33\xb9\xd0\xa5\x44\x40\xc9\x26\x84\x75\x8e\x19\x5a\x6c\xa4\xed\x4d\x55\x01\x45\x15\x65\x21\x5f\x3e\xc7\x05\x70\xc1\x41\x2a\xeb\xd6\x5e\xec\xaa\xae\x5b\x3b\x6d\x6e\xb4\xca\x65\x37\xf7\xc6\x64\x63\x6b\xbb\x28\xc7\xae\xca\x8f\x1e\x92\x70\x7d\x4e\xfb\x91\xa2\xbd\xd7\xe0\x0d\x49\xda\x47\x4a\xcf\x1a\x35\x2b\x9b\x69\xdb\xdb\x09\xd7\x0a\xdc\xa7\x93\xb0\x81\x14\x2e\x25\x2b\xcc\x5c\xd9\x7e\x5c\x14\x7b\xb7\x5d\xa4\xe5\x4a\x22\x12\x41\x0d\x89\xb4\xc4\x35\xc0\xa6\x31\xdc\x95\x31\x63\xc1\x94\xae\x4a\x66\x5a\x66\xd9\x02\xa8\x54\xd4\xcc\xa3\x66\x0b\x7e\x8d\x77\x7b\x14\x51\xe4\xad\xe0\x9e\xa1\x0a\x8d\xb7\x42\x95\xa1\xb8\x26\x88\x51\x37\xaa\x61\x29\x4d\x16\x9e\x2d\xdf\xc8\x17\x4c\x64\x54\x7a\xb1\x75\x75\x53\xb1\x45\x75\x53\xd5\x39\x2a\x1b\x87\xe3\xd4\xc5\x7d\xd6\xd4\x3d\x91\xe0\x59\x42\xdf\x4e\xde\x58\x67\xb9\x18\x74\x95\x8c\x89\x07\xb5\x62\xab\x8e\xb6\x75\x90\x77\x8c\xce\x86\xec\xd0\xd5\x76\xce\x46\xd4\xf6\x13\x21\x8f\x2d\x20\xeb\x81\x3a\xe8\x2e\x24\x83\x5f\x8a\xc9\x7e\x29\x26\xfb\x59\x15\x93\xf5\xa4\xfb\x4d\x0a\xf8\xe7\x51\x58\xd6\x73\xa3\x9b\x93\x93\x9f\x60\x91\xd9\x16\xfb\xda\x68\xc7\x7c\xb2\x05\x67\x3d\x37\xd8\xab\xf0\x0c\xfe\x85\x8a\xcf\x7a\xe2\x6d\x6d\x78\x16\x3e\xc5\x42\xb4\x5e\x9b\xea\xc8\x35\x6f\x2a\x4a\x83\x2d\x0a\xc3\x7a\xc1\xb2\x26\x77\xed\x13\xa8\x73\x04\x9c\x4e\x31\x6d\x07\x06\x82\x87\x0f\x07\x75\xa0\x9b\x87\xe8\xdd\x61\xbf\xaa\x91\x0e\x04\xdc\xf6\xb6\xfd\x96\xcc\xd4\x8f\x69\x9a\x6e\x80\x39\xa5\xc4\x5f\xe3\x02\xd8\xf6\x1e\xef\xe0\x2c\x4e\x51\xc7\x56\x38\x5a\x26\x32\xe3\x32\xaf\x4a\x22\x30\x32\x04\x6c\x65\x5f\xf8\x8c\x68\x33\xab\x23\xdc\x45\x28\x88\xd7\xd5\x12\x18\x8d\x46\xc1\x06\xb0\xba\x4c\x2d\x88\x90\x35\xe0\x21\x9f\x1b\x12\xc8\xa5\xa1\xc9\xc1\xa5\xb9\x34\x5b\x80\xf7\xe5\x83\xe2\x2e\x98\x9d\x43\xe2\xa3\x24\x49\xbd\xd1\x04\xda\x76\x18\x61\x07\x5e\x28\x15\xa2\x24\x7e\xc1\xef\x01\x00\xe0\xe8\x08\xde\x56\xd7\x75\x1a\x71\x93\x90\x45\x26\xab\x02\xa6\x4a\xed\x9b\xf6\x9e\x92\x38\xf8\x1b\xa9\xee\xe4\x2a\x10\xdc\x9a\x4c\xe3\x18\xae\x07\xa7\xb7\x4c\x64\x14\xac\xb8\x1e\x0c\xe1\x7a\x70\xa1\xd5\xcc\x45\x73\xe5\x8c\x5e\x30\xc9\xe1\x7a\xf0\x0c\x67\x9a\x71\xe4\xd7\x83\x38\xf5\x6f\x0a\x66\xd3\xf9\x2b\xd4\x33\xfc\x06\x17\x5f\xb9\x09\x5b\x4d\x97\x56\x33\x8b\xb3\xc5\x57\x39\xf5\xa9\xda\x28\xe8\x71\xb5\x28\xf0\x2b\x97\xdc\x6f\xbc\xf4\x65\x8b\xf5\x44\xd5\xb1\x1a\x78\xff\x81\xee\xeb\xdc\x1e\x27\xd5\x3b\xf8\xfb\x3f\x8c\x92\xe3\xeb\x41\xbd\xa7\xa1\xca\x89\x60\x0a\xbb\xb8\x1e\x40\x0b\x82\xf1\xf5\xc0\xc1\x10\xdf\x47\xa0\xc7\xd7\x03\x5a\x8d\x5e\x6b\x65\xd5\xa4\x9c\x8e\xaf\x07\x93\x85\x45\x33\x3c\x1e\x6a\x2c\x86\x24\xb2\xbe\xaa\x57\xb8\x1e\xfc\x1d\xae\x65\x04\xda\x47\xe6\x43\xb1\xe7\x8f\x83\x1d\x82\x27\xe4\xf9\x5e\x69\xe6\xe2\x77\xfe\x0a\x63\xaf\x88\xf0\xc3\x61\x91\x87\xa9\xc5\x17\x2e\x04\x33\xda\x03\x0e\xb6\xea\x8d\xdc\x57\xd1\x28\x89\x31\xa3\x6b\x55\x4c\x33\x44\xab\xd7\x3b\x22\x13\xac\xb3\x13\xa5\xe4\xa8\xb3\x05\x89\xab\x7a\x56\x6f\x89\xf2\x04\xe0\x7c\xea\x2d\xf5\x60\xc5\xde\x10\xd5\xb9\xfc\x8c\xf4\xae\x21\xfd\xf4\x70\x55\x33\x12\xb7\x39\xdc\xc5\x69\x68\x30\x79\x37\x85\x25\x52\x5c\xe7\xc8\xf9\x1c\xc2\x18\xa8\xb4\x64\x44\x33\xee\x2a\xbb\x73\x34\x86\xcd\xfa\x21\x3c\xf4\x75\x10\xc2\xbc\xcc\x99\x04\x8d\x8c\x13\x9c\x75\x9b\xe4\xce\xc9\x97\xb3\x4a\xf8\xb0\x89\x2a\xbd\x38\xa8\xf1\x1f\x50\x4c\xf7\xed\x26\x08\x4c\x82\x23\xd8\x98\xaf\x5c\x03\x4c\xce\xee\x5f\xa2\x9c\xd9\xf9\x18\xbe\x38\xf9\xf7\x2f\x7f\xb7\xeb\x9e\x63\xcc\xf5\x4f\x28\x49\xa2\x8b\x9e\xc9\x9e\x87\xc3\x1a\x77\x08\xdd\xfe\x92\x78\x9d\x2e\x99\xd5\x7d\x62\x60\xb3\x41\x31\x77\x2c\xe4\xa7\x5d\xea\xb0\x2c\x08\x1f\x2f\x94\xae\x34\x9e\x33\xbc\x56\x4e\x26\x4c\xa3\x8e\xe5\xf8\x64\x08\x93\x80\xda\x87\xb2\xed\xfd\xfd\x87\x64\x05\xc8\xc2\xc0\xef\x87\x4b\xf0\x08\x03\x74\x44\x6a\xea\xe8\xc9\xbb\x83\x54\x8c\x12\x5c\xad\x35\xba\xa2\x4b\x43\xd7\x54\x2a\xa4\xfd\xf2\xb7\xeb\x0e\x55\x48\x91\x97\xf9\x18\x3e\xdf\x78\x9c\xa4\x74\x66\xa8\xf7\x56\x9b\xc5\xcc\xf4\x3c\x43\xdf\xb5\x56\x90\x8c\x84\xd3\x4c\xb3\x3c\x67\x56\xa4\x75\xce\x4b\x37\x09\xd9\xdb\x01\x6e\x60\x2c\x61\xaa\x70\xb7\x6f\x82\xb4\x69\x90\xf6\x85\x56\xbc\x4c\x43\xb4\xaf\xba\x62\x98\xd6\x62\x88\xfc\x38\x47\xfb\xde\x61\xa6\xbc\x21\xa6\xb6\xba\x8a\xeb\x6f\xeb\x22\xa3\xac\xa6\x09\x4b\x46\x2f\xd8\x2b\xa2\x66\x45\x64\x1c\xa3\x1d\x54\x46\x70\x17\x22\x60\x30\x2b\x99\x66\xd2\x22\x72\x77\xb7\x19\xae\x62\xdf\x86\x60\x63\xf5\xd5\xd4\xc8\x7b\x70\x55\xad\xe5\x40\x0c\xd7\x59\x1d\x7f\xf6\x60\xcc\xe3\xcf\x4f\x36\x9c\x74\xd5\x6b\x4d\x97\x82\x59\x8b\x5a\x8e\xe1\x6f\xef\x4f\x47\xff\xc3\x46\xff\xfc\x70\x10\x7e\x7c\x3e\xfa\xfd\xff\x0e\xc7\x1f\x3e\x6b\x3c\x7e\x38\xfc\xfa\xd7\xbb\x8a\x80\x4d\xe5\x30\x4b\x24\xe3\xbb\x36\x62\x32\xfe\x14\x87\xf1\xaa\xc3\x95\xa6\x24\xfc\x0b\x96\x19\x1c\xc2\x3b\xe9\x84\xfe\x3a\x44\xa1\x2c\xf3\xf5\x95\xa5\x03\x9a\x6a\xb0\xbe\xd9\xad\xb1\xbe\x3d\xac\xbd\xf7\x18\x8f\xa2\x0f\x42\xa8\x23\x6d\xbc\x21\x3f\x1a\x57\x9c\x43\xb1\xe8\x54\xa9\x24\x58\x76\x49\xaa\xf2\xa3\xaa\xdd\x9b\x94\xaf\x98\x5c\x40\x2d\xac\xbc\x1d\xb6\x4c\xc9\xc6\x57\xa7\xa4\x5a\x19\x53\xf9\xae\xc6\x27\xc3\x2b\x63\xcd\x8b\xc0\x49\xa8\x8e\x61\x7a\x22\xac\x66\x7a\x51\x43\x67\x62\x4d\x5f\xa8\x72\x3e\x30\x88\x90\x48\xc5\xf1\xa1\xcc\x3c\xf4\x92\x91\x4d\x44\x26\xac\x8b\x70\x71\x74\xa5\x2e\x22\x98\xbe\x79\xa1\xb4\x65\xd2\x7a\x76\xd2\x38\xc3\x7b\x10\x16\x72\x32\xa7\xd0\x50\x97\x03\x2e\xcd\xf1\xf1\xc9\x17\x97\xe5\x84\xab\x9c\x09\xf9\x22\xb7\x47\x87\x5f\x1f\x7c\x57\xb2\xcc\x45\xe3\x28\xe8\xf0\x22\xb7\x87\x3d\x94\xdc\xf1\x97\x9d\x7c\x72\xf0\xde\x73\xc3\x87\x83\xf7\xa3\xf0\xeb\xb3\xf8\xea\xf0\xeb\x83\xeb\x64\x63\xfb\xe1\x67\x04\x5a\x83\xc7\x3e\xbc\x1f\xd5\x0c\x96\x7c\xf8\xec\xf0\xeb\x46\xdb\xe1\xaf\x3f\x46\x7e\xe8\xa1\x19\xb7\xb2\x5b\x30\x30\x56\xb6\x79\xe1\xbc\xb2\xc9\x1f\xf1\xca\x26\x82\xfa\xe9\x52\x48\x45\xfb\xd6\xd4\xc7\xba\x0c\xb6\xe9\xa6\x96\x69\xea\xb5\xcb\x3f\x9f\x8e\x4e\xfe\xed\x4b\x98\x33\x33\x8f\xc2\x8b\x50\x5d\x99\x31\xf5\x45\x2e\x74\xb5\x9e\x71\xae\x66\x0e\x7a\xa9\x10\xd9\xd7\x7d\xb7\xf3\x50\x1c\x29\xb0\x53\x59\xaf\x21\x73\xbf\x6d\x69\xf6\x9f\x05\x95\x14\x2c\x3a\x12\x6f\x17\x75\xcf\x7a\xab\xd5\xc6\x5a\xe9\xb2\xba\x20\xd0\x0c\x41\x65\x1c\x5d\x07\x6d\x6c\x02\xa7\x16\x72\x65\x6c\x73\xb6\x97\x22\x17\xb6\x31\x04\x98\x46\xb8\xc1\xc2\xee\x9a\x7e\x6e\x95\x72\xbd\xc5\x54\x69\x6f\xd8\xaf\x03\x72\x97\x9c\x73\x9c\xaa\xfe\xe6\x4b\x1f\xe9\xfd\x62\x79\x54\x74\x9a\x2a\x7f\xa9\x0d\x99\x13\xad\xd4\x82\x3a\xa7\xa8\xfc\x63\x3d\x12\xfa\x2c\x0d\xc9\xed\x31\x58\x5d\xfe\x24\x6e\xcb\x2b\xdf\x97\xac\xac\xac\xa2\x18\x9f\x61\xf0\xd2\xa1\xaa\x14\xf4\x1e\x0b\x72\x40\xad\x95\x8e\x8b\xfc\x44\xf5\x56\x81\x47\x63\x71\xf7\x63\xca\x99\x7a\xa6\x40\xbb\x93\xa0\xcb\x19\xa9\x55\x19\x50\x82\xd3\x79\x34\x15\xe4\xe4\x3f\xec\x0c\x79\x25\x87\xce\xfc\x65\x83\x7e\xc5\x61\x4b\x83\xaa\xd0\xe3\x72\x1d\x7d\x14\x53\x46\xc4\xca\xf9\xae\xb4\xfb\x9a\xe5\xd7\x8a\x81\x6e\xe8\x42\xc1\x60\x80\x64\x29\x24\x9a\xac\x9d\xb2\x4b\x1c\x00\x00\x80\x8b\x6a\x6c\x6a\x5f\x96\x06\x5a\xe5\xf1\xa0\xab\x2a\x82\xf0\xcd\x20\x51\x05\x32\x0c\x5a\xe2\xef\xd6\x45\x1c\x87\x4e\x3a\x76\xc6\xf9\xba\x32\xe0\xde\x87\xde\xcd\x2f\x1d\x5c\x53\xdf\x44\x7e\x0a\x40\xac\xda\x02\x8c\x2b\x55\x71\x0a\xde\x6d\x89\xbb\x8d\xf7\x01\xb6\x02\xba\xeb\xde\xda\x86\x8a\x1a\xe8\x36\x7b\xba\x8c\x1f\x00\xf0\x4e\x95\xb6\xdb\xea\xa3\xcb\xa5\x41\x0f\xd4\x91\xd3\xda\x50\xcc\x99\xa9\x4f\x3b\x5c\xc4\xd0\x16\xf9\xa7\xa1\x8f\xb6\xf0\x27\x2f\xab\x0b\x24\xcb\x7a\xa8\xb5\xbd\x8d\xa6\x41\x2f\x98\x36\xde\xb1\x78\xec\x2d\x8b\x5d\x81\xda\x90\x8a\x7b\x70\x57\xe2\x29\xd1\xb1\xd9\x07\x59\x5b\x6b\xb6\xc1\x7d\x28\x05\x7f\x42\xef\x21\x63\xe1\xc2\xcd\x13\x7d\xc4\x61\x70\x51\xcd\x08\xcd\x8f\xcc\xb9\x0a\x9b\x8a\xda\xc2\x15\x86\x6b\x49\xee\xac\x73\x9f\x31\xde\x85\xa6\x38\x5f\x28\xa0\xad\x9c\x33\x03\xd7\xb2\x5a\x6e\xe9\xef\x07\x78\x4d\x17\x06\x6b\x2b\xe0\x07\xe8\xf8\xeb\xec\x70\xeb\xbb\x3c\x77\xc6\x18\x0d\xf8\xc3\xc8\xfd\xfd\xd1\xbd\xbe\x40\x7f\x55\xae\x35\xd5\xdf\xba\xd6\xfa\x61\xed\x5a\x6b\x3a\xfc\xc6\xaf\x39\x8a\xff\x1f\xfd\xe6\xf1\x3d\x1f\xbc\xbf\xdd\x80\x81\x17\xcc\xb2\x2c\x58\xa4\xed\x6d\x9c\x29\x0a\xaf\x58\x74\x2f\x76\x4d\xbc\xbc\xf3\xdf\xa9\xeb\x14\xda\x9f\x94\x7d\xff\x71\xeb\x61\x9d\xae\x31\xe3\xa7\xb0\xfc\x68\xa6\x3e\x5c\x48\xfd\x1e\x69\xf9\x75\xa0\xac\xe7\xde\xbb\xd1\xb7\xd5\x44\x7d\x4d\x82\xed\x68\x6c\x0b\x4a\xdb\x16\xda\x0d\x5a\x7c\xc5\xf9\x3e\xd1\x65\xc9\xad\xc1\xc4\xa2\x03\xca\x0e\x22\x5d\xb1\x95\x4b\x8b\x45\x0f\x4a\xa5\xb5\x3b\xa6\xed\x47\xae\x00\x00\x00\x14\xc7\xcc\x0b\xdb\xa3\x67\x2b\x9b\xf4\xc5\x49\x8f\xfe\xdd\x89\xa3\x65\x59\x78\xea\xa1\xe9\x49\xad\xbb\xd0\xec\xd6\x94\xbb\x15\x61\x6c\x21\x08\x76\x98\xb7\x5b\x28\xec\x30\xe9\x76\x02\xe2\x53\x45\x79\x1f\xc1\xf1\xf1\xc5\xc7\xd6\x80\xf7\xf0\xf9\xba\x0c\xd7\xad\x26\xeb\xe1\x40\xf6\xf5\xa2\x9e\x0c\x75\x4f\xea\xb1\xfc\x6b\xdc\xee\xee\x44\x5a\xff\x2f\xdd\x98\x4c\xd0\xdd\x89\x98\x8f\x46\xe9\x3e\x6b\xe2\xca\xe3\x29\xad\x77\x47\xdb\x71\x5f\x54\x26\x54\xf8\xce\x31\xd5\xa7\x34\xf7\xc9\x0a\x43\x1f\x60\x76\x65\xab\x12\x16\x2c\xcf\x40\x98\x66\x26\x9c\xea\xca\x29\x01\xcf\xa4\x0d\xb7\x89\xdd\xf4\xc2\xee\xbb\x00\xcd\xc7\xbd\x9e\xde\xca\x52\xd4\x7a\xd4\x65\x5c\xe2\x6c\x0f\x12\x2e\xd5\xf0\xc7\xde\x55\x6f\x87\x1a\x1e\x5e\x35\x64\x55\xcf\x64\x47\xef\x21\x8e\xef\x5d\xb4\xf5\x72\x69\xd0\xea\x92\xad\x2a\xa6\x5c\xdf\x79\x08\x37\x10\xcd\x06\x80\xe1\x27\x77\x53\x76\x8a\xf0\x77\x6d\xa1\x7b\x55\xbc\xdf\x1e\xf1\xaf\xf1\x7e\x25\xe2\x69\xb2\x1a\xf1\x4b\xd7\xef\x3f\x0d\x2c\x7f\x57\x62\x89\xfd\x84\xf0\x7f\xbb\xae\x6e\x6f\xba\xc4\x78\xb5\xa7\xda\xd5\x1d\x5b\xfb\x55\x01\x6a\x5a\xf5\x59\x81\xea\x5b\x21\xf1\xdb\x03\x35\x49\xd2\x08\xa5\x24\xfd\xdf\xf1\x57\xeb\x13\x05\x5d\xf9\xb2\xae\xaf\xbd\xec\x14\xb0\xda\x29\xfa\xb4\x72\xd0\x83\x97\x5e\x76\x34\x0e\x92\x12\xa7\x64\x66\x36\xde\x94\x93\xaa\x4a\x23\x82\x1d\x54\x3a\x7c\xff\xe3\x5e\xad\xdd\x7d\x7d\xa3\x2f\x85\x68\xfd\x43\x13\x83\x41\xeb\xdf\x91\x70\x8f\x8d\xca\x68\x78\xff\x61\xcf\x2f\x8c\xfc\xdb\xf8\x8f\x45\xd0\xcb\xff\x1b\x00\x9c\xbc\x39\xa3\x94\x63\x00\x00")
 
 func configCrdsKudoDev_instancesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -120,7 +120,7 @@ func configCrdsKudoDev_operatorsYaml() (*asset, error) {
 	return a, nil
 }
 
-var _configCrdsKudoDev_operatorversionsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x6b\x8f\x1b\x37\x92\xdf\xe7\x57\x14\x26\x1f\x9c\x04\x52\xeb\x9c\xec\xe2\x0e\xf3\x2d\x6b\xc7\x87\xb9\x78\x6d\xc3\x8f\x3d\x1c\x72\x01\x86\x6a\x56\xab\xb9\xc3\x26\xfb\xf8\x90\xac\x0b\xf2\xdf\x0f\x55\x64\x3f\xa4\xd1\xa3\x2d\x27\xb9\x2c\x76\xfa\x4b\xa2\x6e\xb2\x58\xef\x17\x6b\x7c\x35\x9f\xcf\xaf\x44\xab\xfe\x86\xce\x2b\x6b\x6e\x40\xb4\x0a\x3f\x06\x34\xf4\xcb\x17\xf7\xff\xe6\x0b\x65\x17\xeb\xa7\x57\xf7\xca\xc8\x1b\x78\x16\x7d\xb0\xcd\x5b\xf4\x36\xba\x12\x9f\x63\xa5\x8c\x0a\xca\x9a\xab\x06\x83\x90\x22\x88\x9b\x2b\x00\x61\x8c\x0d\x82\x5e\x7b\xfa\x09\x50\x5a\x13\x9c\xd5\x1a\xdd\x7c\x85\xa6\xb8\x8f\x4b\x5c\x46\xa5\x25\x3a\x06\xde\x1d\xbd\xfe\x97\xe2\x4f\xc5\xd3\x2b\x80\xd2\x21\x6f\x7f\xaf\x1a\xf4\x41\x34\xed\x0d\x98\xa8\xf5\x15\x80\x11\x0d\xde\x80\x6d\xd1\x89\x60\x5d\xde\xe9\x8b\xfb\x28\x6d\x21\x71\x7d\xe5\x5b\x2c\xe9\xcc\x95\xb3\xb1\xbd\x81\xfe\x7d\xda\x99\xd1\x49\xa4\xbc\xce\x40\x32\xe5\xfc\x45\x2b\x1f\x7e\x38\xf4\xf5\xa5\xf2\x81\x57\xb4\x3a\x3a\xa1\x1f\xa2\xc0\x1f\xbd\x32\xab\xa8\x85\x7b\xf0\xf9\x0a\xc0\x97\xb6\xc5\x1b\x78\x45\x68\xb4\xa2\x44\x79\x05\xd0\x6d\x26\xb4\xe6\x99\xb6\xf5\xd3\x25\x06\xf1\x34\xc1\x2b\x6b\x6c\x44\x42\x1a\x08\xa6\xf9\xee\xcd\xed\xdf\xbe\x7d\xb7\xf3\x1a\x40\xa2\x2f\x9d\x6a\x03\x33\x71\x0f\x71\x50\x1e\x42\x8d\x90\xf6\x40\x65\x1d\xff\xdc\x47\x1f\xbe\x7b\x73\x5b\xf4\x00\x5b\x47\xdf\x83\xea\x18\x96\x9e\x91\x96\x8c\xde\xee\x1d\xff\x84\x30\xcc\x47\x4b\x52\x0f\x4c\xe7\xe7\x83\x50\x66\xa2\xc0\x56\x10\x6a\xe5\xc1\x61\xeb\xd0\xa3\x49\x0a\x43\xaf\x85\x01\xbb\xfc\x3b\x96\xa1\x80\x77\xc8\x18\x82\xaf\x6d\xd4\x92\xf4\x68\x8d\x2e\x80\xc3\xd2\xae\x8c\xfa\xdf\x1e\x9a\x87\x60\xf9\x18\x2d\x02\xfa\x00\xca\x04\x74\x46\x68\x58\x0b\x1d\x71\x06\xc2\x48\x68\xc4\x16\x1c\x12\x5c\x88\x66\x04\x81\x97\xf8\x02\xfe\x6a\x1d\x82\x32\x95\xbd\x81\x3a\x84\xd6\xdf\x2c\x16\x2b\x15\x3a\x0b\x28\x6d\xd3\x44\xa3\xc2\x76\xc1\xca\xac\x96\x31\x58\xe7\x17\x12\xd7\xa8\x17\x5e\xad\xe6\xc2\x95\xb5\x0a\x58\x86\xe8\x70\x21\x5a\x35\x67\x64\x0d\x5b\x41\xd1\xc8\x2f\x5c\xb6\x19\xff\x64\x87\x79\x61\x4b\x5a\xe1\x83\x53\x66\x35\xfa\xc0\x2a\x7a\x82\xcb\xa4\xa4\x24\x5a\x91\xb7\x26\x2a\x06\x66\xd2\x2b\xe2\xc7\xdb\xef\xdf\xbd\x87\xee\xe8\xc4\xf0\xc4\xdb\x61\xa9\x1f\xd8\x4c\x2c\x52\xa6\x42\x97\x56\x56\xce\x36\x0c\x05\x8d\x6c\xad\x32\x81\x7f\x94\x5a\xa1\x09\xe0\xe3\xb2\x51\x81\xe4\xf7\x3f\x11\x7d\x20\x09\x14\xf0\x8c\x4d\x1f\x96\x08\xb1\x95\x22\xa0\x2c\xe0\xd6\xc0\x33\xd1\xa0\x7e\x26\x3c\xfe\xe6\x4c\x26\x6e\xfa\x39\x31\x6f\x1a\x9b\xc7\x5e\x6b\x7f\x71\xe2\xd3\xe8\x43\xe7\x5b\x8e\xc8\x64\xcf\xf0\xde\xb5\x58\xee\x58\x80\x44\xaf\x1c\x69\x6c\x10\x01\x49\xcf\xf7\x36\x14\x3b\xa0\x0f\x9b\x60\x32\xc3\xf6\xa0\x19\x9e\x20\x13\x92\x0f\x36\x58\x12\xaa\xef\xf8\xf3\xc3\xcd\x3b\xd4\x3c\xdb\x5b\xde\x93\x22\x20\x60\xd3\x92\x9d\xc9\x4e\xf7\x42\x2d\x02\x94\xc2\xb0\xdc\x3d\x4a\x32\xc6\x7c\x1c\xfd\xaf\x30\xa0\x8c\x0f\xc2\x94\x98\xac\x1e\x7b\xd2\x8b\x4f\xa1\xa0\xf3\x59\x67\x30\x7f\xf2\x9a\x05\xf7\x16\x2b\x74\x48\x67\x92\x2e\x09\x65\x3c\xa0\xb1\x71\x55\xb3\xfa\xb9\x26\xb9\x9b\x60\x41\x63\x80\xad\x8d\x84\x63\x4b\x18\x5b\x07\x8d\x95\xaa\xda\x32\xa6\x8e\xc0\x90\xd8\x3a\x97\x34\x9f\xcf\xe1\x15\x6e\x88\x50\xdf\x3b\x31\xc2\x1a\x84\x43\x90\xca\x97\x36\x3a\xb1\x42\x09\x4b\x2c\x45\xf4\x4c\xb3\x54\x55\xa5\xca\xa8\xc3\x36\xe3\xba\x24\xbe\x91\xf9\x44\x2f\x56\x08\x9b\x1a\x0d\x60\xb3\x44\x29\x51\x82\x32\xe4\x8e\x7d\x01\xf0\xb4\x80\xdb\x95\xb1\x74\x7e\xa5\x50\x4b\x7a\x77\x4b\xee\xad\xd4\x51\x22\x19\xac\xd9\xe6\x2f\xb0\xa9\x55\x59\x33\x12\x64\x82\x2b\x34\xe8\x84\xd6\x5b\xa8\x2d\x03\x28\x00\x5e\x58\xd7\x4b\x62\x06\x5d\x10\xef\xbc\x35\xf9\xc8\x17\x04\xea\x8d\x08\x09\xce\xd2\x86\x9a\x1c\xf7\x16\x9c\x70\xa8\xb7\xe4\x64\x14\xa3\x27\xca\x10\x85\x4e\xc8\x17\x00\xdf\x90\x99\xa7\x8f\x89\x9e\x1a\x75\x9b\x51\xf5\xa0\x9a\xd6\x7a\xaf\x96\x1a\x59\x1b\xa4\x64\x4b\x52\x95\x2a\x79\x1d\xc7\x24\x65\xa4\x5a\x2b\x39\x06\x7a\x6b\xa0\xb1\x3e\x0c\x6c\xe1\x0f\x7e\x46\x62\x71\x89\xdb\xad\x70\x81\xd8\x2a\x1c\xab\x81\x43\xd2\x1b\x56\x5a\x0f\x5a\xdd\xe3\x0c\xae\x9b\xe8\x43\x12\x22\x58\xa3\xb7\x1c\x27\xc8\x49\xc0\x77\x4c\xf0\x5f\xae\x49\xde\xd7\x1f\x6e\x9f\x33\xd7\x32\xaf\xd2\x4b\x8a\xc7\xc0\xfb\x97\xd8\xc3\x46\x79\x5d\xf0\x61\xef\x6b\xeb\x91\xb4\x3e\x3b\xbc\x0d\x6a\xdd\x09\x17\xe5\xae\x44\x0b\x80\x6f\x89\x45\xa5\x35\x5e\xf9\x40\xee\x93\xb9\xc5\x3a\x58\x00\xfc\x25\x6b\x0a\x29\x5c\xa2\x32\x2b\x53\xc5\x3a\x1c\x66\x29\x84\xf6\x5b\xc0\x45\xbd\xbf\x06\x96\xdb\xb4\x77\x96\x35\xa1\x11\xf7\xe8\x41\x05\xa8\x85\x93\xcc\xe4\xe8\xc9\xc9\x07\x0b\xad\x43\xa9\xca\x00\x1b\x32\xdc\x8d\xd2\x1a\x6a\xd1\xb6\x48\xa8\xfc\xa9\x80\xf7\x35\x76\x3a\xd5\x6b\x81\x6a\x5a\x87\xa5\xf2\xc8\x5c\xb3\x6b\x74\x7a\x0b\xf9\x55\x01\xd0\x85\x23\xe2\x85\xe8\xde\x43\x23\xda\x96\xfd\x83\x05\x01\x1f\xde\xbe\x24\xd0\xca\xb3\xa7\x68\x9d\x95\xb1\x44\x10\xcd\x52\xad\xa2\x0a\xdb\x64\xc7\x91\xfd\x09\x47\xef\xd6\x61\x4e\x09\xe8\x44\x8a\x32\x8a\xa4\x9e\x22\x5a\x86\x3c\xd2\x92\x52\xf8\xac\x1b\x20\xb1\x45\x23\xd1\x94\x5b\x42\x89\x8c\xbc\xc6\x94\x10\xce\x86\x48\x18\x5b\x8d\xc9\x9d\x1a\x39\x4e\x50\x3a\x0f\x95\x35\xdc\x07\x17\xcb\xa4\xc5\xce\xa1\xc6\xb5\x30\xa1\x00\xf8\x73\x01\xff\xd9\x0b\x1f\x85\x57\x7a\x0b\x65\x2d\xcc\x0a\x41\x85\x1d\x81\x76\xce\x41\xf9\x1d\xfb\x66\xc3\xd5\xb6\x4c\x59\xf2\x2c\x87\xcb\x9c\xc6\x74\x7b\xe8\x61\xe9\x88\xaa\x22\xcf\x64\x62\x83\xce\x46\xdf\x25\x3d\x05\xc0\x73\x6b\x9e\x3c\x09\x2c\x6b\x30\xb8\x61\xbf\x91\x0e\x22\xb7\x1b\x8d\x44\x97\x8d\x0d\x25\x7d\x4c\x80\x43\x8d\x5b\x90\x96\xc5\x95\x73\x73\x52\x4f\x1f\x50\x48\x62\x40\xf4\xc9\xad\x67\x44\x66\x29\x21\x27\xee\x13\xca\x9a\x45\x6f\xd7\x4a\xf2\x29\x32\xfb\xfc\x04\x58\x30\xb3\xc8\x18\xe6\x95\x2d\xf9\x8b\x35\xe4\x5f\x5d\xb2\x42\xf2\xc8\x05\x7b\x22\xfc\x28\x9a\x56\xe3\x8c\xb3\x0f\x55\x62\xef\xb0\x3d\x2b\xab\x90\x8d\xf2\x2c\x11\x87\x2b\xe5\x83\x13\xc9\xbd\x8f\xd2\x86\x3a\x2e\x8b\xd2\x36\x0b\xaa\x27\x9c\xc1\x80\x9e\x72\x82\xc5\x52\xdb\xe5\x82\x84\x25\x3c\xce\x9f\x16\x4f\xff\x75\xd1\xc3\x1a\x83\x5a\xac\x9f\x2e\xd8\x15\x14\x2b\xfb\xc5\xcb\x3f\x7f\xfb\x2d\x14\x4f\x1e\x44\x96\xe3\x61\x18\x4e\x64\xc4\x07\xe3\x12\x71\x7f\x4f\xc9\x32\x47\xc2\xc3\x30\x78\x26\x14\xd2\x53\x75\xbe\x7a\xc2\xd9\x4f\x6e\xab\x1c\xc9\x7a\x7b\x6c\x15\xa6\x78\xdc\xa7\xdb\x1c\x1b\xb2\x06\x08\x03\x94\x56\x39\xcc\xdf\x66\x49\x1b\x72\xc0\x1f\xd2\x71\x0a\xac\x20\x72\x60\xf8\x8f\x77\xaf\x5f\x2d\xfe\xdd\x26\xcc\x40\x94\x25\x7a\x9f\xd2\x9d\x86\x9d\x98\x8f\x14\xa0\x7c\x97\x09\xbd\xa3\x2f\x45\x23\x8c\xaa\xd0\x87\x22\x43\x43\xe7\x7f\xfc\xe6\xa7\x3d\x15\x51\x89\x5f\x7d\xea\xda\x85\x76\xe5\x13\x31\xfd\x5e\xd8\xa8\x50\x33\x4a\xad\x95\x19\xe9\x0d\x23\x1b\xc8\x44\x6c\x46\x36\x22\xc7\x87\x1b\xb8\x26\xeb\x18\x1d\xfd\x33\x39\xfd\x5f\xae\xe1\xcb\x0d\x07\x19\x8e\x01\xd7\xe9\xc0\xbe\xc6\xe0\xb8\x90\x25\x38\x1c\xcc\xaa\x1f\x9c\x5a\xad\x90\xc2\x35\xa7\xcd\x94\x9a\x7e\x45\xb1\x44\x55\x60\xec\x68\x31\x83\x20\x7e\xf6\xb6\xb9\x8f\xc8\x8f\xdf\xfc\x74\x0d\x5f\xee\xd2\x45\x51\x12\x3f\xc2\x37\xe4\x40\x98\xb2\xd6\xca\xaf\xb2\x53\xf5\x5b\x13\xc4\x47\x82\x59\x52\x60\x32\x7d\xb4\xab\xc5\x1a\xc1\xdb\x26\x45\xa8\x79\x4a\xe3\x24\x6c\xc4\x96\x68\xe8\x58\x49\x52\x15\x1c\x4f\xf7\x2a\xb0\xf7\xaf\x9f\xbf\xbe\x49\xa7\x91\xd8\x56\xa6\x73\xf3\x95\xa2\xfa\x2a\x79\x4f\xaa\x15\x58\xe6\x84\x48\x4c\x42\xa2\x1c\x30\x7b\xc4\xe4\x81\xab\x48\x59\xfb\x01\x1b\x9b\xa0\xeb\x0f\xcb\xa1\xc3\x6a\xce\x71\x68\xdf\xb8\xfe\xdf\x8a\x8e\x89\xc4\x71\xdd\x3f\x81\xb8\x57\x23\xbd\x3b\x49\xdc\xe0\x0f\x89\x3e\x69\x4b\x4f\xa4\x95\xd8\x06\xbf\xa0\xd0\xbd\x56\xb8\x59\x6c\xac\xbb\x57\x66\x35\x27\xc5\x9a\x27\x69\xfb\x05\x37\x49\x16\x5f\xf0\x7f\x2e\xa6\x85\xdb\x1b\x53\x09\xe2\xc5\xbf\x07\x55\x74\x8e\x5f\x5c\x44\x94\xdb\xcd\x94\xa7\x90\xf6\xae\xcb\x70\xf7\xf6\x92\x59\xa4\xf4\x2c\x37\x3f\x46\x9e\xac\x11\x32\xb9\x3a\x61\xb6\xbf\xb9\xd2\x12\xeb\xa2\xa3\xb3\xb7\xf3\x9c\x02\xcc\x85\x91\xf3\x3e\x45\x2d\xb7\x17\xf1\x2a\xaa\x49\x86\x4a\x09\xf7\xef\xa2\xca\x51\x5d\x64\x95\x47\x5a\x00\xf4\xb4\xc2\x89\x06\x03\xba\x03\x29\x81\x0a\xd8\x1c\xcc\x14\x76\xa8\x7f\xd3\x41\x80\x52\xb4\x24\xa0\xdc\x22\x13\x4e\x89\xa5\xd2\x94\x0d\x27\x27\xbc\xdf\xcb\x5b\x62\x4a\x8f\xa9\x84\x0b\x8a\x4b\x70\x8a\x75\x43\x7d\x7d\x28\x91\x38\x9d\xc2\x10\x6a\x95\x88\x3a\x1c\xfe\xb8\x87\xf9\xf3\xb4\x36\x75\x9e\xf2\xc6\x1c\x4f\x53\x88\xeb\x99\x43\x4b\xfa\x24\x71\x99\x6a\xe9\x53\x58\x9e\x95\xc8\x3e\x2e\xd3\xd0\xed\x7f\x0c\xac\xa6\x24\xd6\xac\xd0\x8d\x97\x12\xbf\x6b\xbb\x61\x2c\x07\x12\x38\xf7\xce\x3d\x8d\xcb\x71\x56\xbe\xd5\x62\xfb\xea\xa8\x93\xdf\xc7\x79\x58\xbf\xd3\x53\x59\x6e\xe1\xc3\xad\xbf\x18\x0d\x34\xb1\x99\x2a\xe2\xdc\xe7\xd1\xca\xa7\x6c\x40\x6b\xbb\x19\x35\x4a\x6f\xab\xb1\x1e\x78\x0c\x9c\x05\x7c\x6f\x62\xd3\xe5\x06\x46\xe9\xbe\x64\x8d\x43\x0d\xdd\xa5\x2d\x0c\x58\xa4\x2a\xe1\x08\x4a\x47\x0d\x69\x22\xb9\xdd\x12\xe1\x9c\xd8\x1e\x5c\xa1\x9a\x26\x06\xb1\xd4\xd3\xa4\x92\xfd\x39\x15\xd4\xd5\x9e\x96\x64\x21\xa5\x64\x47\x82\xa8\x02\xba\xac\xee\x2a\x28\xa1\x93\xda\x6b\xdd\xf7\xb7\xc7\xfd\xf7\x93\xc8\x2f\xad\xd5\x28\xcc\xc1\x35\xc7\x93\x86\x3d\xcc\xaf\x5f\xe5\x5c\x93\x8e\x1d\x37\xec\x72\x12\xdf\xe9\x57\xce\xd2\xba\xe6\x1e\x54\x4a\x23\x17\x62\xe3\x24\xfc\x2e\xdd\x51\x3c\x7b\xfd\xe1\xd5\xfb\x3b\x5a\x6f\xfa\x5a\xb1\xf3\x5f\x9a\xe5\x2c\x38\xb5\xcd\x49\xf6\x7f\x9b\xd4\x3b\xe5\x50\xda\x6a\x55\x0a\x7f\x03\xf0\xf3\xcf\x50\xb0\x27\xf4\x05\xc3\x83\x5f\x7e\xb9\xbe\x54\xbb\x73\x7b\xe0\x48\xe8\xd9\xe3\xc8\xdb\xbc\xb8\xcf\xbe\x0f\x08\x55\xf9\x1e\x26\x85\xec\x25\xee\x38\x33\xa1\x75\xef\xcc\xfc\x8c\x12\xfc\x4d\x8d\xa1\x46\x37\xf2\x8a\xa4\x16\x3e\x56\x95\x3a\xe7\xef\x4e\x49\x39\xd7\x13\x93\xc8\x7a\x9f\xd6\x82\x92\x14\xe6\x99\x2c\xa6\x49\x0b\x93\x04\xbe\xc2\xe0\x01\x3f\x62\x19\x43\xd7\xa0\x4a\x55\xc4\xa0\xca\xac\xc3\xbe\xd3\x85\xdb\xbe\x6b\x9b\x8b\x81\x91\xd9\xdf\xa5\x8e\xc5\x1d\xe7\x2b\xe9\x10\x2e\x51\xf8\x24\x2e\x6f\xf0\xa3\xf2\x81\xb8\x43\x8c\xd9\x28\x8f\xa0\xc2\x13\x0f\x77\x12\x5b\x6d\xb7\x77\x17\x7b\x32\xf6\x29\x73\x5e\x36\x89\x2d\xdb\x16\x47\x92\x1e\xbc\x12\x41\xe8\x49\xe2\xe2\xee\x2e\x9d\x7a\x29\x6a\x27\x72\x86\x53\xee\x88\x78\x77\xc0\xd5\x09\x29\xf9\x66\x55\xe8\x37\x27\x03\xf8\x6e\x66\x41\x72\x18\x88\x15\xe0\xd1\xa9\xd4\xa7\x7e\x53\x0b\xcf\xf4\x93\x7c\xb0\x57\xeb\xd2\x92\x71\x87\xc3\x01\xee\x5c\xea\xd0\x32\xcc\x49\x62\xc8\xc7\x37\xa2\x25\xb4\x78\x63\x52\x13\xae\xdf\xf9\x6b\xa7\x66\x97\x85\x84\x87\xa7\xed\x30\xa2\x0b\x66\x3e\x60\x9b\xb9\xd0\xb5\x2f\x7e\xe8\x73\xcc\x8c\xc1\xd1\x28\x7b\x9e\x23\xe9\x39\xe5\x9c\xd3\x33\x21\x80\xd1\xc3\xd8\x9e\x86\xb4\x1b\xa6\x98\xba\xcc\x64\xda\x3c\xe2\x71\xc7\x81\xe1\xfa\xe6\x21\xe1\xe0\x03\x5f\x36\x88\xe1\x66\xf1\x38\x2f\xe0\xbc\x50\x8e\xa0\x38\xba\x4c\xea\xaf\x02\x28\x8f\xb0\x55\x0e\x8c\xdc\xc1\x67\x21\xd9\xb2\x8c\x07\xae\x89\x76\x9f\x69\x52\x49\xcf\x79\xd9\xa4\x67\xa2\x84\xf2\x62\xe1\xef\x27\x9c\x3d\x91\x5f\x17\x20\x70\x3e\xdf\xd9\x5f\x79\xc4\x4f\x7d\x3a\x48\xee\xa7\xe2\x6a\xfb\x09\x6a\xfa\xda\x49\x4c\x8d\xc4\xde\x42\xbb\xec\xdb\xc7\x25\xf3\x68\xe8\x71\x69\x61\x16\xc9\x5f\x0c\x19\x0b\x0f\x93\x48\xb0\xf1\xa8\xb7\x18\x53\x70\x86\x87\x13\xf8\x61\xa2\xd6\x9c\x2b\x42\x70\x11\x2f\xcc\x38\xcf\x31\xea\xf7\x66\xd1\xe7\x84\xb3\x07\x51\x67\x70\xed\x14\x82\x06\xaf\x43\x3f\x1f\x22\x70\x86\x9f\x27\x8e\x3e\x62\x69\x3b\xf8\xbc\x1c\x0a\x97\xb4\x1e\xc4\x5a\x28\xdd\xe5\xa6\xcc\xb3\x93\x97\xef\x30\xb9\x94\x7f\x2f\xfc\x7d\xaa\x86\x57\xda\x2e\x85\x9e\x41\x6b\xf5\xb6\xb1\xae\xad\x55\x09\x8a\x62\x6b\xb3\x33\xdb\xa2\x35\xb4\x71\xa9\x55\xa9\xb7\x23\xac\x18\xcb\x0b\x02\xf0\xf1\xbe\xe8\x04\xdd\x3f\xe5\x09\xcf\x6e\x7e\x38\x0c\x71\x82\x43\x3c\x0b\xc1\xb7\x52\x3e\x71\xa0\xbf\x0f\x26\xf6\x11\x28\x9f\xdb\xd8\x5c\x6b\x7a\x88\xa9\xaf\xbf\xb6\x4a\xc2\xc6\x29\x1e\x67\x29\x79\xf4\x0c\xa2\x59\x34\xc2\xf9\x5a\x68\xcd\x3d\x79\xbe\xc2\x64\xa5\xe7\x8e\x77\x2b\x9c\x47\x28\xd1\x71\x68\xcf\xd7\x98\xe9\x46\x90\x80\xe4\xdb\x40\x3e\xf7\x07\x65\x64\xba\xed\x94\x76\x63\xbc\x92\xd8\xdf\xe7\x8b\xb6\x75\x56\x94\x35\x28\xbe\x53\x14\xa3\x5b\xe8\x74\x7b\x4c\xd9\x3d\x5f\x18\x8b\x75\x7f\x59\x9a\xf3\x5f\x04\x4f\xda\xff\x77\x6f\x93\x1d\x78\x8a\xa6\xaa\x43\x72\x89\xa5\x6d\xba\x7b\x4f\x1b\x7d\x3f\x91\xd5\xd5\x0d\x4c\x80\xe3\xfb\xc5\x46\xad\xea\x00\x0e\xd7\xca\xab\xb0\x8f\xd8\xb8\xa9\xde\x99\x3d\x2f\xe9\x4e\x30\xa0\xbc\x8f\x47\x8b\x8f\x29\x31\xf3\xd4\xa8\xc9\x11\x71\x8f\x22\xba\x68\xdb\xfe\xc2\x2b\xa3\x6b\xa9\x1e\xa2\x9a\xd8\x61\x6b\x67\x1d\xcd\xfd\xcd\x0a\xdf\xe4\x3a\x2c\xd1\x9c\x0a\x4c\x93\x9c\xba\xb4\xe6\x64\x98\x3f\x5f\x7b\xd1\x53\x89\x20\xf4\xe7\x83\xe9\x6a\xc5\x53\x4d\x20\x98\x4a\x99\xdd\xf5\x5c\x97\x48\xa6\x03\x71\xb9\x78\xc0\x9a\x63\x61\x70\x32\x21\xad\x28\xef\xc5\xea\x24\x43\x76\x08\x40\xc5\x25\x36\xe1\xd6\xed\x65\x03\x9b\xa5\x5b\xe9\xfe\x5d\x65\xb5\x44\x47\x55\xb9\x30\xf0\xe1\xed\x4b\x9e\x78\xc8\xdf\x82\x70\x4b\xa1\x75\xd1\x8d\x1a\xf4\x9c\x18\x37\x69\x66\x3c\x37\x5a\x06\x9d\x3a\x80\x0e\xbd\xd5\x6b\xcc\x1d\x82\x04\xa7\x9b\x82\x70\xe4\x35\x46\x17\x60\xbd\x0f\xc8\x9b\xe4\x70\x02\xa1\x7a\x2a\x5b\x99\xc8\xb3\x5c\xab\x7f\xb6\x1a\xf5\x90\x5e\xa8\xe3\x3d\xb0\x07\x32\x18\xdf\x7d\xee\xb6\x8a\xbe\xe4\x69\x86\xa1\x91\x74\xd7\x7d\xf6\x77\x59\x22\x5f\xa5\x81\xc2\xee\xfa\x03\xe1\xeb\x56\x38\x34\xe1\xeb\x61\x3c\x2c\x4d\x2d\x05\xae\x0c\x86\xb6\x04\xc3\xef\x06\xcb\x5a\xdb\x46\x3e\x95\x21\x94\xb5\xd2\xf2\xeb\xbe\x53\x51\x50\xa4\x29\xfa\xfe\xfa\xa1\x90\xfa\x69\x4c\x52\xc7\xbb\x0c\x30\x2d\x9d\xdf\xcd\x94\x54\x8b\x79\x30\x30\x0d\x0a\xa5\xac\x4e\x24\x12\x3b\xea\x53\x8f\x89\x17\x77\xf9\x45\x57\x97\xf1\x28\x4c\xbe\x33\x92\x27\xcf\x9d\x5e\x13\xa1\x59\x9f\xd3\x81\xf4\x7c\x42\x45\x52\xfd\xda\x00\xef\xf1\x4c\x81\xf1\xa9\xf0\x4e\x64\x4e\x17\x00\x9c\x58\x53\x4d\xaa\x23\x60\x62\xe9\xd5\xda\x93\x14\x4c\xc2\xbd\x9f\x4a\xfe\x4c\x2d\x9f\xc8\xa8\x5f\x95\xfe\x8d\x30\xe1\x7b\x37\xc1\x13\x9e\x0e\xcf\x67\x44\x77\x61\x57\xaf\xf7\x7e\x97\x77\xf6\x4e\xf2\x74\x37\xc7\xee\x0e\x4b\xa5\x48\xd7\xe9\x71\xc3\x44\x55\xb0\xf0\x5f\xdf\xfd\xf5\xe5\x80\x16\xec\xb9\xeb\xe1\x43\x0e\x9f\xe4\x6a\xe8\xc5\x68\x6e\x4b\xe6\x89\x70\x2a\x56\x8e\x4d\xee\x1e\x64\x54\x6c\x57\x4e\x48\x12\xfc\x0b\x67\x0f\x5c\x43\xed\x10\xf3\x61\x67\x31\x13\x93\xaa\x86\xbd\x92\xcd\x0f\x73\xc7\x09\x3e\xf6\xd3\x73\xbf\x52\x71\xf7\x38\x49\xfc\x38\x49\xfc\x38\x49\xfc\x38\x49\xfc\x38\x49\xfc\x38\x49\xfc\xd9\x93\xc4\xe7\x33\xf2\x73\xd3\xc4\x9f\x3b\x4f\x3c\x21\x4b\x3b\x33\x53\xfc\x38\x55\xfc\x38\x55\xfc\x8f\x34\x55\x3c\x41\xe3\x4f\xd5\x81\xff\x08\xb3\xc5\x9f\xd9\xe7\xff\x03\x4e\x18\x4f\xa4\xe8\xc4\x94\xf1\x1f\x76\xce\x78\xd2\x24\xd3\x84\x59\xe3\x7f\x9e\x69\xe3\x09\x1c\x3b\x3a\x71\xfc\x07\x9c\x39\xfe\xad\x66\x88\xd6\x9f\xfc\x07\xc1\xc7\xfe\xc6\x39\x88\x10\xfd\x27\xfc\x95\x33\xaf\xdf\xf9\x3b\x67\xbb\xf4\xe8\xd6\x93\xff\xd0\xf9\x20\x22\x0f\x5e\x26\x90\xa3\xb6\x91\x0f\x96\x6a\xe3\xfc\x66\x40\x9b\xf2\x83\x36\xa0\x7c\xb5\xff\xcf\x3d\x5c\xa7\xe1\xc2\xee\xdf\x6f\xe0\x9f\xa5\x35\xa9\x15\xe3\x6f\xe0\xc7\x9f\xae\x20\xb7\x59\xbb\x06\x03\xbf\xfc\xbf\x00\x00\x00\xff\xff\x8f\xe3\xc6\x4f\x21\x43\x00\x00")
+var _configCrdsKudoDev_operatorversionsYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\x3d\x6b\x73\xdc\x38\x72\xdf\xf5\x2b\xba\xe6\x52\x25\x69\x33\x43\xad\xec\x7b\xaa\xe2\x6c\xf9\xec\xf5\xc5\xb1\xd7\x56\x59\xf6\x5e\x25\xb6\x73\x83\x21\x7b\x66\x70\x22\x01\x1e\x00\x4a\xe6\xba\xfc\xdf\x53\xdd\x00\xf8\x18\xcd\x83\x1a\x69\x37\x7b\x15\xfb\x8b\x35\x24\x1e\x8d\x46\x77\xa3\x9f\xe0\xc1\x64\x32\x39\x10\xa5\xfc\x11\x8d\x95\x5a\x9d\x81\x28\x25\x7e\x72\xa8\xe8\x97\x4d\x2e\xff\x68\x13\xa9\x4f\xae\x4e\x0f\x2e\xa5\xca\xce\xe0\x49\x65\x9d\x2e\xde\xa0\xd5\x95\x49\xf1\x29\xce\xa5\x92\x4e\x6a\x75\x50\xa0\x13\x99\x70\xe2\xec\x00\x40\x28\xa5\x9d\xa0\xc7\x96\x7e\x02\xa4\x5a\x39\xa3\xf3\x1c\xcd\x64\x81\x2a\xb9\xac\x66\x38\xab\x64\x9e\xa1\xe1\xc1\xe3\xd4\x57\xdf\x26\xbf\x4d\x4e\x0f\x00\x52\x83\xdc\xfd\xad\x2c\xd0\x3a\x51\x94\x67\xa0\xaa\x3c\x3f\x00\x50\xa2\xc0\x33\xd0\x25\x1a\xe1\xb4\x09\x3d\x6d\x72\x59\x65\x3a\xc9\xf0\xea\xc0\x96\x98\xd2\x9c\x0b\xa3\xab\xf2\x0c\x9a\xe7\xbe\x67\x00\xc7\x2f\xe5\x75\x18\x24\xac\x9c\xdf\xe4\xd2\xba\x17\xeb\xde\xbe\x94\xd6\x1d\x00\x00\x94\x79\x65\x44\x7e\x13\x04\x7e\x69\xa5\x5a\x54\xb9\x30\x37\x5e\x1f\x00\xd8\x54\x97\x78\x06\xaf\x08\x8c\x52\xa4\x98\x1d\x00\xc4\xce\x04\xd6\x24\xac\xed\xea\x74\x86\x4e\x9c\xfa\xf1\xd2\x25\x16\x8c\x52\x00\xa0\x31\xd5\xe3\xf3\xe7\x3f\x3e\xbc\xe8\x3d\x06\xc8\xd0\xa6\x46\x96\x8e\x91\xb8\x02\x38\x48\x0b\x6e\x89\xe0\xfb\xc0\x5c\x1b\xfe\xb9\x0a\x3e\x3c\x3e\x7f\x9e\x34\x03\x96\x86\xde\x3b\x19\x11\x06\x00\x00\xd0\xa1\x92\xce\xd3\x95\xe9\x0f\x09\x42\xdf\x0a\x32\x22\x0f\xf4\xf3\x87\x89\x30\x0b\x8b\x02\x3d\x07\xb7\x94\x16\x0c\x96\x06\x2d\x2a\x4f\x30\xf4\x58\x28\xd0\xb3\xbf\x63\xea\x12\xb8\x40\x86\x10\xec\x52\x57\x79\x46\x74\x74\x85\xc6\x81\xc1\x54\x2f\x94\xfc\xa9\x19\xcd\x82\xd3\x3c\x4d\x2e\x1c\x5a\x07\x52\x39\x34\x4a\xe4\x70\x25\xf2\x0a\xc7\x20\x54\x06\x85\xa8\xc1\x20\x8d\x0b\x95\xea\x8c\xc0\x4d\x6c\x02\x3f\x68\x83\x20\xd5\x5c\x9f\xc1\xd2\xb9\xd2\x9e\x9d\x9c\x2c\xa4\x8b\x1c\x90\xea\xa2\xa8\x94\x74\xf5\x09\x13\xb3\x9c\x55\x4e\x1b\x7b\x92\xe1\x15\xe6\x27\x56\x2e\x26\xc2\xa4\x4b\xe9\x30\x75\x95\xc1\x13\x51\xca\x09\x03\xab\x98\x0b\x92\x22\xfb\x8d\x09\x3c\x63\x0f\x7b\xc8\x73\x35\x51\x85\x75\x46\xaa\x45\xe7\x05\x93\xe8\x16\x2c\x13\x91\x82\xb4\x20\x42\x57\xbf\x8a\x16\x99\xf4\x88\xf0\xf1\xe6\xfb\x8b\xb7\x10\xa7\xf6\x08\xf7\xb8\x6d\x9b\xda\x16\xcd\x84\x22\xa9\xe6\x68\x7c\xcb\xb9\xd1\x05\x8f\x82\x2a\x2b\xb5\x54\x8e\x7f\xa4\xb9\x44\xe5\xc0\x56\xb3\x42\x3a\xda\xbf\x7f\x54\x68\x1d\xed\x40\x02\x4f\x98\xf5\x61\x86\x50\x95\x99\x70\x98\x25\xf0\x5c\xc1\x13\x51\x60\xfe\x44\x58\xfc\xd9\x91\x4c\xd8\xb4\x13\x42\xde\x30\x34\x77\xa5\xd6\x6a\x63\x8f\xa7\xce\x8b\x28\x5b\x00\x86\x30\xde\x45\x89\x69\x8f\x03\x32\xb4\xd2\x10\xc5\x3a\xe1\x10\xf4\x7c\xb5\x43\xd2\x1b\x7a\x3d\x0b\x02\x00\x88\xb2\x5c\xcb\x86\x5b\x96\x19\x64\xb0\xc2\x94\x40\xbd\xe0\xd7\x37\x3b\xf7\x56\xf3\x64\xa5\x79\xb3\x14\x01\x0e\x8b\x92\xf8\x2c\x0b\x13\x81\x5b\x0a\x07\xa9\x50\xbc\xef\x16\x33\x70\x3a\x4e\x47\x7f\x0a\x05\x52\x59\x27\x54\x8a\x9e\xeb\xb1\x59\x7a\x72\x9b\x15\x2c\x51\xe4\x6e\xf9\x64\x89\xe9\xa5\xdd\x01\xfd\x7f\x74\x9a\x42\x86\x69\x2e\x0c\xc2\xf5\x12\xa3\x64\xb1\x2c\x67\x98\xcb\xc6\x34\xa5\x4c\x45\x9e\xd7\x20\x20\xe5\xf3\xad\xe1\x98\x31\x50\x47\x3f\x71\x9d\xc0\xdb\x25\xd6\xfc\x84\x57\x39\xab\x79\x2d\x8f\xcb\x32\xaf\xc1\x09\x7b\xc9\x62\x26\x4a\x58\x83\x22\x23\x84\xd9\x20\xd2\x22\x0a\x12\x78\xdd\x82\xc0\x94\x0a\xd7\xd2\x2d\x75\xe5\x40\x84\x99\x20\x25\xc0\xbb\x53\x7b\xd8\x5d\x9c\x9e\xcf\x47\xcc\xc6\x50\xa9\x9c\x66\x70\x4b\x94\x86\x09\xab\xb2\x30\xd7\x79\xae\xaf\xad\xe7\x55\x5d\x14\x5a\x41\x87\x4b\xe0\x68\xea\xdb\x25\x7a\x66\x89\xed\xb3\xbf\xa0\xa2\xcd\x90\x5a\x4d\x79\x01\xd4\x6f\xfa\x06\x45\x56\x4f\xc7\xf4\x47\xaa\x55\x2a\x73\xa9\x16\xfe\xf5\xf4\xc2\x89\x3c\xc7\x6c\x4a\xa3\x66\x7c\xf8\xdb\xe3\x9b\xfb\x28\x1d\x16\x6b\x76\x69\xf3\x3e\xc5\x6d\xb2\xcd\x5a\x6f\xee\x55\x6f\x37\xd6\x8c\xbd\x99\x69\xb6\x9f\x60\x1b\xa0\xeb\x1c\x65\x7a\xde\x85\x68\x0c\x98\x2c\x12\x98\x5e\x8a\xf9\xa5\x48\x88\x58\x8b\x9f\xa4\xd7\x93\xf8\xf0\x9e\x26\x1b\x86\xdf\x42\xdd\x9b\xe4\xfe\x06\xd8\xf8\x00\x58\x0f\xd5\x0b\x82\x6a\x7f\x10\x4c\x95\xa3\x1d\x04\xc3\x1b\x6a\xe9\xb9\x5f\xe4\x39\x2c\xc5\x15\x82\xd3\x50\x0a\x6b\x99\x0d\x9a\x83\x9c\x9e\xce\xb6\xee\xdc\x56\x92\xd9\x48\x38\x04\x40\x38\x05\xa5\x5a\xe4\xc8\xb0\x7b\x72\xe9\x50\x56\x02\xdf\x7f\x12\xa9\xcb\x6b\xd0\x8a\xdf\x4a\x67\x61\x2e\x31\xcf\x2c\x2c\xbd\xea\x30\x43\xb0\xe8\x92\x8d\x73\xef\x22\xac\x46\xc4\x7a\x86\xd8\xd6\xe8\xa6\x9c\xf5\x7d\xf8\x20\x95\x06\xfd\x99\xce\x9c\xdc\x8c\xd7\xdf\x68\x70\xda\xe3\x3a\x36\x4c\xb6\x4e\x37\x0c\x76\x00\x08\xa3\xed\x6a\xb5\xb2\x80\x0b\xee\x14\x21\x6c\x40\x4e\xe0\x29\xce\x45\x95\xb3\x62\x00\xa3\xb7\xa6\xc2\x51\xb2\x73\xe4\x9d\xc4\xb9\xd2\xf4\xfe\x06\x0c\xd8\xcf\xb6\x0f\x39\xe1\x01\x0f\x76\xcf\x78\x43\x79\x58\xfd\xc7\xf4\x77\x0b\x3a\x79\x46\xed\xbb\x34\xc2\x03\x6c\x24\x0c\x56\x07\xef\x8b\x2e\x4a\xe1\x96\xb7\xa4\x8a\x73\xe1\x96\x9e\x33\xff\xf3\xe2\xf5\x2b\xfe\x85\x9f\x48\xe1\x64\x69\x6a\x31\x27\xdd\x22\xe8\xa8\xbc\x92\x7b\xa5\x0d\x5e\xfd\x2d\x21\xfe\x91\xfa\xb4\xf0\x44\xc9\x40\xf8\x4c\x7e\x79\x32\x23\x94\xef\x68\xc2\x8b\xbc\x0f\x52\xbc\xa9\x08\xdc\x82\x2e\x5f\xdf\xe8\xdc\x12\xe9\x36\x4d\xc3\x0b\x5d\xfc\x47\x25\x72\xfa\x7b\x1a\x35\xf1\x64\xd1\x36\x1a\x83\x4c\x30\xe9\xda\x75\xa4\x82\xaf\xd0\xfc\xb5\xb0\x44\xca\x29\xda\xa0\x93\x49\x67\x3b\x0e\x87\x64\x00\x86\x66\x5a\xe7\x28\xd4\xc1\x1d\xf0\xe8\x9b\x08\x63\x44\x7d\x70\xbb\x5d\x9f\x74\xb4\x92\xb5\xaf\x49\x25\x58\xfb\x82\x0f\xea\x83\x5b\x82\xbb\x19\xd0\xe8\x12\xd8\xa1\x5a\x1f\x7a\xf5\xf5\x0d\xce\xd1\xa0\x4a\x59\xe4\x3b\x21\x95\x05\x54\xba\x5a\x2c\xd9\xba\x33\x05\xef\x20\x38\x0d\x39\x3a\xa8\x75\x05\x52\xd1\xe6\x39\xd0\x06\x0a\x9d\xc9\x79\x1d\xb4\xe4\x39\x1a\x83\x59\x63\xf1\x4f\x26\x13\x78\x85\xd7\x50\x59\xb4\x8d\x8f\x80\x80\x06\x61\x10\x32\x69\x53\x5d\x19\xb1\xa0\xad\xc6\x54\x54\x96\x0f\xf4\x4c\xce\xe7\x32\xad\x72\x57\x07\x58\x67\x24\x5b\xa4\xb3\x50\x59\xb1\x08\x9a\x3f\x16\x33\xcc\x32\xcc\x40\x2a\xd2\xec\x6c\x02\x70\x9a\xc0\xf3\x85\xd2\x34\xbf\xd7\x07\x12\x80\xe7\x0e\xa4\x4a\xf3\x2a\x43\xb2\x87\x55\x1d\xde\xc0\xf5\x52\xa6\x4b\x06\x42\x69\x07\x9e\x4a\xc9\x66\x58\x6a\x1e\x20\x01\x78\xa6\x4d\xa3\xe5\x8f\x21\xfa\xc8\xc2\xd6\xb2\xee\xcc\x42\x9c\x85\x21\x8d\x33\xd3\x6e\x09\x57\x68\x6a\x30\xc2\x60\x5e\x13\x47\x4b\x06\x4f\xa4\x8e\xf8\x82\x81\x4f\x00\x1e\x90\x15\xed\x5f\xf2\x23\x58\x62\x5e\x06\x50\x2d\xc8\xa2\xd4\xd6\xca\x59\x8e\xe0\x34\x88\x2c\x63\x2e\x91\x73\x99\x72\x3b\xd6\xc4\xa4\xca\xe4\x95\xcc\xba\x83\x3e\x57\x50\x68\xeb\x5a\xb4\xf0\x0b\x3b\xa6\x6d\x31\x1e\xdb\xa5\x30\x8e\xd0\x2a\x0c\x00\x00\x18\x24\x01\x97\x7a\x33\x22\x97\x97\x38\x86\x51\x51\x59\xe7\x37\x11\xb4\x22\x33\x48\xf3\x66\x59\x78\xcc\x0b\xfe\xf3\x08\xb4\x81\xd1\xbb\xe7\x4f\x19\x6b\x01\x57\xfe\x21\xb9\xbb\x80\xfb\xcf\xb0\x19\x1b\xb3\x51\x02\x00\x00\x6f\x97\xda\x22\xa4\x8d\x3f\xe1\x1a\xf3\x3c\x6e\x2e\x66\xfd\x1d\x4d\x00\x1e\x12\x8a\x52\xad\xac\xb4\x0e\x95\xf3\xa8\x14\x5e\x11\x81\x3f\x07\x4a\x71\x4b\x0c\xab\x0c\xc4\x34\x67\x1a\x76\xbc\xe6\x4e\x17\xcf\x59\xfd\x36\x24\x59\xb8\xef\x38\x50\x42\x21\x2e\xd1\x82\x74\xb0\x14\xc6\x5b\x7d\x95\x45\x63\xc1\x69\x28\x0d\x66\x92\x44\xd3\x52\x38\xb8\x96\xac\x1a\x97\x25\x12\x28\xbf\x65\x23\x32\xd2\x54\x43\x05\xb2\x28\x0d\xa6\xd2\x22\x63\x4d\x5f\xa1\xc9\x6b\x08\x8f\x12\x80\xe8\xed\x21\x5c\x88\xf8\x1c\x0a\x51\x96\x7c\x86\x6a\x10\xf0\xee\xcd\x4b\x1a\x5a\x5a\xc2\x19\x09\xc4\xac\x4a\x11\x44\x31\x93\x8b\x4a\xba\x1a\x00\x00\xb2\xca\x30\x5f\x28\x87\xa6\x34\x18\x3c\x6e\x34\x63\x10\x50\x20\xbc\xc3\x28\x8c\xdc\xa1\x92\x54\xd8\x40\x1b\x90\x61\x89\x2a\x43\x95\xd6\x20\x2d\x68\x6f\xaa\xb1\xbf\x75\xdc\x3a\x9a\xaa\x32\x47\x00\x80\xc6\xa2\xbc\xea\x1b\x52\x81\xc2\xad\x33\x55\xea\xa9\xd8\x18\xcc\xf1\x4a\x28\x97\x00\xfc\x2e\x81\xbf\x36\x9b\x8f\xc2\xca\xbc\x86\x74\x29\xd4\x02\x41\xba\xde\x86\x46\xe1\x20\x6d\x8f\xbf\x99\x71\x73\x9d\xf2\x0a\xed\x38\x78\xa3\x82\x97\x30\xf6\x01\x00\xbf\x3b\x62\x3e\xc7\xd4\x81\xaa\x0a\x34\xba\xb2\xd1\xa7\x98\x00\x3c\xd5\xea\xf0\xd0\xf1\x5e\x83\xc2\x6b\x96\x1b\x7e\x22\x10\x0a\x2a\x95\xa1\x09\xcc\x86\x19\xbd\xf4\x03\xb3\x9d\x9e\x69\xde\xae\x70\x12\x11\x79\x5a\x87\x82\x35\xb6\xca\x7a\xd5\x27\x00\x32\x0e\xf6\x3c\x08\x06\x39\xe7\xad\xd7\x57\x32\xe3\x59\xb2\xe0\x52\xf1\x03\x0b\x46\x16\x31\xc3\x64\xae\x53\x7e\xa3\x15\xc9\x57\x03\x26\x4a\xe4\x84\x25\x11\x7e\x12\x45\x99\xe3\x98\x9d\x7b\x32\xc5\x46\x60\x07\xdb\x2c\x2b\xa4\x57\xc6\x0c\x2e\xa4\x75\xe1\xe0\xef\x7a\xe5\x96\xd5\x2c\x49\x75\x71\x42\xee\x7a\xa3\xd0\xa1\x25\x97\xdb\xc9\x2c\xd7\xb3\x13\xda\x2c\x61\x71\x72\x9a\x9c\xfe\xe1\xa4\x19\xab\x3b\xd4\xc9\xd5\xe9\x09\x8b\x82\x64\xa1\x7f\xf3\xf2\x77\x0f\x1f\x42\x72\x78\x70\x3b\x1d\x74\x97\xb9\xbe\x6a\xac\xaf\x12\x59\xc0\xc8\x06\xbb\x6e\x87\xce\x36\x8f\xb2\x7a\xc0\xdc\x87\xcf\xe7\xe1\x24\x6b\xf8\xb1\x94\xe8\xdd\x5d\xad\x11\x2c\x5b\x0a\x10\x0a\x50\x39\x69\xa2\x16\x33\xf6\xd4\xe0\x81\xe9\x78\xbb\xe9\x60\xf5\xda\xbc\xcc\x58\x93\x3e\xf9\x8b\xf6\x90\x81\x48\x49\xe5\xf1\xde\xc4\x82\x85\x98\xad\xe8\x80\xb2\xd1\xd1\x48\x86\x19\x26\x85\x50\x72\x8e\xd6\x25\x61\x34\x34\xf6\xfd\x83\x8f\x2b\x24\x22\x7b\x1a\x55\x43\x48\x20\xad\x5f\x4c\xd3\x97\x5d\x55\x0c\x52\xa9\xb3\x00\xf4\x35\x03\xeb\x88\x45\xb4\x8a\xa6\x07\x9f\x0f\x67\x30\x22\xee\xe8\x4c\xfd\x99\x84\xfe\x97\x11\x1c\x5d\xf3\x21\xc3\x67\xc0\xc8\x4f\xd8\xb8\xf0\xe9\x59\xc7\x9c\xf4\x3d\x3d\xe9\x3b\x23\x17\x0b\x34\xe8\x45\x0a\x92\x4f\xeb\x18\xb4\x21\xf8\x95\xee\x34\xe6\x21\x08\x9f\x0d\x6f\xae\x02\xf2\xfe\xc1\xc7\x11\x1c\xf5\xd7\x45\xa7\x24\x7e\x82\x07\x20\x95\x5f\x59\xa9\xb3\xe3\x20\x54\x6d\xad\x9c\xf8\x04\x24\x5e\xe9\x60\x52\xcd\x69\xc7\xf6\x96\xd5\x85\x3f\xa1\x26\xde\x4b\x9a\xc1\xb5\xa8\x69\x0d\x11\x95\xb4\xab\x82\xcf\xd3\x95\x00\xc7\xdb\xd7\x4f\x5f\x9f\xf9\xd9\x68\xdb\x16\x2a\x8a\xf9\xb9\x54\x22\x0f\xd2\x53\x06\x77\x05\x2f\xa9\x6a\x4c\xbd\x28\x11\xbd\x04\x9e\x57\xe4\x14\x4f\x0e\xd7\x52\xeb\x0e\x5a\xdf\xec\x75\x5a\x13\x75\x58\x65\xae\xff\x33\x9f\xfe\xc0\xc5\x71\x58\x6d\xc0\xe2\x5e\x75\xe8\x6e\xeb\xe2\x5a\x79\x48\xeb\xcb\x74\x6a\x69\x69\x29\x96\xce\x9e\xd0\xd1\x7d\x25\xf1\xfa\xe4\x5a\x9b\x4b\xa9\x16\x13\x22\xac\x49\x70\xd0\x9d\x10\x28\xf6\xe4\x37\xfc\xdf\xde\x6b\xe1\xe8\xe1\xd0\x05\x71\xe3\x5f\x62\x55\x34\x8f\x3d\xd9\x6b\x51\xa6\xaf\x29\x0f\x59\xda\x45\xd4\x70\x57\xfa\x82\xd3\x41\x3d\x0b\xb1\xc5\x8e\x24\x2b\x44\xe6\x45\x9d\x50\xf5\xcf\x4e\xb4\x84\xba\xca\xd0\xdc\xf5\x24\xa8\x00\x13\xa1\xb2\x49\xa3\xa2\xa6\xf5\x5e\xb8\xaa\xe4\x20\x46\x25\x85\xfb\x17\x21\xe5\x4a\xee\xc5\x95\x5b\x4c\xd4\x52\x18\x51\xa0\x43\xb3\x46\x25\x18\x16\x54\x38\x8f\x23\x40\x2a\x4a\xda\xa0\x10\x81\x16\x46\x8a\x99\xcc\xa5\xab\x83\x10\x5e\x0d\x95\xcf\xd0\xab\xc7\x64\xc2\x39\xc9\x11\x2e\xa9\x7a\xb1\x9b\x3d\x62\x0e\x99\x77\x83\x0e\x72\xa8\x07\x97\x29\x48\x0b\x22\x76\x0c\xe7\xa9\x3f\xe2\x1a\xe4\x50\x93\x46\x49\x0c\x81\xa8\x6d\x50\xc2\x6e\xd2\xea\xc3\x32\x0c\xdc\xe6\x47\x8b\x6a\x01\xb9\x56\x0b\x34\xdd\xa6\xa0\xe7\xb0\xd4\xd7\x0c\x65\xbb\x04\xd6\xbd\x43\xc8\x70\x7f\x98\xa5\x2d\x73\x51\xbf\xda\x28\xe4\x57\x61\x6e\xdb\xf7\x42\x96\xb3\x1a\xde\x3d\xb7\x7b\x83\x81\xaa\x2a\x86\x6e\x71\x08\xa3\x52\xae\x09\x13\x22\x85\xeb\x3a\x79\x08\xcf\xe7\x5d\x3a\xb0\xe8\x58\x0b\xf8\x5e\x55\x45\xd4\x0d\x94\xcc\x1b\x93\xb5\x6a\x6d\xe8\xa8\xb6\xf0\xc0\xc2\x5b\x09\xfb\x85\x5a\x76\x2e\x77\x97\xc3\x0b\x40\x16\x45\xe5\xc4\x2c\x1f\xb6\x2b\x41\x9e\xa3\x8d\xaa\x68\xd9\xe1\x61\xde\x24\xaf\xec\x64\x20\xe6\x0e\x4d\x20\x77\xe9\xa4\xc8\x3d\xd9\xe7\xb9\xe8\x05\x4a\x02\x67\x1f\xec\xeb\xf6\x53\x43\xe9\x69\xf4\x2a\xe8\x9a\x34\x6d\x37\x1e\x1e\x94\xf8\x48\x5f\x41\x4b\x8b\xb1\x73\x98\xcb\x1c\x61\xbe\xa2\x84\x4f\x79\x5a\x78\xf2\xfa\xdd\xab\xb7\x53\x6a\xaf\x1a\x5b\x31\xca\xaf\x1c\x59\x26\xb1\x6a\x1b\x94\xec\x0f\x8a\x7f\x9d\x01\x80\xc1\x32\x97\xa9\xb0\x67\x00\x9f\x3f\x43\xc2\x92\xd0\x26\x3c\x1e\x7c\xf9\x32\xda\x3b\x24\xb8\xc3\x6b\xdd\x8f\x0a\x86\xc6\x60\x37\x6f\xaa\xb4\xcd\x98\xc1\x13\xdc\x15\x66\x22\xcf\x1b\x61\x66\xc7\xa0\x0d\xb9\x7b\xc8\x2d\xd5\x91\x8a\x44\x16\xb6\x22\xb7\x1f\x26\x7b\xef\xb2\x45\x65\xa5\x93\x57\x03\x89\x34\xb6\x86\x42\x98\x4b\x0b\xa2\xb3\xa0\x6b\xb2\x0a\xa2\xa8\x66\xff\xf3\x5c\x66\xa4\x0f\x88\x3c\x84\x65\x05\xc7\x45\xaf\xb5\xa1\x9c\x18\x67\xdb\xb6\xd6\xb1\x03\x92\x77\xf5\x02\x53\x83\x2e\x52\x71\xc4\x41\xd7\x72\xec\x3d\x27\x0c\xb3\x74\x60\xf5\xc6\x5e\xb6\x74\xa6\x2b\x57\x56\xcd\x40\x4f\x5e\x3e\xf7\xcd\x14\x64\x52\x2c\x94\xb6\x4e\xa6\x76\x7f\xbc\x05\x3b\x6c\x10\xd6\xde\xfa\xb6\xe0\xd1\xc1\xe4\xc0\xb4\x90\x0b\xe5\x19\x65\x81\xce\x02\x7e\xc2\xb4\x72\xd1\xb1\xe7\xad\xaf\x56\x04\x30\xef\xdb\xb8\xb6\xe7\x71\xf9\xd1\x88\xea\x88\xcb\xa9\xf7\xf4\x4c\x59\xcf\xf3\x93\xb0\x69\xc7\x33\x11\x77\x01\x7e\x92\x96\x62\xe5\x9a\x08\xea\x5a\x5a\x04\xe9\x0e\x2d\x4c\x33\x2c\x73\x5d\xef\x1f\x36\xe7\xfd\x9c\x6c\x8b\x4a\xf6\xd1\x52\x97\xd8\xe1\x90\x56\x9a\xd3\x08\xfd\xe0\xe9\xd4\xcf\xba\x2f\x68\x7b\x86\x03\x08\x77\x6b\x8e\x08\x91\xf9\xf8\xae\xc8\xcf\xb7\x2a\x3e\x7d\x8d\x8c\xf6\xa1\x5d\xac\x00\x8b\x46\x7a\xff\xfe\xf9\x92\x3c\x8b\x61\x7f\xb0\x11\x07\xa9\x26\xa1\xe8\x30\xdb\x47\xe5\xd2\xea\x99\x90\x79\x65\x86\xed\xc4\xeb\xd8\xda\x5b\x57\x91\x6c\xa2\xaf\x8d\x9c\x81\x59\x95\xdf\x54\xb3\x3a\x41\xa6\x2e\xd5\x52\xdf\xb9\x90\xb9\x4f\xeb\x01\x01\x73\xe1\x44\x0e\x68\x8c\x36\x8d\x28\x38\xa4\x7e\x33\x91\x5e\x1e\x72\x07\xef\x14\x6e\x35\xdf\xc6\x6b\xba\xaa\xa1\x06\x7e\xce\x85\x75\xe4\xee\x21\x0f\xd0\xbc\x22\x87\x21\xe5\xe1\xda\x25\x66\x3c\x1c\x08\x83\x20\xae\x84\xcc\xe3\x79\x21\x9d\x6d\x8e\x1e\x0b\xc2\xfa\xd3\xc1\xe0\x95\xd4\x95\x0d\xc7\x04\x7c\xf9\x32\xee\x3f\x5f\x9d\xfd\xcb\x17\x40\x97\xee\xcd\x21\x25\x6f\xf5\xa0\x3d\x09\x54\x51\x88\x92\xf7\x83\x7e\xf1\xee\x80\xd3\x20\xfc\xdb\xc8\xfd\xf7\x90\x4c\xe2\xc7\xeb\xd2\x67\xd4\xcd\xac\xc3\x32\x10\x67\xf4\xc6\xbd\x68\x4c\xa6\x00\x81\xbd\x6b\xda\xc8\x36\x5d\x63\x20\x6a\x01\x00\xc0\x43\x7b\x8b\xd8\xed\x05\xb5\x8f\x48\xa6\xce\x1d\x1c\x47\x0c\xb4\xc9\x7e\x37\x17\x1e\x8f\x2e\xd1\xe6\xa1\x26\x5b\x67\xdf\xb1\x29\x1b\x40\xec\xa4\x1e\x36\x91\x2d\xeb\x4f\x49\xaf\xe7\x91\xd1\xed\x37\x49\xa7\x69\xb5\x23\xe4\x3b\x74\x57\x86\xee\xcd\xad\x76\x08\x00\x00\x38\x57\xd0\x0e\x19\x75\x10\xbe\xf6\x00\x60\xb7\xfa\xde\xfd\xe7\x64\x81\xba\x72\x43\xe0\xe8\x1f\x71\xbe\x5f\x54\x8e\x0b\xf1\x49\x16\x55\x41\x51\xa8\x9e\xae\xce\x84\xe7\xcf\x7f\xa9\xa3\x28\xdc\x26\x45\x41\x76\x3a\x06\x93\xa8\x39\x2f\x40\x2a\x06\x38\xb9\xef\x4d\x23\x11\x7f\x6b\x14\xfc\x75\x89\xca\x5b\xf3\x91\x8f\xba\x69\x30\x4c\xb2\x21\xcf\xc4\x50\x4c\xcd\xd0\x5f\x23\x47\xb9\x52\x1c\x22\x9d\x8b\xdc\xe2\x28\xe6\xf5\x7d\xfe\x0c\x0b\x07\x47\xc2\x69\xd9\xa8\xf6\xaf\x5e\x3f\xfd\xfe\x6f\xac\xdf\x1f\xc3\x43\xf8\xf2\x65\xca\xf6\xa3\x74\x61\x3c\x9f\x7c\x15\x87\xe9\x22\xcd\x5e\xca\xb2\xc4\xec\x9e\xd1\x34\x30\xe3\x64\x28\xfd\x71\x2c\x09\x17\xf5\x6d\xf2\x51\x4c\x86\x3e\x88\xd2\x88\xf3\xe8\x79\xb0\xd5\x8c\x19\xaa\xf5\xef\xe7\x42\x9d\xf8\xc3\xa5\xb5\xd6\xf8\x44\xcf\x40\x57\x2e\xb9\x0f\x99\x3c\x88\x79\xf6\x61\x1b\x0f\xf7\x3e\x7c\xe3\x7b\xee\xc7\x38\x03\x57\xbd\x9b\x59\x7e\xe5\x6c\xe2\x71\x24\x54\xe6\x8d\x51\x3e\xeb\xb0\xf4\x11\xfb\x41\xac\x33\x08\x51\x03\xd8\x85\xea\x9e\xd8\x8d\x02\xb4\xde\x3d\x9d\x31\xa5\xa9\xd4\x30\x65\xf8\x9c\x5a\x42\x86\x39\x9f\xf3\x2b\x49\xd8\x3d\xed\x97\x77\xe5\x1a\x0d\x82\x28\xcb\x5c\x06\xcb\x5d\x01\x0a\x93\x4b\x34\x2d\x65\x52\xcf\xae\x29\xe6\xa7\x40\x45\xab\xca\x60\x56\x39\xa6\xc3\x59\xdd\xf2\xe4\x18\xc8\x07\x0d\xd2\xeb\xdf\xeb\xd4\xdc\x98\xea\x40\x16\xaa\x85\xaa\x6c\xa0\xf4\x76\x78\xab\xea\x32\x80\x06\x0b\x7d\x85\x59\x5b\xcf\xb2\xa2\xd8\x8e\xc1\x6a\x10\x8c\x25\x92\x1c\x0c\x2a\x3b\xd5\x04\xa7\xf6\x13\x05\x6c\xc0\x42\xa0\xb9\x43\x6f\x3c\x1e\xf6\x72\xfb\xa3\xee\x18\xb8\x92\xa8\xc6\x76\x93\x96\x04\x1c\x12\x9b\x1c\x76\x49\x5d\x18\x84\x4b\x2c\xdd\x1d\x5c\x1a\x3b\x44\xe6\x2f\x2d\x2c\x77\xf2\xc1\x0e\x01\xb9\x97\x68\x24\x7c\x37\xf4\x17\xf6\x68\xf4\xf0\xdb\x62\x34\x50\x4a\x7a\xca\xbb\x95\x78\xbc\x8b\x11\x7e\xc3\x56\x6e\x2d\x1f\x82\xb4\x55\xca\xe9\x67\x72\x70\x4b\x11\xb1\x65\xea\x0d\x8a\x68\x0f\x9e\x97\xad\x9b\xda\xb7\xef\x5b\x96\x4c\x1b\x5b\x2b\x99\x60\x70\xe0\xe6\x2d\xd5\xd0\xf0\x31\xb0\xc8\xf5\x8c\x3c\x67\xa5\xce\xeb\x42\x9b\x72\x29\x53\x90\xb4\x13\x45\xaf\x50\x30\xcf\xa1\xac\x66\xb9\x4c\xf3\xba\x03\x15\x43\xb9\x87\xdb\x60\x5b\xed\xc5\x4e\x32\xde\x66\x28\x0c\xf0\xaf\x3a\x53\x0f\x74\xae\x3a\x53\x43\x2e\xb9\xf4\x8e\x78\x55\xcf\x5d\xa8\x96\x71\x01\x7b\x34\x98\x6c\x3c\xe5\x02\x9c\x11\xca\x4a\x54\xce\xd3\x77\x02\x7f\x0d\xe5\x46\xd2\x8d\x57\x5f\xfa\x03\x2e\x8e\x50\x29\x27\xf3\x76\x6c\x96\xc4\x98\x59\xd0\x3c\x6c\xcb\x8b\x06\x05\x39\x49\x36\xb1\xc6\x2e\xbc\x03\x00\x90\x2f\x44\xcf\xe7\x9b\x1b\xac\xe0\xe1\xcf\xbe\x7d\x23\x09\xa4\xea\x4b\x82\x19\xba\x6b\x44\x05\xee\x5a\x83\x70\x74\x1e\x34\xf5\x31\xa3\x87\xdf\xda\xad\xa5\x08\x83\x8e\xee\x42\x7c\x7a\x1c\xc6\x1d\x0c\xf4\x0f\x6d\x9f\x55\x11\xa6\xaa\x62\x86\x06\xf4\x9c\xe5\x12\xed\x5e\x6c\xd8\xb5\x92\x9a\xad\x98\x21\x05\xd9\x7c\xcd\xe5\x6b\x95\x62\xdc\x82\x8e\x8e\xbf\x49\xbe\x6d\x5b\xb9\x4f\xdc\x3d\x03\xa9\xdc\xc3\x07\x3b\x31\x24\x95\xc3\x05\x6e\x8f\xb1\x6c\xd1\x6d\x6e\x96\x53\x6e\x11\x0b\x14\x20\xf2\x89\x77\xd6\xb3\x7d\x93\xf2\xca\x94\x59\x62\x6a\x83\x4e\x20\x7c\xf5\x5b\xe5\x53\x97\xae\xb4\xcc\xe0\xda\x48\x2e\x36\x08\xc5\x7d\x95\x3a\x29\x84\xb1\x4b\x2a\x63\x33\xc1\x71\xe0\x93\x98\x38\xa9\xa7\x14\xc6\x22\xa4\x68\xd8\xdd\x13\x32\x35\x7d\xd2\x23\x0d\xa2\x3b\xdc\x46\xf9\x30\xfe\x48\xc9\xf4\xb5\xb2\x32\xc3\x26\x65\x59\x94\xa5\xd1\x22\x5d\x82\xe4\xb4\x49\xd1\x49\xb4\xf5\x09\xb2\xa9\x50\x3e\x27\x56\x5c\x35\xf9\xa0\xc1\x55\x8d\x60\x49\xe4\xff\xdd\x6a\x15\x7d\x92\x16\x64\x04\x72\x86\xa9\x2e\x62\x6a\xa7\xae\x6c\x53\x71\x18\x43\x23\xbc\x00\xc3\x29\x94\x85\x5c\x2c\x1d\x90\x3f\xcf\x4a\xb7\x0a\x58\x37\x6f\x28\x9e\xe9\xdc\x24\xce\xa0\x40\x5a\x5b\xe1\x5d\xf8\x7a\x5b\xb1\xea\x86\xed\xee\x78\x79\x44\x59\x36\x39\x7d\x01\x5c\x4d\x21\x1f\x29\x72\x30\x58\xea\x71\x5c\x73\x93\x3c\xc6\xc9\xaa\x06\x53\x54\xee\xae\x1c\x9e\x2e\x85\x71\x83\xa1\x7e\x42\xad\x23\x57\x67\xd2\x60\xea\xb4\xa9\x9b\x3c\x36\xff\xb6\x1f\x03\xe4\x52\xcd\x0c\xa3\x57\x78\x4a\x59\xc3\x76\xca\xdb\x39\x6d\xda\x9c\xf0\xd3\x13\x1e\x3e\xa9\x45\x91\x4f\x13\xb8\xa8\x66\x3c\xa0\x6d\x4a\xee\x28\xca\xa8\x4a\x91\x76\x22\x40\x53\xdf\x64\xba\x01\x98\x3b\x0b\x40\x4a\x91\x11\x2a\x1b\x8e\x20\xdf\x3e\xa2\xa8\xf5\x2b\x86\x81\xe0\x88\xdf\x3a\x0b\xc2\x2c\x2a\x3a\xe7\xed\x31\x38\x1d\x23\x43\x49\xc8\x76\x57\xda\x81\xa9\x54\x08\x82\x2e\x31\xcf\xb7\xad\x64\x80\x03\x6d\xa8\x1d\x3f\xc0\x61\x31\xa8\xfe\xaf\x9f\xa8\xd3\x96\xff\x55\x36\x18\x5c\x21\xd5\x50\xcf\x61\x4a\xe9\x39\xa9\xcb\xe1\x5a\x48\x07\x93\xc9\x5c\x9b\xe9\x19\x4c\x9b\x69\x1e\xf9\x2a\x5d\x7e\xdd\x8a\x81\x1b\xc5\x83\x23\x6e\x36\x0a\x74\xe2\x4b\xf1\xe0\x68\x75\x98\x47\xcf\xc8\xfc\x9d\x86\xc2\x4d\x8e\x8e\x85\xa1\x8e\x7d\xdd\x2f\x89\x23\x2a\x4b\x7a\xf4\x39\xf1\xcf\x13\xb6\x06\xbe\x3c\x7a\x53\x29\xb2\x9b\x56\xe1\x58\x57\xf8\xc5\x16\xbc\xab\x4c\x48\x1a\x97\x57\xa8\x42\xd8\xeb\x88\xa7\xad\xfd\x2f\x0e\x86\xeb\x42\x3a\x87\xd9\x71\xab\xb0\x88\x76\x51\xe3\x9e\x6d\xca\xd5\xdd\x42\x3a\x0c\xe5\xd7\xbd\x6a\xd3\xc3\xbb\x53\xba\x9a\xcb\xc5\xb6\x3d\x1d\x1a\x14\xbb\xe5\xc4\x37\x4b\x45\xe7\x72\x01\x1c\x1a\xb5\xa1\xcc\xde\x9b\x3b\xd5\x82\xd8\xc1\x82\xa4\x43\x90\x0e\x26\x5f\x57\xee\xd3\xb1\xd1\x47\x97\x27\xa1\x48\x8a\x0f\x1e\x2a\x4e\x07\xd9\x64\x89\xec\x16\x06\x3b\x5c\x7a\x4d\x22\xed\x2d\xc4\x41\xe8\x11\x05\xc2\x86\xb4\xdf\x46\x00\x34\xf5\xec\x3e\x90\xdd\x0f\x91\xfa\xb2\x3d\x63\x5d\xa7\x6b\x34\x06\x75\x76\x67\x59\x97\x69\x85\x67\x3b\x07\xd9\x55\x46\x36\xf7\x61\xc6\x73\x9d\xcb\xb4\x1e\x2e\x22\x9e\x75\xbb\x35\xb1\x10\xd2\xfd\x05\x28\xad\x26\x3f\xa1\x21\x24\x49\x5a\x7b\xd6\x41\x61\x2b\x6e\xfd\x6d\x01\x67\x80\x92\xb9\x7a\xfa\x8c\xb4\xc1\x29\x1c\x75\x94\x06\x4e\xa3\x9e\xb2\x75\x31\x8d\x15\x2e\xdc\xcf\x06\x32\xb9\x61\x47\x8c\x61\xa6\x2b\xd5\x44\x45\xb9\x2b\x94\x1e\xc8\x18\xbe\x8c\xf6\xba\x9e\x37\x3a\xd3\xdd\xd9\x91\x75\xd9\xbb\xef\x46\x74\xe1\xbc\xda\x11\xe1\x19\x04\xd3\x25\x6b\x96\xf2\x27\x71\x2b\xe9\xff\xa2\xdb\x6b\xa3\xee\x70\xd9\x6f\xb5\x5d\x87\xe0\xd2\x21\x51\xdb\x13\xaa\x00\xba\xa1\x4b\xf4\xde\x9e\xf4\x06\x8e\xba\xc5\x73\xae\x8d\x8f\xe5\x4f\x4a\x47\x17\xa9\x0f\xf3\x35\x43\xdd\x99\xa5\x74\xdf\x65\xb0\x8f\x76\x18\x87\xd8\x5f\x45\x04\xad\xf0\xce\x0b\xe1\x4c\x9b\xc1\xf0\xbf\xe6\xe6\x3e\x72\x1a\x93\xc6\xb2\x0e\x8f\xb4\x12\x0e\x04\x94\x92\xeb\x1e\x9d\x9c\x8b\x74\xab\xc6\x36\x34\xa4\x79\x89\xf5\xad\xc2\xaf\x2f\xb0\xee\xc9\x67\xae\x78\x6a\x32\xac\x9b\x34\xa3\x6e\xaa\x35\x83\xde\xd0\x49\xc7\x29\x9f\x9c\x4b\xaa\x06\xfa\xb7\x17\x58\xff\x3b\xbb\xe0\x07\xc5\x92\x76\x60\x1f\x76\xf8\x6d\xd6\xaf\x2a\x94\xd3\x45\x79\xe8\x0f\xd6\x1f\x44\x39\x65\x29\xe8\x93\xb0\xee\x0b\xbe\x21\x15\xdf\x13\xda\x98\xed\xef\xd7\x17\x01\xdf\xea\x90\x26\xfb\x40\x2c\x70\x30\xa9\x06\xf4\x10\x17\xc5\xbe\x4c\x05\x63\x5f\xa6\xd6\x3c\xf3\x12\x08\x58\x81\xa3\x3a\x44\x7f\x07\x88\x7f\xe7\x84\x99\x89\x3c\x4f\x62\xed\x61\xc3\xb3\xdd\xac\xcd\x31\xdf\xd3\xc6\x8a\xae\xcc\x73\xce\xe9\xcf\xaf\x30\xa4\x0c\xfa\x71\x62\x59\xa4\x91\x19\x76\x2b\x62\x1a\x8b\x39\x74\xca\xda\x19\x08\xd4\x3b\x8b\xa9\x26\x27\xe7\xec\xde\x46\x7a\x26\xf3\xe1\x7b\xd0\xd5\x8a\xfa\xb9\xa3\x47\xb4\x09\xae\x63\xef\xc5\xd7\x76\x1a\x76\xe4\xd8\x07\x3c\x5a\x6e\xfd\xa6\x14\x06\x95\xfb\xa6\x39\xfb\x42\x19\xb3\xc3\x7e\x32\x23\x8f\x1f\x2f\x72\x2a\x75\x59\xf1\xac\x3c\x42\xba\x94\x79\xf6\x4d\x93\x82\x97\x90\x5f\x26\x69\x12\xee\xed\xdd\x91\xe4\xd2\x25\x0e\xf7\xa8\x9d\xfb\xf6\x20\x4c\xf7\x48\xd4\x95\x6b\x7d\x31\xab\x07\x68\x7b\xbe\xfa\x8b\x6b\xd8\xdf\x19\x4e\xb8\x86\x9a\x1a\x04\xad\xe4\x64\xc5\x50\x97\xb0\x31\xd0\x22\x53\x28\xd0\x2c\x30\x82\x0e\x6c\xe5\x94\x6b\xe7\xfe\x65\x2c\xd5\x41\x11\x43\x18\x68\xd2\xd2\x09\x74\x76\x37\xb0\xfb\x1b\x26\x4b\x0c\x37\xa3\xf9\x52\xee\xa8\xc9\x32\xcd\x45\x72\xf4\xb1\x44\x6e\x1c\x63\x02\x31\xd5\x48\x65\x9d\xa3\xe6\x9e\xee\x37\x41\x75\xb5\x8b\x29\x6f\xb5\x03\x00\x00\xf3\xfb\x1e\x70\xc0\xd1\x7d\xbb\xf1\x06\x9c\x9a\xb7\x18\x70\xd0\x09\x74\xdf\xc4\xa9\xb3\xbb\x8b\x65\x9d\x5d\xf0\x6d\x34\x7a\xb8\xed\x7a\xde\xf6\xe9\x67\x2d\x64\x90\x8b\x19\xe6\xe1\x7e\x9b\x26\xdf\x73\x2a\xca\xf2\x51\x2a\xac\x15\x2a\x33\x62\x1c\x85\xcb\x23\x52\x8a\xc8\xfc\x60\x6d\x08\xde\x76\xb5\xbf\x4e\x46\xb4\x54\x1d\xf3\xd6\x78\x5f\x4b\xe0\x03\x91\x91\xc9\x95\x91\xf3\xc4\x0b\xb0\x59\xcd\x27\xc6\x71\xec\x14\xa7\x6a\x0b\x07\xbd\xd4\x2b\x82\xb0\xa2\x36\x11\xd8\x3b\x9f\x95\xcd\x0d\x97\x67\xff\x7c\x72\xae\xa9\x04\x38\xdf\x52\x7e\xb6\xab\x2e\xa0\xed\x4b\xe3\x65\xb6\xaf\x98\xf8\xfa\x1e\xd0\xf3\x76\xb2\xee\xf1\x72\xc3\x99\xc3\x8e\x99\xf6\xca\xc1\xe0\x0e\xa3\x2d\x0e\x16\x4d\x72\x67\x1b\x98\xaf\xe5\x31\x17\x32\x43\xbe\xc4\xf0\x16\x2b\xee\xf5\x0b\x77\x61\x44\x13\x1f\x2a\x8b\x61\xe8\x09\x9f\xc3\x3e\x8d\x82\x0f\xd7\x17\xef\x9e\xbe\x3e\xb4\xa0\xaf\x43\x64\x05\x0a\xa1\x04\x97\x07\x74\x8a\xe3\xc3\x15\xa3\xbe\xb3\x5b\x1a\xc4\x09\xd5\x53\xf3\x39\x7b\xd3\xef\x33\x5d\x81\x66\x0a\x73\x14\x1c\xd3\x58\x84\x8b\x36\x7d\xa0\x8d\xe7\xb9\x3b\xce\x2a\x93\x0f\xc6\x13\x29\xc3\x7a\xde\xdd\xd7\xde\x4d\xaa\x31\x62\x7b\xfe\xfa\xe2\x2d\x2b\x5b\x51\x5e\xc4\xf2\xcc\xa2\x9e\xf8\x7e\x7c\xc1\xf1\xc4\xd6\xd6\x61\x91\xd8\xab\xf4\xc4\x54\x6a\x9a\x50\xae\x80\x54\xbe\xf9\xd4\x5f\xc6\x91\xd7\x4d\x3d\x19\xd9\xfe\x69\x5e\x59\x87\x66\xe2\x15\x76\xeb\x6f\x82\xe0\x48\x19\xf2\x56\x34\xe8\xf7\x4e\x41\x7f\x07\x0b\xc3\x16\x47\x09\x70\xbf\x7b\xf3\xf2\xee\x36\xbf\xe7\x80\x5b\xe9\xbf\x3f\x36\x5d\xfa\x92\x76\x55\xc7\xf3\x01\x96\x15\xdd\x4e\xda\x61\xaa\x9d\xc7\x87\x41\x5b\xe5\x1c\x27\xfc\xaf\xc7\x3f\xbc\x6c\xac\x0d\xdb\x8b\xad\xb5\x4c\x7c\x7f\x91\x94\x6b\xa1\xdc\xf7\xc6\xdc\xd5\xa5\xb5\xf3\x04\xfe\x19\x32\x6d\x98\x82\x3b\x99\x36\x05\x0a\x5b\x99\x6e\xaa\x95\x75\xe1\xd2\x83\xed\x41\xec\xc1\xc9\x8b\x91\x67\x94\x76\xec\x96\xfd\x39\xf3\x72\x36\x1f\x1c\x8d\xad\xb1\x7f\x81\xcc\x56\xc0\xfa\x7b\x11\x27\xf3\x2c\x10\x33\xf3\x4d\x7b\xa1\x8b\xd3\x9e\x66\x1b\xb0\x60\xc5\x38\x5c\x75\x17\xb2\xfe\x40\x0f\x3a\xd7\xc6\xc4\x4d\xa3\xec\x99\xe4\x36\x59\x44\x55\xb9\x30\x22\xa3\xa3\xf8\x99\xd1\xc5\x8e\x74\xa2\x77\xbd\xc6\xbc\x18\x1f\xd1\x5f\xc9\x21\xb2\xed\xad\xc2\x7e\x7c\x6c\x2e\xef\xb9\xa7\x6c\xa3\xaf\x17\x99\x7d\xbd\xc8\xec\xeb\x45\x66\x5f\x2f\x32\xfb\x7a\x91\xd9\xd7\x8b\xcc\xee\x7c\x91\xd9\xcf\x71\xf7\xf8\xed\xae\x33\x1b\xa0\x6c\xee\xb8\xd2\xec\xeb\xa5\x66\x5f\x2f\x35\xfb\x67\xba\xd4\xec\x3e\xaf\xd3\xff\x95\x5e\x6d\x76\xc7\xc4\xf3\x5f\xe1\x05\x67\x03\x57\xb4\xe5\x92\xb3\x5f\xed\x35\x67\x83\x12\xfd\x07\x5c\x75\xf6\xff\xe7\xb2\xb3\x01\x18\xdb\x78\xe1\xd9\xaf\xf0\xca\xb3\x9f\xcb\xdb\x70\x75\xeb\xcf\xfd\x6c\x98\x68\xdd\x07\x1e\xb6\x7e\xc3\x88\xdb\xf7\xbe\x62\x14\xef\x72\xbf\xf3\x67\x8c\xda\xaf\xc7\xec\xfe\x12\x51\x68\x18\x0f\xc3\xe8\x57\xaf\xf2\xc6\xb3\xd4\xb1\x79\x56\x3e\x31\xd4\x5c\xee\x56\x37\x87\x6f\xb8\x19\x9e\x0d\x98\xd1\x8f\xd4\x73\xd4\x82\x03\xd2\xc6\x1c\x59\xb9\x7e\x24\xce\xbe\xb4\x6d\x9e\x7e\xea\x3f\x33\x24\x6c\x9b\xb0\x4b\xee\xda\x26\x77\xe1\x0a\x8d\x9c\xd7\xd3\x7d\xfd\x14\xa3\x06\x01\xad\x87\x22\x43\xc7\x7e\x32\x52\x80\xb5\x42\x10\xc1\x25\x11\x14\x0c\x66\x46\xd7\x6e\x11\xcb\x07\xd2\x28\xa3\x2d\xef\xdd\x13\x6f\x83\x7a\x16\x2d\x17\xe5\xc8\x1e\xf2\x36\xa0\x77\xa5\x42\x65\x69\x70\xe0\x3b\x45\x8c\xa8\x41\xb8\xce\x27\x12\x28\x1b\x18\x62\x32\x70\xbb\x9f\x09\xf4\x15\x31\x22\x46\x78\xa6\x75\xa0\x26\x3f\xe1\x67\x00\x00\x38\x39\x81\x37\xcd\x77\xc8\x3a\xf4\xe5\xcd\x1d\x56\x2b\x60\xae\xf5\xa1\xed\xaf\x29\x89\x9d\x5f\x28\x8a\x24\xac\x01\x21\x3a\x5a\xce\xe0\xc3\xe8\x71\xac\x0c\xfb\x30\x1a\xc3\x87\xd1\xb9\xd1\x0b\xce\x47\x56\x0b\x7a\x20\x54\x06\x1f\x46\x4f\x91\x7d\x4c\xd9\x87\x51\x1c\xfa\x5f\x39\xa0\xff\x03\xc5\x1c\x5e\x60\xfd\x88\x07\xec\xbd\xba\x08\x95\x96\x8f\x38\x2e\xd1\xbc\x23\x8f\x16\x5d\xee\xf3\xa8\x10\x65\xef\xe1\x0f\xa2\xec\x0d\xd4\xa1\xeb\xf7\x1f\x0b\x74\xe2\xea\x34\x69\x9e\xf9\x74\xeb\xb3\x0f\xa3\x76\x4d\x63\x8a\x00\x51\x4d\x50\xfd\x61\x04\x3d\x08\xce\x3e\x8c\x18\x86\xf8\x3c\x02\x7d\xf6\x61\x44\xb3\xd1\x63\xa3\x9d\x9e\x55\xf3\xb3\x0f\xa3\x59\x4d\x69\x59\xa7\x63\x83\xe5\x98\x24\xda\xa3\x76\x86\x0f\xa3\x29\x7c\x50\x11\x68\x9f\x02\x1e\x0c\xfc\xb5\x77\x87\xed\x32\x79\x72\x61\xdd\x5b\xce\x1a\x8d\x1f\x73\x1c\x24\xca\x6f\x76\x8b\x6e\x68\x7a\xc3\xde\xde\xfe\xb7\x66\x42\x6a\xaa\xf3\xdf\x17\x64\x2f\xa6\x56\x4d\x22\xbc\xd3\x31\x9f\x3d\xaa\xbd\x4d\xf9\x6a\xf3\xa5\x29\x36\x80\xf3\x9a\x8b\x84\x5a\x6e\xf3\x57\xce\x91\xf3\x60\xde\x58\xac\x4a\x3b\xb8\x24\xaa\x1b\xaf\xde\xcf\xc6\x70\x35\x23\x12\xb7\x31\xee\xe2\x30\xd4\x99\xcc\x9b\x92\x6f\xc5\xdb\x64\xc3\xc5\x0a\x2c\xb2\xf2\x27\x34\xe2\xbe\xe7\x66\x81\xd6\x8a\xc5\x30\x84\x87\xb6\x0c\x21\x2c\xab\x22\x24\x88\x13\x9c\xed\x3b\x95\x49\xf2\x42\xa8\x45\x23\x7c\xc4\x4c\x57\x21\x90\xd5\xe0\x3f\xa0\xb8\x10\x35\xcc\x10\x84\x02\x26\xd8\x1d\x97\xc3\x14\xe2\xd3\x4b\x54\x0b\xb7\x3c\x83\x87\x0f\xfe\xf0\xfb\x3f\xee\xbb\xe6\xe1\x1f\x32\xe9\x2d\xff\x66\xb7\xce\xc7\x11\x79\x7d\xc9\x9a\xaf\x93\x04\xe3\xad\x47\x87\xd7\xc2\x82\x45\x07\x33\x61\x31\x83\xaa\x24\x7c\xf4\x9d\xa9\x72\xbe\x7e\x30\xd9\x48\xb8\xbc\x86\xd3\x07\x63\xae\x8b\xe7\xa9\x6f\xc8\xb6\xf7\x9f\x3e\xae\xf9\xa2\x0a\x48\x0b\x7f\x1a\xaf\xc0\x23\x39\x17\x8a\x1d\xcb\xc2\xa1\xb7\x07\x0d\xfa\xb3\x22\x18\xa6\x6b\xce\x8a\xdd\x37\x89\x76\xea\x04\x7f\xff\xdb\x4d\x9b\xea\x4b\x32\xcf\xe0\xdb\xad\xdb\xb9\xad\x88\xd0\xa0\xb0\x03\xf7\xd0\x37\x6d\x0f\x48\x41\xc2\x69\x61\x44\x41\xfe\xfb\xb4\xbd\x7c\xce\x74\x09\x39\x7c\xa6\x8f\x3a\xc6\xa2\x95\x06\x77\x87\x36\x48\x9b\x0e\x69\x9f\x7b\x67\xa4\xf1\x41\xfc\xa8\x95\x37\x5d\x82\xc3\x98\x68\xdf\x6b\x4c\x54\xf9\x82\xe4\x0a\x8e\x61\x43\xfe\x0c\x29\x0a\xca\xe0\x88\x75\x32\xd1\x0c\xf6\x07\x51\xbc\xe7\xb0\xb9\x0e\xce\xbb\x56\x59\x91\x0e\x49\xd8\xb0\xa8\x84\x11\xca\xa1\x77\xd4\x79\x2d\x86\xdb\x76\x04\x9b\x68\xbf\xb9\x19\x79\x0f\xde\x36\x73\x31\x88\xc1\x1d\xcd\xfc\x39\x80\x31\x4f\xbf\x7d\xb0\x65\xa7\x9b\x56\x1b\x9a\x94\xc2\x39\x34\xea\x0c\xfe\xe7\xfd\xe3\xc9\x7f\x8b\xc9\x4f\x1f\x8f\xc2\x1f\xdf\x4e\xfe\xf4\xb7\xf1\xd9\xc7\x6f\x3a\x3f\x3f\x1e\x7f\xf7\x2f\xfb\x8a\x80\xed\x9f\x30\xeb\x91\x8c\x5d\xff\xe1\xb2\x71\xfc\x4a\x1c\xe9\x80\x63\xe0\xaa\xa8\x31\xbc\x53\x2c\xf4\x37\x21\x6a\xdb\xf5\xaf\x93\xa0\x4e\x6e\x7e\xcd\x73\x6c\x7e\x1f\xe6\xde\x17\x25\x83\xaf\x24\xa4\x86\xb4\xf0\x8e\xfc\xe8\x7c\xbb\x15\x58\x8e\x91\x36\x96\x04\xcd\x8e\x7d\xaa\xcd\x7b\xaf\x52\xfe\x20\x54\x0d\xad\xb0\xf2\x7a\xd8\x2a\x25\xfb\xc8\x86\x48\x8d\xb6\xb6\xcd\x1a\x62\xef\x1a\x34\xca\x9a\x17\x81\x31\x36\x26\xcc\x4c\x3a\x23\x4c\xdd\x42\x67\x3b\xf7\xf7\xce\xab\x1c\x8e\x2c\x22\x24\x4a\x67\x78\x53\x66\x1e\x7b\xc9\x18\xaf\x7f\x76\x1a\x32\xa4\xb2\xae\x5c\xa6\x4d\xe8\xc9\x38\xf6\xd9\xfb\x54\x80\x05\x7e\x02\xd9\xe6\x49\x49\x0b\x47\x99\xb2\xa7\xa7\x0f\x1e\x5e\x54\xb3\x4c\x17\x42\xaa\x67\x85\x3b\x39\xfe\xee\x88\xbe\x60\xc5\xee\x38\xf2\x43\x3c\x2b\xdc\xf1\x80\x43\xee\xf4\xf7\x3b\xf9\xe4\xe8\xbd\xe7\x86\x8f\x47\xef\x27\xe1\xaf\x6f\xe2\xa3\xe3\xef\x8e\x3e\x24\x5b\xdf\x1f\x7f\x43\xa0\x75\x78\xec\xe3\xfb\x49\xcb\x60\xc9\xc7\x6f\x8e\xbf\xeb\xbc\x3b\xde\x93\xdd\xb6\x7f\xd6\xea\xa6\x1a\xb7\xb6\x59\x50\x30\xd6\xbe\xf3\xc2\x79\xed\x2b\xbf\xc5\x6b\x5f\x6d\xf8\x44\xdf\x5e\xc6\xf7\xda\x4e\x37\x1e\xfa\xc3\xb8\x93\x88\x66\x9d\xa6\xd8\x6e\xf7\x49\x35\xbb\x91\x1f\x17\x84\x15\x7c\xfe\x72\xd0\xca\x2d\xaf\x23\x7a\x72\xea\x7d\xaf\x7c\x34\xea\x7d\x80\x9c\x7f\x76\x8c\x68\x78\xff\xf1\x00\x42\x9a\x6c\x8c\xa1\xf3\xc3\xff\x1d\x00\x66\x0f\x96\xe7\xe2\x7d\x00\x00")
 
 func configCrdsKudoDev_operatorversionsYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -48,7 +48,8 @@ type certManagerVersion struct {
 }
 
 const (
-	instanceAdmissionWebHookName = "kudo-manager-instance-admission-webhook-config"
+	instanceAdmissionWebHookName        = "kudo-manager-instance-admission-webhook-config"
+	operatorVersionAdmissionWebHookName = "kudo-manager-operatorversion-admission-webhook-config"
 )
 
 var (
@@ -124,11 +125,14 @@ func (k *KudoWebHook) verifyWithCertManager(client *kube.Client, result *verifie
 	if err := validateAdmissionWebhookInstallation(client.KubeClient.AdmissionregistrationV1beta1(), instanceAdmissionWebhookCertManager(k.opts.Namespace, k.certManagerGroup), result); err != nil {
 		return err
 	}
+	if err := validateValidatingWebhookInstallation(client.KubeClient.AdmissionregistrationV1beta1(), operatorVersionAdmissionWebhookCertManager(k.opts.Namespace, k.certManagerGroup), result); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (k *KudoWebHook) verifyWithSelfSignedCA(client *kube.Client, result *verifier.Result) error {
-	iaw, s, err := k.resourcesWithSelfSignedCA()
+	iaw, ovaw, s, err := k.resourcesWithSelfSignedCA()
 	if err != nil {
 		return nil
 	}
@@ -136,6 +140,9 @@ func (k *KudoWebHook) verifyWithSelfSignedCA(client *kube.Client, result *verifi
 	if err := validateAdmissionWebhookInstallation(client.KubeClient.AdmissionregistrationV1beta1(), *iaw, result); err != nil {
 		return err
 	}
+	if err := validateValidatingWebhookInstallation(client.KubeClient.AdmissionregistrationV1beta1(), *ovaw, result); err != nil {
+		return err
+	}
 	if err := validateWebhookSecretInstallation(client.KubeClient, *s, result); err != nil {
 		return err
 	}
@@ -152,11 +159,14 @@ func (k *KudoWebHook) installWithCertManager(client *kube.Client) error {
 	if err := installAdmissionWebhook(client.KubeClient.AdmissionregistrationV1beta1(), instanceAdmissionWebhookCertManager(k.opts.Namespace, k.certManagerGroup)); err != nil {
 		return err
 	}
+	if err := installValidatingWebhook(client.KubeClient.AdmissionregistrationV1beta1(), operatorVersionAdmissionWebhookCertManager(k.opts.Namespace, k.certManagerGroup)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func (k *KudoWebHook) installWithSelfSignedCA(client *kube.Client) error {
-	iaw, s, err := k.resourcesWithSelfSignedCA()
+	iaw, ovaw, s, err := k.resourcesWithSelfSignedCA()
 	if err != nil {
 		return nil
 	}
@@ -164,6 +174,9 @@ func (k *KudoWebHook) installWithSelfSignedCA(client *kube.Client) error {
 	if err := installAdmissionWebhook(client.KubeClient.AdmissionregistrationV1beta1(), *iaw); err != nil {
 		return err
 	}
+	if err := installValidatingWebhook(client.KubeClient.AdmissionregistrationV1beta1(), *ovaw); err != nil {
+		return err
+	}
 
 	if err := installWebhookSecret(client.KubeClient, *s); err != nil {
 		return err
@@ -189,16 +202,23 @@ func (k *KudoWebHook) UninstallWebHook(client *kube.Client) error {
 	if err != nil {
 		return fmt.Errorf("failed to uninstall WebHook: %v", err)
 	}
+
+	ovObj := OperatorVersionAdmissionWebhook(k.opts.Namespace)
+
+	err = kubeutils.DeleteAndWait(client.CtrlClient, &ovObj)
+	if err != nil {
+		return fmt.Errorf("failed to uninstall WebHook: %v", err)
+	}
 	return nil
 }
 
 func (k *KudoWebHook) Resources() []runtime.Object {
 	if k.opts.SelfSignedWebhookCA {
-		iaw, s, err := k.resourcesWithSelfSignedCA()
+		iaw, ovaw, s, err := k.resourcesWithSelfSignedCA()
 		if err != nil {
 			panic(err)
 		}
-		return []runtime.Object{iaw, s}
+		return []runtime.Object{iaw, ovaw, s}
 
 	}
 
@@ -207,32 +227,34 @@ func (k *KudoWebHook) Resources() []runtime.Object {
 
 func (k *KudoWebHook) resourcesWithCertManager() []runtime.Object {
 	av := instanceAdmissionWebhookCertManager(k.opts.Namespace, k.certManagerGroup)
-	objs := []runtime.Object{&av}
+	ovav := operatorVersionAdmissionWebhookCertManager(k.opts.Namespace, k.certManagerGroup)
+	objs := []runtime.Object{&av, &ovav}
 	objs = append(objs, k.issuer)
 	objs = append(objs, k.certificate)
 	return objs
 }
 
-func (k *KudoWebHook) resourcesWithSelfSignedCA() (*admissionv1beta1.MutatingWebhookConfiguration, *corev1.Secret, error) {
+func (k *KudoWebHook) resourcesWithSelfSignedCA() (*admissionv1beta1.MutatingWebhookConfiguration, *admissionv1beta1.ValidatingWebhookConfiguration, *corev1.Secret, error) {
 	tinyCA, err := NewTinyCA(kudoinit.DefaultServiceName, k.opts.Namespace)
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to set up webhook CA: %v", err)
+		return nil, nil, nil, fmt.Errorf("unable to set up webhook CA: %v", err)
 	}
 
 	srvCertPair, err := tinyCA.NewServingCert()
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to set up webhook serving certs: %v", err)
+		return nil, nil, nil, fmt.Errorf("unable to set up webhook serving certs: %v", err)
 	}
 
 	srvCert, srvKey, err := srvCertPair.AsBytes()
 	if err != nil {
-		return nil, nil, fmt.Errorf("unable to marshal webhook serving certs: %v", err)
+		return nil, nil, nil, fmt.Errorf("unable to marshal webhook serving certs: %v", err)
 	}
 
 	iaw := instanceAdmissionWebhookWithCABundle(k.opts.Namespace, tinyCA.CA.CertBytes())
+	ovaw := operatorVersionAdmissionWebhookWithCABundle(k.opts.Namespace, tinyCA.CA.CertBytes())
 	ws := webhookSecret(k.opts.Namespace, srvCert, srvKey)
 
-	return &iaw, &ws, nil
+	return &iaw, &ovaw, &ws, nil
 }
 
 func (k *KudoWebHook) detectCertManagerVersion(client *kube.Client, result *verifier.Result) error {
@@ -442,6 +464,29 @@ func validateAdmissionWebhookInstallation(client clientv1beta1.MutatingWebhookCo
 	return nil
 }
 
+func installValidatingWebhook(client clientv1beta1.ValidatingWebhookConfigurationsGetter, webhook admissionv1beta1.ValidatingWebhookConfiguration) error {
+	_, err := client.ValidatingWebhookConfigurations().Create(context.TODO(), &webhook, metav1.CreateOptions{})
+	if kerrors.IsAlreadyExists(err) {
+		clog.V(4).Printf("admission webhook %v already registered", webhook.Name)
+		return nil
+	}
+	return err
+}
+
+func validateValidatingWebhookInstallation(client clientv1beta1.ValidatingWebhookConfigurationsGetter, webhook admissionv1beta1.ValidatingWebhookConfiguration, result *verifier.Result) error {
+	_, err := client.ValidatingWebhookConfigurations().Get(context.TODO(), webhook.Name, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			result.AddErrors(fmt.Sprintf("admission webhook %s is not installed", webhook.Name))
+			return nil
+		}
+		return err
+	}
+
+	clog.V(2).Printf("AdmissionWebhook %s is installed", webhook.Name)
+	return nil
+}
+
 func installWebhookSecret(client kubernetes.Interface, secret corev1.Secret) error {
 	_, err := client.CoreV1().Secrets(secret.Namespace).Create(context.TODO(), &secret, metav1.CreateOptions{})
 	if kerrors.IsAlreadyExists(err) {
@@ -524,6 +569,63 @@ func InstanceAdmissionWebhook(ns string) admissionv1beta1.MutatingWebhookConfigu
 	}
 }
 
+func operatorVersionAdmissionWebhookWithCABundle(ns string, caData []byte) admissionv1beta1.ValidatingWebhookConfiguration {
+	ovaw := OperatorVersionAdmissionWebhook(ns)
+	ovaw.Webhooks[0].ClientConfig.CABundle = caData
+	return ovaw
+}
+
+func operatorVersionAdmissionWebhookCertManager(ns string, certManagerGroup string) admissionv1beta1.ValidatingWebhookConfiguration {
+	ovaw := OperatorVersionAdmissionWebhook(ns)
+	injectCaAnnotationName := fmt.Sprintf("%s/inject-ca-from", certManagerGroup)
+	ovaw.Annotations[injectCaAnnotationName] = fmt.Sprintf("%s/kudo-webhook-server-certificate", ns)
+	return ovaw
+}
+
+// OperatorVersionAdmissionWebhook returns a ValidatingWebhookConfiguration for the operator version admission controller.
+func OperatorVersionAdmissionWebhook(ns string) admissionv1beta1.ValidatingWebhookConfiguration {
+	namespacedScope := admissionv1beta1.NamespacedScope
+	failedType := admissionv1beta1.Fail
+	equivalentType := admissionv1beta1.Equivalent
+	noSideEffects := admissionv1beta1.SideEffectClassNone
+	return admissionv1beta1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        operatorVersionAdmissionWebHookName,
+			Annotations: map[string]string{},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ValidatingWebhookConfiguration",
+			APIVersion: "admissionregistration.k8s.io/v1beta1",
+		},
+		Webhooks: []admissionv1beta1.ValidatingWebhook{
+			{
+				Name: "operatorversion-admission.kudo.dev",
+				Rules: []admissionv1beta1.RuleWithOperations{
+					{
+						Operations: []admissionv1beta1.OperationType{"CREATE", "UPDATE", "DELETE"},
+						Rule: admissionv1beta1.Rule{
+							APIGroups:   []string{"kudo.dev"},
+							APIVersions: []string{"v1beta1"},
+							Resources:   []string{"operatorversions"},
+							Scope:       &namespacedScope,
+						},
+					},
+				},
+				FailurePolicy: &failedType, // this means that the request to change an operator version would fail, if webhook is not up
+				MatchPolicy:   &equivalentType,
+				SideEffects:   &noSideEffects,
+				ClientConfig: admissionv1beta1.WebhookClientConfig{
+					Service: &admissionv1beta1.ServiceReference{
+						Name:      kudoinit.DefaultServiceName,
+						Namespace: ns,
+						Path:      convert.StringPtr("/validate-kudo-dev-v1beta1-operatorversion"),
+					},
+				},
+			},
+		},
+	}
+}
+
 func issuer(ns string, group string, apiVersion string) *unstructured.Unstructured {
 	apiString := fmt.Sprintf("%s/%s", group, apiVersion)
 	return &unstructured.Unstructured{
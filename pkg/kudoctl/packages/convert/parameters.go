@@ -41,6 +41,52 @@ func ParametersToCRDType(parameters packages.Parameters) ([]kudoapi.Parameter, e
 			Type:        parameter.Type,
 			Immutable:   parameter.Immutable,
 			Enum:        enumValues,
+			Sensitive:   parameter.Sensitive,
+		})
+	}
+
+	return result, nil
+}
+
+// ParametersFromCRDType converts the 'Parameter' defined in the KUDO API back to package parameters.
+func ParametersFromCRDType(parameters []kudoapi.Parameter) (packages.Parameters, error) {
+	result := make(packages.Parameters, 0, len(parameters))
+
+	for _, parameter := range parameters {
+		var d interface{}
+		if parameter.Default != nil {
+			var err error
+			d, err = utilconvert.UnwrapParamValue(parameter.Default, parameter.Type)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert %s default for parameter '%s': %w", parameter.Type, parameter.Name, err)
+			}
+		}
+
+		var enumValues *[]interface{}
+		if parameter.IsEnum() {
+			ev := []interface{}{}
+			for _, v := range parameter.EnumValues() {
+				v := v
+				vUnwrapped, err := utilconvert.UnwrapParamValue(&v, parameter.Type)
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert %s enum value '%s' for parameter '%s': %w", parameter.Type, v, parameter.Name, err)
+				}
+				ev = append(ev, vUnwrapped)
+			}
+			enumValues = &ev
+		}
+
+		result = append(result, packages.Parameter{
+			DisplayName: parameter.DisplayName,
+			Name:        parameter.Name,
+			Description: parameter.Description,
+			Required:    parameter.Required,
+			Default:     d,
+			Trigger:     parameter.Trigger,
+			Type:        parameter.Type,
+			Immutable:   parameter.Immutable,
+			Enum:        enumValues,
+			Sensitive:   parameter.Sensitive,
 		})
 	}
 
@@ -3,12 +3,14 @@ package convert
 import (
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
 	"github.com/kudobuilder/kudo/pkg/engine/task"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
 	"github.com/kudobuilder/kudo/pkg/util/kudo"
@@ -74,6 +76,7 @@ func FilesToResources(files *packages.Files) (*packages.Resources, error) {
 			Parameters:     parameters,
 			Plans:          files.Operator.Plans,
 			UpgradableFrom: nil,
+			HealthChecks:   files.Operator.HealthChecks,
 		},
 		Status: kudoapi.OperatorVersionStatus{},
 	}
@@ -87,6 +90,33 @@ func FilesToResources(files *packages.Files) (*packages.Resources, error) {
 	}, nil
 }
 
+// OperatorVersionToFiles converts an OperatorVersion back to the package files, e.g. to run the package verifiers on
+// an OperatorVersion that was installed in the cluster. Package-only metadata like parameter groups is not part of the
+// OperatorVersion and therefore missing in the result.
+func OperatorVersionToFiles(ov *kudoapi.OperatorVersion) (*packages.Files, error) {
+	parameters, err := ParametersFromCRDType(ov.Spec.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &packages.Files{
+		Templates: ov.Spec.Templates,
+		Operator: &packages.OperatorFile{
+			APIVersion:      packages.APIVersion,
+			Name:            ov.Spec.Operator.Name,
+			OperatorVersion: ov.Spec.Version,
+			AppVersion:      ov.Spec.AppVersion,
+			Tasks:           ov.Spec.Tasks,
+			Plans:           ov.Spec.Plans,
+			HealthChecks:    ov.Spec.HealthChecks,
+		},
+		Params: &packages.ParamsFile{
+			APIVersion: packages.APIVersion,
+			Parameters: parameters,
+		},
+	}, nil
+}
+
 func BuildInstanceResource(operatorName, appVersion, operatorVersion string) *kudoapi.Instance {
 	return &kudoapi.Instance{
 		TypeMeta: metav1.TypeMeta{
@@ -117,20 +147,57 @@ func validateTask(t kudoapi.Task, templates map[string]string) []string {
 		if len(t.Spec.Parameter) == 0 {
 			errs = append(errs, fmt.Sprintf("toggle task %s does not have parameter specified", t.Name))
 		}
+	case task.WaitTaskKind:
+		resources = t.Spec.ResourceTaskSpec.Resources
+		if _, err := task.ParseWaitCondition(t.Spec.WaitTaskSpec.Condition); err != nil {
+			errs = append(errs, fmt.Sprintf("wait task %s has an invalid condition: %v", t.Name, err))
+		}
 	case task.PipeTaskKind:
 		resources = append(resources, t.Spec.PipeTaskSpec.Pod)
 
 		if len(t.Spec.PipeTaskSpec.Pipe) == 0 {
 			errs = append(errs, fmt.Sprintf("task %s does not have pipe files specified", t.Name))
 		}
+	case task.ExecTaskKind:
+		if len(t.Spec.PodSelector) == 0 {
+			errs = append(errs, fmt.Sprintf("exec task %s does not have a pod selector specified", t.Name))
+		}
+		if len(t.Spec.Command) == 0 {
+			errs = append(errs, fmt.Sprintf("exec task %s does not have a command specified", t.Name))
+		}
 	case task.KudoOperatorTaskKind:
 		if len(t.Spec.ParameterFile) != 0 {
 			resources = append(resources, t.Spec.ParameterFile)
 		}
+	case task.HelmTaskKind:
+		if len(t.Spec.Chart) == 0 {
+			errs = append(errs, fmt.Sprintf("helm task %s does not have a chart specified", t.Name))
+		} else {
+			resources = append(resources, path.Join(t.Spec.Chart, renderer.ChartFileName))
+		}
+		if len(t.Spec.ValuesFile) != 0 {
+			resources = append(resources, t.Spec.ValuesFile)
+		}
+	case task.KustomizeTaskKind:
+		if len(t.Spec.Kustomization) == 0 {
+			errs = append(errs, fmt.Sprintf("kustomize task %s does not have a kustomization specified", t.Name))
+		} else {
+			resources = append(resources, path.Join(t.Spec.Kustomization, renderer.KustomizationFileName))
+		}
+		resources = append(resources, t.Spec.Patches...)
+	case task.ExternalTaskKind:
+		if len(t.Spec.URL) == 0 {
+			errs = append(errs, fmt.Sprintf("external task %s does not have a url specified", t.Name))
+		}
 	case task.DummyTaskKind:
 		// Nothing to validate for Dummy Task
 	default:
-		errs = append(errs, fmt.Sprintf("unknown task kind %s", t.Kind))
+		// registered task kinds are validated by their factory, see task.Register
+		if !task.IsRegistered(t.Kind) {
+			errs = append(errs, fmt.Sprintf("unknown task kind %s", t.Kind))
+		} else if _, err := task.Build(&t); err != nil {
+			errs = append(errs, err.Error())
+		}
 	}
 
 	for _, res := range resources {
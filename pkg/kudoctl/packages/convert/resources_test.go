@@ -0,0 +1,38 @@
+package convert
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
+)
+
+type backupTask struct{}
+
+func (backupTask) Run(ctx task.Context) (bool, error) { return true, nil }
+
+func TestValidateTask_RegisteredKind(t *testing.T) {
+	assert.NoError(t, task.Register("Backup", func(tt *kudoapi.Task) (task.Tasker, error) {
+		if tt.Spec.Config["bucket"] == "" {
+			return nil, fmt.Errorf("task validation error: backup task '%s' has no bucket", tt.Name)
+		}
+		return backupTask{}, nil
+	}))
+	t.Cleanup(func() { task.Unregister("Backup") })
+
+	errs := validateTask(kudoapi.Task{Name: "backup", Kind: "Backup"}, nil)
+	assert.Equal(t, []string{"task validation error: backup task 'backup' has no bucket"}, errs)
+
+	errs = validateTask(kudoapi.Task{
+		Name: "backup",
+		Kind: "Backup",
+		Spec: kudoapi.TaskSpec{ExternalTaskSpec: kudoapi.ExternalTaskSpec{Config: map[string]string{"bucket": "backups"}}},
+	}, nil)
+	assert.Empty(t, errs)
+
+	errs = validateTask(kudoapi.Task{Name: "restore", Kind: "Restore"}, nil)
+	assert.Equal(t, []string{"unknown task kind Restore"}, errs)
+}
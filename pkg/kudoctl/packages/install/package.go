@@ -19,6 +19,8 @@ type Options struct {
 	Wait *time.Duration
 	// Create the namespace for the operator package.
 	CreateNamespace bool
+	// Parameters sourced from existing Secrets and ConfigMaps.
+	ParametersFrom map[string]kudoapi.ParameterSource
 }
 
 // Package installs an operator package with parameters into a namespace.
@@ -43,6 +45,9 @@ func Package(
 		resources.OperatorVersion.Spec.Version)
 
 	applyOverrides(&resources, instanceName, namespace, parameters)
+	if options.ParametersFrom != nil {
+		resources.Instance.Spec.ParametersFrom = options.ParametersFrom
+	}
 
 	if !options.SkipInstance {
 		// If skipInstance is specified, we do not need to validate the parameters - If we do, we prevent the
@@ -73,7 +78,7 @@ func Package(
 		return nil
 	}
 
-	if err := install.Instance(client, resources.Instance); err != nil {
+	if err := install.Instance(client, resources.Instance, resources.OperatorVersion); err != nil {
 		return err
 	}
 
@@ -106,18 +111,34 @@ func applyOverrides(
 	}
 
 	if parameters != nil {
-		clog.V(3).Printf("parameters in use: %v", parameters)
+		clog.V(3).Printf("parameters in use: %v", maskSensitive(parameters, resources.OperatorVersion.Spec.Parameters))
 		resources.Instance.Spec.Parameters = parameters
 	}
 }
 
+// maskSensitive returns a copy of the parameters with the values of sensitive parameters masked
+func maskSensitive(parameters map[string]string, definitions []kudoapi.Parameter) map[string]string {
+	masked := make(map[string]string, len(parameters))
+	for n, v := range parameters {
+		masked[n] = v
+	}
+	for _, p := range definitions {
+		p := p
+		if _, ok := masked[p.Name]; ok && p.IsSensitive() {
+			masked[p.Name] = kudoapi.MaskedParameterValue
+		}
+	}
+	return masked
+}
+
 func validateParameters(instance kudoapi.Instance, parameters []kudoapi.Parameter) error {
 	missingParameters := []string{}
 
 	for _, p := range parameters {
 		if p.IsRequired() && !p.HasDefault() {
 			_, ok := instance.Spec.Parameters[p.Name]
-			if !ok {
+			_, sourced := instance.Spec.ParametersFrom[p.Name]
+			if !ok && !sourced {
 				missingParameters = append(missingParameters, p.Name)
 			}
 		}
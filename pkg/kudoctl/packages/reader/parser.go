@@ -19,6 +19,9 @@ const (
 	templateBase     = "templates"
 	templateFileName = ".*\\.yaml"
 	APIVersion       = "kudo.dev/v1beta1"
+
+	// chartPartialFileName matches the partials of Helm charts, e.g. templates/redis/templates/_helpers.tpl
+	chartPartialFileName = "^_.*\\.tpl$"
 )
 
 func newPackageFiles() packages.Files {
@@ -51,7 +54,7 @@ func parsePackageFile(filePath string, fileBytes []byte, currentPackage *package
 			os.Exit(1)
 		}
 
-		return match
+		return match || isChartPartial(dir, file)
 	}
 
 	isParametersFile := func(name string) bool {
@@ -76,8 +79,8 @@ func parsePackageFile(filePath string, fileBytes []byte, currentPackage *package
 			return fmt.Errorf("expecting supported API version %s but got %s", APIVersion, currentPackage.Operator.APIVersion)
 		}
 	case isTemplateFile(filePath):
-		pathParts := strings.Split(filePath, "templates/")
-		name := pathParts[len(pathParts)-1]
+		// keep the path relative to the templates folder, charts have a nested templates folder of their own
+		name := strings.TrimPrefix(filepath.ToSlash(filepath.Clean(filePath)), templateBase+"/")
 		currentPackage.Templates[name] = string(fileBytes)
 	case isParametersFile(filePath):
 		paramsFile, err := readParametersFile(fileBytes)
@@ -104,6 +107,15 @@ func parsePackageFile(filePath string, fileBytes []byte, currentPackage *package
 	return nil
 }
 
+// isChartPartial returns true for partials in the templates directory of a chart in the templates folder
+func isChartPartial(dir, file string) bool {
+	if !strings.Contains(filepath.ToSlash(dir), "/"+templateBase+"/") {
+		return false
+	}
+	match, err := regexp.MatchString(chartPartialFileName, file)
+	return err == nil && match
+}
+
 func readParametersFile(fileBytes []byte) (packages.ParamsFile, error) {
 	paramsFile := packages.ParamsFile{}
 	if err := yaml.Unmarshal(fileBytes, &paramsFile); err != nil {
@@ -43,6 +43,8 @@ func TestParsePackageFile(t *testing.T) {
 		{filePath: "templates/some/nested/template2.yaml", isTemplate: true, fileContent: "not-empty"},
 		{filePath: "./templates/some-template.yaml", isTemplate: true, fileContent: "not-empty"},
 		{filePath: "./templates/with/subdirectory/some-template.yaml", isTemplate: true, fileContent: "not-empty"},
+		{filePath: "templates/redis/templates/deployment.yaml", isTemplate: true, fileContent: "not-empty"},
+		{filePath: "templates/redis/templates/_helpers.tpl", isTemplate: true, fileContent: "not-empty"},
 		{filePath: "operator.yaml", isOperator: true, expectedError: errors.New("failed to parse yaml into valid operator operator.yaml")},
 	}
 
@@ -65,6 +65,7 @@ type Parameter struct {
 	Type        kudoapi.ParameterType `json:"type,omitempty"`
 	Immutable   *bool                 `json:"immutable,omitempty"`
 	Enum        *[]interface{}        `json:"enum,omitempty"`
+	Sensitive   *bool                 `json:"sensitive,omitempty"`
 
 	// The following fields are descriptive only and are not used in the OperatorVersion. They are only used on the
 	// package level and are not converted to the CRDs, as they are only used during installation of an operator and
@@ -78,6 +79,10 @@ func (p Parameter) IsImmutable() bool {
 	return p.Immutable != nil && *p.Immutable
 }
 
+func (p Parameter) IsSensitive() bool {
+	return p.Sensitive != nil && *p.Sensitive
+}
+
 func (p Parameter) IsRequired() bool {
 	return p.Required != nil && *p.Required
 }
@@ -172,4 +177,5 @@ type OperatorFile struct {
 	Tasks             []kudoapi.Task          `json:"tasks"`
 	Plans             map[string]kudoapi.Plan `json:"plans"`
 	NamespaceManifest string                  `json:"namespaceManifest,omitempty"`
+	HealthChecks      []kudoapi.HealthCheck   `json:"healthChecks,omitempty"`
 }
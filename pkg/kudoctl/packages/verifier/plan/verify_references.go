@@ -2,22 +2,26 @@ package plan
 
 import (
 	"fmt"
+	"sort"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
 )
 
 var _ packages.Verifier = &ReferenceVerifier{}
 
-// ReferenceVerifier verifies plans producing errors for plans referenced in param triggers that do not exist
-// and warnings for missing mandatory plans.
+// ReferenceVerifier verifies plans producing errors for plans referenced in param triggers or plan 'onFailure'
+// fields that do not exist, for pruning plans that don't apply all objects and warnings for missing mandatory plans.
 type ReferenceVerifier struct{}
 
 func (ReferenceVerifier) Verify(pf *packages.Files) verifier.Result {
 	res := verifier.NewResult()
 	res.Merge(plansNotDefined(pf))
 	res.Merge(hasMandatoryPlans(pf))
+	res.Merge(onFailurePlans(pf))
+	res.Merge(pruningPlans(pf))
 
 	return res
 }
@@ -48,3 +52,64 @@ func plansNotDefined(pf *packages.Files) verifier.Result {
 
 	return res
 }
+
+func onFailurePlans(pf *packages.Files) verifier.Result {
+	res := verifier.NewResult()
+	plans := pf.Operator.Plans
+
+	for name, plan := range plans {
+		if plan.OnFailure == "" {
+			continue
+		}
+		onFailure, ok := plans[plan.OnFailure]
+		switch {
+		case !ok:
+			res.AddErrors(fmt.Sprintf("plan %q used as onFailure plan of plan %q is not defined", plan.OnFailure, name))
+		case plan.OnFailure == name:
+			res.AddErrors(fmt.Sprintf("plan %q can not be its own onFailure plan", name))
+		case onFailure.OnFailure != "":
+			res.AddErrors(fmt.Sprintf("plan %q used as onFailure plan of plan %q can not define an onFailure plan itself", plan.OnFailure, name))
+		}
+	}
+
+	return res
+}
+
+// pruningPlans verifies that plans with Plan.Prune enabled reference all tasks that apply objects. A pruning plan
+// deletes the objects of the instance it didn't apply, so it would delete the objects of the tasks it doesn't reference.
+func pruningPlans(pf *packages.Files) verifier.Result {
+	res := verifier.NewResult()
+	plans := pf.Operator.Plans
+
+	names := make([]string, 0, len(plans))
+	for name := range plans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		plan := plans[name]
+		if !plan.Prune {
+			continue
+		}
+		referenced := map[string]bool{}
+		for _, ph := range plan.Phases {
+			for _, st := range ph.Steps {
+				for _, t := range st.Tasks {
+					referenced[t] = true
+				}
+			}
+		}
+		for _, t := range pf.Operator.Tasks {
+			if appliesObjects(t.Kind) && !referenced[t.Name] {
+				res.AddErrors(fmt.Sprintf("plan %q prunes objects but does not reference the %s task %q, its objects would be pruned", name, t.Kind, t.Name))
+			}
+		}
+	}
+
+	return res
+}
+
+func appliesObjects(kind string) bool {
+	return kind == task.ApplyTaskKind || kind == task.HelmTaskKind || kind == task.KustomizeTaskKind
+}
@@ -72,3 +72,97 @@ func TestPlanReferenceVerifier(t *testing.T) {
 	assert.Equal(t, 1, len(res.Errors))
 	assert.Equal(t, `plan "not-existing-plan" used in parameter "PARAM2" is not defined`, res.Errors[0])
 }
+
+func TestPlanReferenceVerifier_OnFailure(t *testing.T) {
+	tests := []struct {
+		name  string
+		plans map[string]kudoapi.Plan
+		want  []string
+	}{
+		{
+			name:  "valid onFailure plan",
+			plans: map[string]kudoapi.Plan{"deploy": {OnFailure: "rollback"}, "rollback": {}},
+		},
+		{
+			name:  "onFailure plan does not exist",
+			plans: map[string]kudoapi.Plan{"deploy": {OnFailure: "rollback"}},
+			want:  []string{`plan "rollback" used as onFailure plan of plan "deploy" is not defined`},
+		},
+		{
+			name:  "plan is its own onFailure plan",
+			plans: map[string]kudoapi.Plan{"deploy": {OnFailure: "deploy"}},
+			want:  []string{`plan "deploy" can not be its own onFailure plan`},
+		},
+		{
+			name:  "chained onFailure plans",
+			plans: map[string]kudoapi.Plan{"deploy": {OnFailure: "rollback"}, "rollback": {OnFailure: "cleanup"}, "cleanup": {}},
+			want:  []string{`plan "rollback" used as onFailure plan of plan "deploy" can not define an onFailure plan itself`},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			pf := packages.Files{
+				Operator: &packages.OperatorFile{Plans: tt.plans},
+				Params:   &packages.ParamsFile{},
+			}
+			res := ReferenceVerifier{}.Verify(&pf)
+
+			assert.Equal(t, len(tt.want), len(res.Errors))
+			for _, e := range tt.want {
+				assert.Contains(t, res.Errors, e)
+			}
+		})
+	}
+}
+
+func TestPlanReferenceVerifier_Prune(t *testing.T) {
+	tasks := []kudoapi.Task{
+		{Name: "app", Kind: "Apply"},
+		{Name: "chart", Kind: "Helm"},
+		{Name: "cleanup", Kind: "Delete"},
+	}
+	plan := func(prune bool, tasks ...string) kudoapi.Plan {
+		return kudoapi.Plan{
+			Prune:  prune,
+			Phases: []kudoapi.Phase{{Name: "main", Steps: []kudoapi.Step{{Name: "everything", Tasks: tasks}}}},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		plans map[string]kudoapi.Plan
+		want  []string
+	}{
+		{
+			name:  "pruning plan references all tasks that apply objects",
+			plans: map[string]kudoapi.Plan{"deploy": plan(true, "app", "chart")},
+		},
+		{
+			name:  "plan without pruning references a subset of the tasks",
+			plans: map[string]kudoapi.Plan{"deploy": plan(true, "app", "chart"), "backup": plan(false, "app")},
+		},
+		{
+			name:  "pruning plan misses tasks that apply objects",
+			plans: map[string]kudoapi.Plan{"deploy": plan(true, "app", "chart"), "update": plan(true, "cleanup")},
+			want: []string{
+				`plan "update" prunes objects but does not reference the Apply task "app", its objects would be pruned`,
+				`plan "update" prunes objects but does not reference the Helm task "chart", its objects would be pruned`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			pf := packages.Files{
+				Operator: &packages.OperatorFile{Tasks: tasks, Plans: tt.plans},
+				Params:   &packages.ParamsFile{},
+			}
+			res := ReferenceVerifier{}.Verify(&pf)
+
+			assert.ElementsMatch(t, tt.want, res.Errors)
+		})
+	}
+}
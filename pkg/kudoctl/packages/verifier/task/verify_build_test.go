@@ -1,11 +1,13 @@
 package task
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/task"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
 )
 
@@ -63,6 +65,16 @@ func TestTaskBasicsVerifier(t *testing.T) {
 				},
 			},
 		}, errors: []string{}, warnings: []string{}},
+		{name: "An external task without url", task: kudoapi.Task{
+			Name: "Task",
+			Kind: "External",
+			Spec: kudoapi.TaskSpec{},
+		}, errors: []string{"task validation error: external task 'Task' has no url"}, warnings: []string{}},
+		{name: "An unknown task", task: kudoapi.Task{
+			Name: "Task",
+			Kind: "Unknown",
+			Spec: kudoapi.TaskSpec{},
+		}, errors: []string{"unknown task kind Unknown"}, warnings: []string{}},
 	}
 	verifier := BuildVerifier{}
 
@@ -76,6 +88,32 @@ func TestTaskBasicsVerifier(t *testing.T) {
 	}
 }
 
+type backupTask struct{}
+
+func (backupTask) Run(ctx task.Context) (bool, error) { return true, nil }
+
+func TestBuildVerifier_RegisteredKind(t *testing.T) {
+	assert.NoError(t, task.Register("Backup", func(tt *kudoapi.Task) (task.Tasker, error) {
+		if tt.Spec.Config["bucket"] == "" {
+			return nil, fmt.Errorf("task validation error: backup task '%s' has no bucket", tt.Name)
+		}
+		return backupTask{}, nil
+	}))
+	t.Cleanup(func() { task.Unregister("Backup") })
+
+	pf := packageFilesFromWithTask(kudoapi.Task{Name: "Task", Kind: "Backup"})
+	res := BuildVerifier{}.Verify(&pf)
+	assert.Equal(t, []string{"task validation error: backup task 'Task' has no bucket"}, res.Errors)
+
+	pf = packageFilesFromWithTask(kudoapi.Task{
+		Name: "Task",
+		Kind: "Backup",
+		Spec: kudoapi.TaskSpec{ExternalTaskSpec: kudoapi.ExternalTaskSpec{Config: map[string]string{"bucket": "backups"}}},
+	})
+	res = BuildVerifier{}.Verify(&pf)
+	assert.Empty(t, res.Errors)
+}
+
 func packageFilesFromWithTask(task kudoapi.Task) packages.Files {
 	steps := []kudoapi.Step{{
 		Name:  "cat-in-hat",
@@ -30,7 +30,7 @@ func (ParametersVerifier) Verify(pf *packages.Files) verifier.Result {
 
 	implicits := renderer.NewVariableMap().WithDefaults()
 
-	nodes := getNodeMap(pf.Templates)
+	nodes := getNodeMap(templatesAndWhenExpressions(pf))
 	// additional processing errors
 	for fname, node := range nodes {
 		if node.error != nil {
@@ -47,6 +47,23 @@ func (ParametersVerifier) Verify(pf *packages.Files) verifier.Result {
 	return res
 }
 
+// templatesAndWhenExpressions returns all templates together with the 'when' expressions of phases and steps, which
+// are rendered with the same variables. Files of Helm charts are rendered with chart values and files of kustomizations
+// are not rendered at all, both are therefore omitted.
+func templatesAndWhenExpressions(pf *packages.Files) packages.Templates {
+	templates := packages.Templates{}
+	for name, t := range pf.Templates {
+		if renderer.IsChartFile(name, pf.Templates) || renderer.IsKustomizationFile(name, pf.Templates) {
+			continue
+		}
+		templates[name] = t
+	}
+	for name, when := range whenExpressions(pf) {
+		templates[name] = when
+	}
+	return templates
+}
+
 func immutableParams(pf *packages.Files) verifier.Result {
 	res := verifier.NewResult()
 	for _, p := range pf.Params.Parameters {
@@ -134,7 +151,7 @@ func paramGroups(pf *packages.Files) verifier.Result {
 func paramsDefinedNotUsed(pf *packages.Files) verifier.Result {
 	res := verifier.NewResult()
 	tparams := make(map[string]bool)
-	nodes := getNodeMap(pf.Templates)
+	nodes := getNodeMap(templatesAndWhenExpressions(pf))
 
 	for _, node := range nodes {
 		for _, tparam := range node.parameters {
@@ -163,7 +180,7 @@ func paramsNotDefined(pf *packages.Files) verifier.Result {
 	for _, param := range pf.Params.Parameters {
 		params[param.Name] = true
 	}
-	nodes := getNodeMap(pf.Templates)
+	nodes := getNodeMap(templatesAndWhenExpressions(pf))
 
 	for fname, nodes := range nodes {
 		for _, tparam := range nodes.parameters {
@@ -2,7 +2,10 @@ package template
 
 import (
 	"fmt"
+	"path"
+	"strings"
 
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
 	engtask "github.com/kudobuilder/kudo/pkg/engine/task"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
@@ -23,13 +26,35 @@ func (ReferenceVerifier) Verify(pf *packages.Files) verifier.Result {
 
 	// conflated a bit...  the loop 1) confirms that all resources are defined templates, and 2) creates a map of all resources for next verification
 	requiredTemplates := make(map[string]bool)
+	usesKustomize := false
 	for _, task := range pf.Operator.Tasks {
 		var resources []string
 		switch task.Kind {
-		case engtask.ApplyTaskKind, engtask.DeleteTaskKind, engtask.ToggleTaskKind:
+		case engtask.ApplyTaskKind, engtask.DeleteTaskKind, engtask.ToggleTaskKind, engtask.WaitTaskKind:
 			resources = task.Spec.ResourceTaskSpec.Resources
 		case engtask.PipeTaskKind:
 			resources = append(resources, task.Spec.PipeTaskSpec.Pod)
+		case engtask.HelmTaskKind:
+			// all files of the chart are used by the task
+			chart := path.Clean(task.Spec.HelmTaskSpec.Chart)
+			if _, ok := templates[path.Join(chart, renderer.ChartFileName)]; !ok {
+				res.AddErrors(fmt.Sprintf("chart %q required by %s but is not defined", task.Spec.HelmTaskSpec.Chart, task.Name))
+			}
+			for template := range templates {
+				if strings.HasPrefix(template, chart+"/") {
+					requiredTemplates[template] = true
+				}
+			}
+			if task.Spec.HelmTaskSpec.ValuesFile != "" {
+				resources = append(resources, task.Spec.HelmTaskSpec.ValuesFile)
+			}
+		case engtask.KustomizeTaskKind:
+			kustomization := path.Join(task.Spec.KustomizeTaskSpec.Kustomization, renderer.KustomizationFileName)
+			if _, ok := templates[kustomization]; !ok {
+				res.AddErrors(fmt.Sprintf("kustomization %q required by %s but is not defined", task.Spec.KustomizeTaskSpec.Kustomization, task.Name))
+			}
+			usesKustomize = true
+			resources = append(resources, task.Spec.KustomizeTaskSpec.Patches...)
 		case engtask.KudoOperatorTaskKind:
 			// param file is being stored in the templates folder, we need to make sure it's in here
 			// to not treat it as error
@@ -47,6 +72,15 @@ func (ReferenceVerifier) Verify(pf *packages.Files) verifier.Result {
 		}
 	}
 
+	// kustomizations can use any other kustomization in the templates folder as a base
+	if usesKustomize {
+		for template := range templates {
+			if renderer.IsKustomizationFile(template, pf.Templates) {
+				requiredTemplates[template] = true
+			}
+		}
+	}
+
 	for template := range templates {
 		// skip manifest file as it is already accounted for
 		if template == pf.Operator.NamespaceManifest {
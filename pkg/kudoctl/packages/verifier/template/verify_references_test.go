@@ -41,3 +41,65 @@ func TestTemplateReferenceVerifier(t *testing.T) {
 	assert.Equal(t, 1, len(res.Errors))
 	assert.Equal(t, `template "bar.yaml" required by foo but is not defined`, res.Errors[0])
 }
+
+func TestTemplateReferenceVerifier_Helm(t *testing.T) {
+	templates := map[string]string{
+		"redis/Chart.yaml":                "name: redis",
+		"redis/values.yaml":               "replicas: 1",
+		"redis/templates/deployment.yaml": "does not matter",
+		"redis-values.yaml":               "does not matter",
+	}
+	tasks := []kudoapi.Task{
+		{
+			Name: "redis",
+			Kind: "Helm",
+			Spec: kudoapi.TaskSpec{HelmTaskSpec: kudoapi.HelmTaskSpec{Chart: "redis", ValuesFile: "redis-values.yaml"}},
+		},
+		{
+			Name: "memcached",
+			Kind: "Helm",
+			Spec: kudoapi.TaskSpec{HelmTaskSpec: kudoapi.HelmTaskSpec{Chart: "memcached"}},
+		},
+	}
+	pf := packages.Files{
+		Templates: templates,
+		Operator:  &packages.OperatorFile{Tasks: tasks},
+		Params:    &packages.ParamsFile{Parameters: []packages.Parameter{}},
+	}
+	verifier := ReferenceVerifier{}
+	res := verifier.Verify(&pf)
+
+	assert.Equal(t, 0, len(res.Warnings))
+	assert.Equal(t, []string{`chart "memcached" required by memcached but is not defined`}, res.Errors)
+}
+
+func TestTemplateReferenceVerifier_Kustomize(t *testing.T) {
+	templates := map[string]string{
+		"base/kustomization.yaml":    "resources:\n- deployment.yaml",
+		"base/deployment.yaml":       "does not matter",
+		"overlay/kustomization.yaml": "bases:\n- ../base",
+		"replicas.yaml":              "does not matter",
+	}
+	tasks := []kudoapi.Task{
+		{
+			Name: "app",
+			Kind: "Kustomize",
+			Spec: kudoapi.TaskSpec{KustomizeTaskSpec: kudoapi.KustomizeTaskSpec{Kustomization: "overlay", Patches: []string{"replicas.yaml"}}},
+		},
+		{
+			Name: "other",
+			Kind: "Kustomize",
+			Spec: kudoapi.TaskSpec{KustomizeTaskSpec: kudoapi.KustomizeTaskSpec{Kustomization: "other"}},
+		},
+	}
+	pf := packages.Files{
+		Templates: templates,
+		Operator:  &packages.OperatorFile{Tasks: tasks},
+		Params:    &packages.ParamsFile{Parameters: []packages.Parameter{}},
+	}
+	verifier := ReferenceVerifier{}
+	res := verifier.Verify(&pf)
+
+	assert.Equal(t, 0, len(res.Warnings))
+	assert.Equal(t, []string{`kustomization "other" required by other but is not defined`}, res.Errors)
+}
@@ -2,13 +2,15 @@ package template
 
 import (
 	"fmt"
+	"path"
+	"sort"
 
 	engtask "github.com/kudobuilder/kudo/pkg/engine/task"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
-	"github.com/kudobuilder/kudo/pkg/controller/instance"
 	"github.com/kudobuilder/kudo/pkg/engine"
 	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/engine/workflow"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
 	packageconvert "github.com/kudobuilder/kudo/pkg/kudoctl/packages/convert"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
@@ -16,7 +18,7 @@ import (
 
 var _ packages.Verifier = &RenderVerifier{}
 
-// RenderVerifier checks that all templates are compilable and contain valid golang template syntax
+// RenderVerifier checks that all templates and 'when' expressions are compilable and contain valid golang template syntax
 type RenderVerifier struct{}
 
 func (RenderVerifier) Verify(pf *packages.Files) verifier.Result {
@@ -42,10 +44,22 @@ func templateCompilable(pf *packages.Files) verifier.Result {
 	configs := renderer.NewVariableMap().
 		WithDefaults().
 		WithParameters(params).
-		WithPipes(pipes)
+		WithPipes(pipes).
+		WithPrevious(renderer.Previous{
+			OperatorVersionName: "OperatorVersionName",
+			OperatorVersion:     "OperatorVersion",
+			AppVersion:          "AppVersion",
+			Params:              params,
+		})
 
-	engine := renderer.New()
+	// There is no cluster to look up objects in, templates have to handle objects that don't exist
+	engine := renderer.New().WithLookup(renderer.EmptyLookup)
 	for k, v := range pf.Templates {
+		// charts are verified below, kustomizations are not rendered
+		if renderer.IsChartFile(k, pf.Templates) || renderer.IsKustomizationFile(k, pf.Templates) {
+			continue
+		}
+
 		// Render the template
 		s, err := engine.Render(k, v, configs)
 		if err != nil {
@@ -62,9 +76,67 @@ func templateCompilable(pf *packages.Files) verifier.Result {
 		}
 	}
 
+	for _, dir := range chartDirs(pf) {
+		chart, err := renderer.LoadChart(dir, pf.Templates)
+		if err != nil {
+			res.AddErrors(err.Error())
+			continue
+		}
+		// charts are rendered with their default values, the values of the instance are only known at runtime
+		if err := renderer.VerifyChart(chart); err != nil {
+			res.AddErrors(fmt.Sprintf("chart %s is invalid: %v", dir, err))
+		}
+	}
+
+	for _, task := range pf.Operator.Tasks {
+		if task.Kind != engtask.KustomizeTaskKind {
+			continue
+		}
+		// kustomizations are built without their patches, which are rendered templates verified above
+		if _, err := renderer.Kustomize(task.Spec.KustomizeTaskSpec.Kustomization, pf.Templates, nil); err != nil {
+			res.AddErrors(fmt.Sprintf("kustomize task %s is invalid: %v", task.Name, err))
+		}
+	}
+
+	for name, when := range whenExpressions(pf) {
+		if _, err := engine.RenderCondition(name, when, configs); err != nil {
+			res.AddErrors(fmt.Sprintf("%s is invalid: %v", name, err))
+		}
+	}
+
 	return res
 }
 
+// whenExpressions returns the 'when' expressions of all phases and steps keyed by a description of their location
+func whenExpressions(pf *packages.Files) map[string]string {
+	expressions := map[string]string{}
+	for planName, plan := range pf.Operator.Plans {
+		for _, ph := range plan.Phases {
+			if ph.When != "" {
+				expressions[fmt.Sprintf("'when' expression of phase %s.%s", planName, ph.Name)] = ph.When
+			}
+			for _, st := range ph.Steps {
+				if st.When != "" {
+					expressions[fmt.Sprintf("'when' expression of step %s.%s.%s", planName, ph.Name, st.Name)] = st.When
+				}
+			}
+		}
+	}
+	return expressions
+}
+
+// chartDirs returns the directories of all charts in the templates folder, subcharts are verified with their parents
+func chartDirs(pf *packages.Files) []string {
+	dirs := []string{}
+	for name := range pf.Templates {
+		if path.Base(name) == renderer.ChartFileName && path.Dir(name) != "." && !renderer.IsChartFile(path.Dir(name), pf.Templates) {
+			dirs = append(dirs, path.Dir(name))
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
 func isParameterFile(k string, pf *packages.Files) bool {
 	for _, task := range pf.Operator.Tasks {
 		switch task.Kind {
@@ -82,7 +154,7 @@ func collectPipes(pf *packages.Files) (map[string]string, error) {
 	pipes := make(map[string]string)
 	for name, plan := range pf.Operator.Plans {
 		plan := plan
-		planPipes, err := instance.PipesMap(name, &plan, pf.Operator.Tasks, &engine.Metadata{})
+		planPipes, err := workflow.PipesMap(name, &plan, pf.Operator.Tasks, &engine.Metadata{})
 		if err != nil {
 			return nil, err
 		}
@@ -98,5 +170,5 @@ func collectParams(pf *packages.Files) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	return instance.ParamsMap(&kudoapi.Instance{}, &kudoapi.OperatorVersion{Spec: kudoapi.OperatorVersionSpec{Parameters: parameters}})
+	return workflow.ParamsMap(&kudoapi.Instance{}, &kudoapi.OperatorVersion{Spec: kudoapi.OperatorVersionSpec{Parameters: parameters}})
 }
@@ -158,3 +158,120 @@ spec:
 	assert.Equal(t, 0, len(res.Warnings))
 	assert.Equal(t, 0, len(res.Errors))
 }
+
+func TestTemplateRenderVerifier_When(t *testing.T) {
+	paramFile := packages.ParamsFile{Parameters: []packages.Parameter{
+		{Name: "NODE_COUNT", Default: "3", Type: kudoapi.IntegerValueType},
+	}}
+	operator := packages.OperatorFile{
+		Plans: map[string]kudoapi.Plan{
+			"deploy": {
+				Phases: []kudoapi.Phase{
+					{
+						Name: "main",
+						When: "{{ gt (atoi .Params.NODE_COUNT) 3 }}",
+						Steps: []kudoapi.Step{
+							{Name: "valid", When: "{{ eq .PlanName \"deploy\" }}"},
+							{Name: "invalid", When: "{{ .Params.NODE_COUNT }}"},
+						},
+					},
+				},
+			},
+		},
+	}
+	pf := packages.Files{
+		Templates: map[string]string{},
+		Operator:  &operator,
+		Params:    &paramFile,
+	}
+	verifier := RenderVerifier{}
+	res := verifier.Verify(&pf)
+
+	assert.Equal(t, 0, len(res.Warnings))
+	assert.Equal(t, 1, len(res.Errors))
+	assert.Equal(t, `'when' expression of step deploy.main.invalid is invalid: expected a boolean value but got "3"`, res.Errors[0])
+}
+
+func TestTemplateRenderVerifier_Lookup(t *testing.T) {
+	paramFile := packages.ParamsFile{Parameters: []packages.Parameter{}}
+	templates := map[string]string{
+		"guarded.yaml": `
+{{ $secret := lookup "v1" "Secret" .Namespace "credentials" }}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  password: {{ if $secret }}{{ $secret.data.password }}{{ else }}{{ randAlphaNum 16 }}{{ end }}
+`,
+		"unguarded.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  password: {{ (lookup "v1" "Secret" .Namespace "credentials").data.password }}
+`,
+	}
+	pf := packages.Files{
+		Templates: templates,
+		Operator:  &packages.OperatorFile{},
+		Params:    &paramFile,
+	}
+	verifier := RenderVerifier{}
+	res := verifier.Verify(&pf)
+
+	assert.Equal(t, 0, len(res.Warnings))
+	assert.Equal(t, 1, len(res.Errors))
+	assert.Contains(t, res.Errors[0], `executing "unguarded.yaml"`)
+}
+
+func TestTemplateRenderVerifier_Helm(t *testing.T) {
+	templates := map[string]string{
+		"redis/Chart.yaml": "name: redis\nversion: 0.1.0\n",
+		"redis/templates/deployment.yaml": `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "redis.fullname" . }}
+`,
+		"redis/templates/_helpers.tpl":  `{{ define "redis.fullname" }}{{ .Release.Name }}-redis{{ end }}`,
+		"broken/Chart.yaml":             "name: broken\nversion: 0.1.0\n",
+		"broken/templates/service.yaml": "{{ if .Values.service }}",
+	}
+	pf := packages.Files{
+		Templates: templates,
+		Operator:  &packages.OperatorFile{},
+		Params:    &packages.ParamsFile{Parameters: []packages.Parameter{}},
+	}
+	verifier := RenderVerifier{}
+	res := verifier.Verify(&pf)
+
+	assert.Equal(t, 0, len(res.Warnings))
+	assert.Equal(t, 1, len(res.Errors))
+	assert.Contains(t, res.Errors[0], "chart broken is invalid")
+}
+
+func TestTemplateRenderVerifier_Kustomize(t *testing.T) {
+	templates := map[string]string{
+		"app/kustomization.yaml":    "resources:\n- configmap.yaml\n",
+		"app/configmap.yaml":        "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: \"{{ .Unknown }}\"\n",
+		"broken/kustomization.yaml": "resources:\n- missing.yaml\n",
+	}
+	pf := packages.Files{
+		Templates: templates,
+		Operator: &packages.OperatorFile{
+			Tasks: []kudoapi.Task{
+				{Name: "app", Kind: "Kustomize", Spec: kudoapi.TaskSpec{KustomizeTaskSpec: kudoapi.KustomizeTaskSpec{Kustomization: "app"}}},
+				{Name: "broken", Kind: "Kustomize", Spec: kudoapi.TaskSpec{KustomizeTaskSpec: kudoapi.KustomizeTaskSpec{Kustomization: "broken"}}},
+			},
+		},
+		Params: &packages.ParamsFile{Parameters: []packages.Parameter{}},
+	}
+	verifier := RenderVerifier{}
+	res := verifier.Verify(&pf)
+
+	assert.Equal(t, 0, len(res.Warnings))
+	assert.Equal(t, 1, len(res.Errors))
+	assert.Contains(t, res.Errors[0], "kustomize task broken is invalid")
+}
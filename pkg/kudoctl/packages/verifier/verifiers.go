@@ -0,0 +1,33 @@
+package verifier
+
+import (
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier/plan"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier/task"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier/template"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
+)
+
+// Verifiers are the checks of an operator package that apply to an OperatorVersion as well. They are shared by
+// `kubectl kudo package verify`, the OperatorVersion controller and the OperatorVersion admission webhook. Checks of
+// package-only metadata, e.g. the versions in operator.yaml, are left to the CLI.
+var Verifiers = []packages.Verifier{
+	DuplicateVerifier{},
+	InvalidCharVerifier{";,"},
+	HealthCheckVerifier{},
+	task.BuildVerifier{},
+	task.ReferenceVerifier{},
+	plan.ReferenceVerifier{},
+	template.ParametersVerifier{},
+	template.ReferenceVerifier{},
+	template.RenderVerifier{},
+}
+
+// Verify runs all Verifiers on the package files
+func Verify(pf *packages.Files) verifier.Result {
+	res := verifier.NewResult()
+	for _, v := range Verifiers {
+		res.Merge(v.Verify(pf))
+	}
+	return res
+}
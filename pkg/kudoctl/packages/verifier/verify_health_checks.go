@@ -0,0 +1,33 @@
+package verifier
+
+import (
+	"fmt"
+
+	"github.com/kudobuilder/kudo/pkg/kubernetes/status"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
+)
+
+var _ packages.Verifier = &HealthCheckVerifier{}
+
+// HealthCheckVerifier verifies the health checks in operator.yaml
+type HealthCheckVerifier struct{}
+
+func (HealthCheckVerifier) Verify(pf *packages.Files) verifier.Result {
+	res := verifier.NewResult()
+	if pf.Operator == nil {
+		return res
+	}
+	kinds := map[string]bool{}
+	for _, check := range pf.Operator.HealthChecks {
+		if err := status.ValidateHealthCheck(check); err != nil {
+			res.AddErrors(err.Error())
+		}
+		kind := fmt.Sprintf("%s %s", check.APIVersion, check.Kind)
+		if kinds[kind] {
+			res.AddErrors(fmt.Sprintf("health check for %s has a duplicate", kind))
+		}
+		kinds[kind] = true
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package verifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
+)
+
+func TestHealthCheckVerifier(t *testing.T) {
+	rules := []kudoapi.HealthRule{{Condition: &kudoapi.ConditionHealthRule{Type: "Ready"}}}
+
+	tests := []struct {
+		name           string
+		checks         []kudoapi.HealthCheck
+		expectedErrors []string
+	}{
+		{"no health checks", nil, []string{}},
+		{"valid health check", []kudoapi.HealthCheck{
+			{APIVersion: "kafka.strimzi.io/v1beta1", Kind: "Kafka", Rules: rules},
+		}, []string{}},
+		{"health check without rules", []kudoapi.HealthCheck{
+			{APIVersion: "kafka.strimzi.io/v1beta1", Kind: "Kafka"},
+		}, []string{"health check for kafka.strimzi.io/v1beta1 Kafka has no rules"}},
+		{"duplicate health check", []kudoapi.HealthCheck{
+			{APIVersion: "kafka.strimzi.io/v1beta1", Kind: "Kafka", Rules: rules},
+			{APIVersion: "kafka.strimzi.io/v1beta1", Kind: "Kafka", Rules: rules},
+		}, []string{"health check for kafka.strimzi.io/v1beta1 Kafka has a duplicate"}},
+	}
+
+	verifier := HealthCheckVerifier{}
+	for _, tt := range tests {
+		res := verifier.Verify(&packages.Files{Operator: &packages.OperatorFile{HealthChecks: tt.checks}})
+		assert.Equal(t, []string{}, res.Warnings, tt.name)
+		assert.Equal(t, tt.expectedErrors, res.Errors, tt.name)
+	}
+}
@@ -0,0 +1,48 @@
+package verifier
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/verifier"
+)
+
+var _ packages.Verifier = &DuplicateVerifier{}
+var _ packages.Verifier = &InvalidCharVerifier{}
+
+// DuplicateVerifier provides verification that there are no duplicates disallowing casing (Kudo and kudo are duplicates)
+type DuplicateVerifier struct{}
+
+func (DuplicateVerifier) Verify(pf *packages.Files) verifier.Result {
+	res := verifier.NewResult()
+	names := map[string]bool{}
+	for _, param := range pf.Params.Parameters {
+		name := strings.ToLower(param.Name)
+		if names[name] {
+			res.AddParamError(param.Name, "has a duplicate")
+		}
+		names[name] = true
+	}
+	return res
+}
+
+// InvalidCharVerifier provides verification that parameter names don't contain any of the InvalidChars
+type InvalidCharVerifier struct {
+	InvalidChars string
+}
+
+func (v InvalidCharVerifier) Verify(pf *packages.Files) verifier.Result {
+	res := verifier.NewResult()
+	for _, param := range pf.Params.Parameters {
+		name := strings.ToLower(param.Name)
+		for _, char := range name {
+			if strings.Contains(v.InvalidChars, strings.ToLower(string(char))) {
+				res.AddParamError(param.Name, fmt.Sprintf("contains invalid character %q", char))
+			}
+		}
+
+	}
+
+	return res
+}
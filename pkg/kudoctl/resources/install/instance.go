@@ -14,7 +14,8 @@ import (
 
 // Instance installs a KUDO instance to a cluster.
 // It returns an error if the namespace already contains an instance with the same name.
-func Instance(client *kudo.Client, instance *kudoapi.Instance) error {
+// Values of parameters that are flagged as sensitive in the operator version are stored in a Secret owned by the instance.
+func Instance(client *kudo.Client, instance *kudoapi.Instance, ov *kudoapi.OperatorVersion) error {
 	existingInstance, err := client.GetInstance(instance.Name, instance.Namespace)
 	if err != nil {
 		return fmt.Errorf("failed to verify existing instance: %v", err)
@@ -27,7 +28,15 @@ func Instance(client *kudo.Client, instance *kudoapi.Instance) error {
 			instance.Namespace)
 	}
 
-	if _, err := client.InstallInstanceObjToCluster(instance, instance.Namespace); err != nil {
+	params, secret, err := client.StoreSensitiveParameters(instance, ov, instance.Spec.Parameters)
+	if err != nil {
+		return err
+	}
+	instance.Spec.Parameters = params
+	instance.Spec.SensitiveParametersSecret = secret
+
+	created, err := client.InstallInstanceObjToCluster(instance, instance.Namespace)
+	if err != nil {
 		return fmt.Errorf(
 			"failed to install instance %s/%s: %v",
 			instance.Namespace,
@@ -35,6 +44,10 @@ func Instance(client *kudo.Client, instance *kudoapi.Instance) error {
 			err)
 	}
 
+	if err := client.SetSensitiveParametersOwner(created); err != nil {
+		return err
+	}
+
 	clog.Printf("instance %s/%s created", instance.Namespace, instance.Name)
 	return nil
 }
@@ -227,10 +227,11 @@ func (c *Client) GetOperator(name, namespace string) (*kudoapi.Operator, error)
 	return o, err
 }
 
-// UpdateInstance updates operatorversion on instance
+// UpdateInstance updates operatorversion on instance. Values of sensitive parameters are stored in the sensitive
+// parameters Secret of the instance and only referenced from the instance spec.
 func (c *Client) UpdateInstance(instanceName, namespace string, operatorVersion *string, parameters map[string]string, triggeredPlan *string, wait bool, waitTime time.Duration) error {
 	var oldInstance *kudoapi.Instance
-	if wait {
+	if wait || parameters != nil {
 		var err error
 		oldInstance, err = c.GetInstance(instanceName, namespace)
 		if err != nil {
@@ -247,7 +248,11 @@ func (c *Client) UpdateInstance(instanceName, namespace string, operatorVersion
 	}
 	// 2. new/updated parameters
 	if parameters != nil {
-		instanceSpec.Parameters = parameters
+		var err error
+		instanceSpec.Parameters, instanceSpec.SensitiveParametersSecret, err = c.storeSensitiveParameters(oldInstance, operatorVersion, parameters)
+		if err != nil {
+			return err
+		}
 	}
 	// 3. new/updated execution plan
 	if triggeredPlan != nil {
@@ -274,6 +279,60 @@ func (c *Client) UpdateInstance(instanceName, namespace string, operatorVersion
 	return c.WaitForInstance(instanceName, namespace, oldInstance, waitTime)
 }
 
+// storeSensitiveParameters stores the sensitive parameters of an update in the sensitive parameters Secret of the
+// instance, see StoreSensitiveParameters. The parameter definitions are taken from the new operator version if the
+// instance is upgraded.
+func (c *Client) storeSensitiveParameters(instance *kudoapi.Instance, operatorVersion *string, parameters map[string]string) (map[string]string, string, error) {
+	if instance == nil {
+		return parameters, "", nil
+	}
+
+	ovName := instance.Spec.OperatorVersion.Name
+	if operatorVersion != nil {
+		ovName = *operatorVersion
+	}
+	ov, err := c.GetOperatorVersion(ovName, instance.Namespace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get operator version %s/%s: %v", instance.Namespace, ovName, err)
+	}
+	if ov == nil {
+		return parameters, instance.Spec.SensitiveParametersSecret, nil
+	}
+
+	return c.StoreSensitiveParameters(instance, ov, parameters)
+}
+
+// CancelPlan cancels the currently scheduled (or running) plan of the instance by setting the
+// Spec.PlanExecution.Cancel flag. The plan name is passed along to make sure that the expected plan is cancelled.
+func (c *Client) CancelPlan(instanceName, namespace, plan string) error {
+	instance, err := c.GetInstance(instanceName, namespace)
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return fmt.Errorf("instance %s/%s does not exist", namespace, instanceName)
+	}
+
+	scheduled := instance.Spec.PlanExecution.PlanName
+	if scheduled == "" {
+		return fmt.Errorf("instance %s/%s has no scheduled (or running) plan", namespace, instanceName)
+	}
+	if plan != "" && plan != scheduled {
+		return fmt.Errorf("plan %s is not scheduled (or running) on instance %s/%s, current plan is %s", plan, namespace, instanceName, scheduled)
+	}
+
+	serializedPatch, err := json.Marshal(struct {
+		Spec *kudoapi.InstanceSpec `json:"spec"`
+	}{
+		&kudoapi.InstanceSpec{PlanExecution: kudoapi.PlanExecution{Cancel: true}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.kudoClientset.KudoV1beta1().Instances(namespace).Patch(context.TODO(), instanceName, types.MergePatchType, serializedPatch, v1.PatchOptions{})
+	return err
+}
+
 // WaitForInstance waits for instance to be "complete".
 // It uses controller-runtime `wait.PollImmediate`, the function passed to it returns done==false if it isn't done.
 // For a situation where there is no previous state (like install), the "lastPlanStatus" will be nil until the manager
@@ -375,6 +434,16 @@ func (c *Client) ListInstancesAsRuntimeObject(namespace string) ([]runtime.Objec
 	return existingItems, nil
 }
 
+// ListInstances lists all instances in a given ns, or in all namespaces if the ns is empty
+func (c *Client) ListInstances(namespace string) ([]kudoapi.Instance, error) {
+	instances, err := c.kudoClientset.KudoV1beta1().Instances(namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return instances.Items, nil
+}
+
 func (c *Client) ListOperatorVersions(namespace string) ([]kudoapi.OperatorVersion, error) {
 	ovs, err := c.kudoClientset.KudoV1beta1().OperatorVersions(namespace).List(context.TODO(), v1.ListOptions{})
 	if err != nil {
@@ -398,16 +467,26 @@ func (c *Client) ListOperatorVersionsAsRuntimeObject(namespace string) ([]runtim
 	return asObjs, nil
 }
 
+// ListOperators lists all operators installed in the cluster in a given ns
+func (c *Client) ListOperators(namespace string) ([]kudoapi.Operator, error) {
+	operators, err := c.kudoClientset.KudoV1beta1().Operators(namespace).List(context.TODO(), v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return operators.Items, nil
+}
+
 // ListOperatorsAsRuntimeObject lists all operators installed in the cluster in a given ns
 func (c *Client) ListOperatorsAsRuntimeObject(namespace string) ([]runtime.Object, error) {
-	operators, err := c.kudoClientset.KudoV1beta1().Operators(namespace).List(context.TODO(), v1.ListOptions{})
+	operators, err := c.ListOperators(namespace)
 	if err != nil {
 		return nil, err
 	}
 
 	existingItems := []runtime.Object{}
-	for i := range operators.Items {
-		existingItems = append(existingItems, &operators.Items[i])
+	for i := range operators {
+		existingItems = append(existingItems, &operators[i])
 	}
 	return existingItems, nil
 }
@@ -478,6 +557,16 @@ func (c *Client) DeleteInstance(instanceName, namespace string) error {
 	return c.kudoClientset.KudoV1beta1().Instances(namespace).Delete(context.TODO(), instanceName, options)
 }
 
+// DeleteOperatorVersion deletes an operator version.
+func (c *Client) DeleteOperatorVersion(name, namespace string) error {
+	return c.kudoClientset.KudoV1beta1().OperatorVersions(namespace).Delete(context.TODO(), name, v1.DeleteOptions{})
+}
+
+// DeleteOperator deletes an operator.
+func (c *Client) DeleteOperator(name, namespace string) error {
+	return c.kudoClientset.KudoV1beta1().Operators(namespace).Delete(context.TODO(), name, v1.DeleteOptions{})
+}
+
 // ValidateServerForOperator validates that the k8s server version and kudo version are valid for operator
 // error message will provide detail of failure, otherwise nil
 func (c *Client) ValidateServerForOperator(operator *kudoapi.Operator) error {
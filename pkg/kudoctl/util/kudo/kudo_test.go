@@ -719,6 +719,67 @@ func TestKudoClient_UpdateOperatorVersion(t *testing.T) {
 	}
 }
 
+func TestKudoClient_CancelPlan(t *testing.T) {
+	testInstance := kudoapi.Instance{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "kudo.dev/v1beta1",
+			Kind:       "Instance",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion: v1.ObjectReference{
+				Name: "test-1.0",
+			},
+			PlanExecution: kudoapi.PlanExecution{
+				PlanName: "deploy",
+				UID:      "deploy-uid",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		instanceName string
+		plan         string
+		scheduled    string
+		shouldFail   bool
+	}{
+		{name: "non-existing instance", instanceName: "nonexisting-instance", shouldFail: true},
+		{name: "no plan scheduled", instanceName: testInstance.Name, shouldFail: true},
+		{name: "different plan scheduled", instanceName: testInstance.Name, plan: "backup", scheduled: "deploy", shouldFail: true},
+		{name: "cancel scheduled plan", instanceName: testInstance.Name, scheduled: "deploy"},
+		{name: "cancel named plan", instanceName: testInstance.Name, plan: "deploy", scheduled: "deploy"},
+	}
+
+	for _, tt := range tests {
+		k2o := newTestSimpleK2o()
+
+		instanceToCreate := testInstance.DeepCopy()
+		instanceToCreate.Spec.PlanExecution.PlanName = tt.scheduled
+		_, err := k2o.kudoClientset.
+			KudoV1beta1().
+			Instances(installNamespace).
+			Create(context.TODO(), instanceToCreate, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatalf("error creating instance in tests setup for %s", tt.name)
+		}
+
+		err = k2o.CancelPlan(tt.instanceName, installNamespace, tt.plan)
+		assert.Equal(t, tt.shouldFail, err != nil, "%s: unexpected error: %v", tt.name, err)
+		if err != nil {
+			continue
+		}
+
+		instance, err := k2o.GetInstance(tt.instanceName, installNamespace)
+		assert.NoError(t, err)
+		assert.True(t, instance.Spec.PlanExecution.Cancel, "%s: expected the cancel flag to be set", tt.name)
+		assert.Equal(t, tt.scheduled, instance.Spec.PlanExecution.PlanName)
+		assert.Equal(t, testInstance.Spec.PlanExecution.UID, instance.Spec.PlanExecution.UID)
+	}
+}
+
 func TestKudoClient_DeleteInstance(t *testing.T) {
 	testInstance := kudoapi.Instance{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,96 @@
+package kudo
+
+import (
+	"context"
+	"fmt"
+
+	v1core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/clog"
+	label "github.com/kudobuilder/kudo/pkg/util/kudo"
+)
+
+// StoreSensitiveParameters moves the values of all sensitive parameters of the operator version from the passed
+// parameters into the sensitive parameters Secret of the instance. It returns the parameters with references to the
+// stored values and the name of the Secret, which has to be set as InstanceSpec.SensitiveParametersSecret. See
+// Instance.StoreSensitiveParameterValues for how values are stored. For an instance that is not created yet, the Secret
+// has no owner until SetSensitiveParametersOwner is called.
+func (c *Client) StoreSensitiveParameters(instance *kudoapi.Instance, ov *kudoapi.OperatorVersion, parameters map[string]string) (map[string]string, string, error) {
+	sensitive := kudoapi.PlaintextSensitiveParameters(ov, parameters)
+	if len(sensitive) == 0 {
+		return parameters, instance.Spec.SensitiveParametersSecret, nil
+	}
+
+	name := kudoapi.SensitiveParametersSecretName(instance.Name)
+	secret, err := c.KubeClientset.CoreV1().Secrets(instance.Namespace).Get(context.TODO(), name, v1.GetOptions{})
+	exists := err == nil
+	switch {
+	case apierrors.IsNotFound(err):
+		secret = &v1core.Secret{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      name,
+				Namespace: instance.Namespace,
+				Labels: map[string]string{
+					label.HeritageLabel: "kudo",
+					label.InstanceLabel: instance.Name,
+				},
+			},
+			Type: v1core.SecretTypeOpaque,
+		}
+	case err != nil:
+		return nil, "", fmt.Errorf("failed to get sensitive parameters secret %s/%s: %v", instance.Namespace, name, err)
+	}
+	if instance.UID != "" {
+		setInstanceOwner(secret, instance)
+	}
+
+	result := instance.StoreSensitiveParameterValues(secret, ov, parameters)
+
+	if exists {
+		_, err = c.KubeClientset.CoreV1().Secrets(instance.Namespace).Update(context.TODO(), secret, v1.UpdateOptions{})
+	} else {
+		_, err = c.KubeClientset.CoreV1().Secrets(instance.Namespace).Create(context.TODO(), secret, v1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to store sensitive parameters in secret %s/%s: %v", instance.Namespace, name, err)
+	}
+	clog.V(2).Printf("stored %d sensitive parameter(s) in secret %s/%s", len(sensitive), instance.Namespace, name)
+
+	return result, name, nil
+}
+
+// SetSensitiveParametersOwner makes the created instance the owner of its sensitive parameters Secret, so that the
+// Secret is garbage collected with the instance
+func (c *Client) SetSensitiveParametersOwner(instance *kudoapi.Instance) error {
+	name := instance.Spec.SensitiveParametersSecret
+	if name == "" {
+		return nil
+	}
+
+	secret, err := c.KubeClientset.CoreV1().Secrets(instance.Namespace).Get(context.TODO(), name, v1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get sensitive parameters secret %s/%s: %v", instance.Namespace, name, err)
+	}
+	if !setInstanceOwner(secret, instance) {
+		return nil
+	}
+
+	if _, err := c.KubeClientset.CoreV1().Secrets(instance.Namespace).Update(context.TODO(), secret, v1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to set owner of sensitive parameters secret %s/%s: %v", instance.Namespace, name, err)
+	}
+	return nil
+}
+
+// setInstanceOwner adds the instance as controller owner to the secret and returns true if the secret was changed
+func setInstanceOwner(secret *v1core.Secret, instance *kudoapi.Instance) bool {
+	for _, o := range secret.OwnerReferences {
+		if o.UID == instance.UID {
+			return false
+		}
+	}
+	secret.OwnerReferences = append(secret.OwnerReferences, *v1.NewControllerRef(instance, kudoapi.SchemeGroupVersion.WithKind("Instance")))
+	return true
+}
@@ -0,0 +1,97 @@
+package kudo
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+func TestKudoClient_StoreSensitiveParameters(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1.0", Namespace: installNamespace},
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{
+				{Name: "NODES"},
+				{Name: "PASSWORD", Sensitive: convert.BoolPtr(true)},
+			},
+		},
+	}
+	instance := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: installNamespace},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion: v1.ObjectReference{Name: "test-1.0"},
+			Parameters:      map[string]string{"NODES": "3", "PASSWORD": "s3cr3t"},
+		},
+	}
+
+	k2o := newTestSimpleK2o()
+
+	// install
+	params, secretName, err := k2o.StoreSensitiveParameters(instance, ov, instance.Spec.Parameters)
+	assert.NoError(t, err)
+	assert.Equal(t, "test-sensitive-parameters", secretName)
+	assert.Equal(t, "3", params["NODES"])
+	assert.True(t, kudoapi.IsSensitiveParameterRef(params["PASSWORD"]))
+	assert.Equal(t, "s3cr3t", instance.Spec.Parameters["PASSWORD"], "the passed parameters must not be modified")
+	assert.Equal(t, "s3cr3t", sensitiveValue(t, k2o, secretName, params["PASSWORD"]))
+
+	instance.Spec.Parameters = params
+	instance.Spec.SensitiveParametersSecret = secretName
+	instance.UID = "uid"
+	assert.NoError(t, k2o.SetSensitiveParametersOwner(instance))
+	secret, _ := k2o.KubeClientset.CoreV1().Secrets(installNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	assert.Equal(t, 1, len(secret.OwnerReferences))
+	assert.Equal(t, "test", secret.OwnerReferences[0].Name)
+
+	// update to the same value keeps the reference
+	updated, _, err := k2o.StoreSensitiveParameters(instance, ov, map[string]string{"PASSWORD": "s3cr3t"})
+	assert.NoError(t, err)
+	assert.Equal(t, params["PASSWORD"], updated["PASSWORD"])
+
+	// update to a new value, the old value is still referenced by the applied snapshot
+	instance.Status.AppliedSnapshot = &kudoapi.InstanceSnapshot{Parameters: params}
+	updated, _, err = k2o.StoreSensitiveParameters(instance, ov, map[string]string{"PASSWORD": "n3w-s3cr3t"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, params["PASSWORD"], updated["PASSWORD"])
+	assert.Equal(t, "n3w-s3cr3t", sensitiveValue(t, k2o, secretName, updated["PASSWORD"]))
+	assert.Equal(t, "s3cr3t", sensitiveValue(t, k2o, secretName, params["PASSWORD"]))
+
+	// values that are not referenced anymore are removed
+	instance.Spec.Parameters = updated
+	instance.Status.AppliedSnapshot = &kudoapi.InstanceSnapshot{Parameters: updated}
+	latest, _, err := k2o.StoreSensitiveParameters(instance, ov, map[string]string{"PASSWORD": "l4test-s3cr3t"})
+	assert.NoError(t, err)
+	secret, _ = k2o.KubeClientset.CoreV1().Secrets(installNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	assert.Equal(t, 2, len(secret.Data))
+	assert.NotContains(t, secret.Data, strings.TrimPrefix(params["PASSWORD"], kudoapi.SensitiveParameterRefPrefix))
+	assert.Equal(t, "l4test-s3cr3t", sensitiveValue(t, k2o, secretName, latest["PASSWORD"]))
+}
+
+func TestKudoClient_StoreSensitiveParameters_NoSensitiveParameters(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{Spec: kudoapi.OperatorVersionSpec{Parameters: []kudoapi.Parameter{{Name: "NODES"}}}}
+	instance := &kudoapi.Instance{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: installNamespace}}
+
+	k2o := newTestSimpleK2o()
+	params, secretName, err := k2o.StoreSensitiveParameters(instance, ov, map[string]string{"NODES": "3"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", secretName)
+	assert.Equal(t, map[string]string{"NODES": "3"}, params)
+
+	secrets, _ := k2o.KubeClientset.CoreV1().Secrets(installNamespace).List(context.TODO(), metav1.ListOptions{})
+	assert.Empty(t, secrets.Items)
+}
+
+func sensitiveValue(t *testing.T, k2o *Client, secretName, ref string) string {
+	secret, err := k2o.KubeClientset.CoreV1().Secrets(installNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if !assert.NoError(t, err) {
+		return ""
+	}
+	return string(secret.Data[strings.TrimPrefix(ref, kudoapi.SensitiveParameterRefPrefix)])
+}
@@ -41,6 +41,7 @@ func CustomCachedDiscoveryClient(additionalResources ...*metav1.APIResourceList)
 			APIResources: []metav1.APIResource{
 				{Name: "statefulset", Namespaced: true, Kind: "StatefulSet"},
 				{Name: "deployment", Namespaced: true, Kind: "Deployment"},
+				{Name: "daemonset", Namespaced: true, Kind: "DaemonSet"},
 			},
 		},
 		{
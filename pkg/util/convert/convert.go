@@ -13,6 +13,11 @@ func StringPtr(input string) *string {
 	return &input
 }
 
+// BoolPtr returns a pointer to the bool value passed in.
+func BoolPtr(input bool) *bool {
+	return &input
+}
+
 // StringValue returns the value of the string pointer passed in or
 // "" if the pointer is nil.
 func StringValue(input *string) string {
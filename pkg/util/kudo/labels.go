@@ -23,9 +23,16 @@ const (
 	// DependenciesHash is used to trigger pod reloads if one of the dependencies of the resource is changed
 	DependenciesHashAnnotation = "kudo.dev/dependencies-hash"
 
+	// DependsOnAnnotation declares additional dependencies of an object, e.g. `configmap/foo,secret/bar`. A change
+	// in one of them changes the dependencies hash of the object
+	DependsOnAnnotation = "kudo.dev/depends-on"
+
 	// Used to ignore this resource in the calculation of the dependencies hash
 	SkipHashCalculationAnnotation = "kudo.dev/skip-hash-calculation"
 
 	// Last applied state for three way merges
 	LastAppliedConfigAnnotation = "kudo.dev/last-applied-configuration"
+
+	// FieldManager is the field manager KUDO uses for server-side apply
+	FieldManager = "kudo-manager"
 )
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"reflect"
 
+	"github.com/robfig/cron"
 	"github.com/thoas/go-funk"
 	"k8s.io/api/admission/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -89,11 +90,24 @@ func handleCreate(ia *InstanceAdmission, req admission.Request) admission.Respon
 	}
 	new.Spec.PlanExecution.PlanName = kudoapi.DeployPlanName
 	new.Spec.PlanExecution.UID = uuid.NewUUID()
+	new.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerInstall
 
+	if err := validatePlaintextSensitiveParameters(ov, nil, new); err != nil {
+		return admission.Denied(fmt.Sprintf("failed to create an Instance %s/%s: sensitive parameters are not valid: %v", new.Namespace, new.Name, err))
+	}
 	setImmutableParameterDefaults(ov, new)
 	if err := validateParameters(ov, new); err != nil {
 		return admission.Denied(fmt.Sprintf("failed to create an Instance %s/%s: parameters are not valid: %v", new.Namespace, new.Name, err))
 	}
+	if err := validateSensitiveParameters(ov, new, ia.client); err != nil {
+		return admission.Denied(fmt.Sprintf("failed to create an Instance %s/%s: sensitive parameters are not valid: %v", new.Namespace, new.Name, err))
+	}
+	if err := validateParametersFrom(ov, new); err != nil {
+		return admission.Denied(fmt.Sprintf("failed to create an Instance %s/%s: parameter sources are not valid: %v", new.Namespace, new.Name, err))
+	}
+	if err := validateSchedules(ov, new); err != nil {
+		return admission.Denied(fmt.Sprintf("failed to create an Instance %s/%s: schedules are not valid: %v", new.Namespace, new.Name, err))
+	}
 
 	marshaled, err := json.Marshal(new)
 	if err != nil {
@@ -140,16 +154,24 @@ func handleUpdate(ia *InstanceAdmission, req admission.Request) admission.Respon
 		}
 	}
 
-	triggered, err := admitUpdate(old, new, ov, oldOv)
+	triggered, trigger, err := admitUpdate(old, new, ov, oldOv)
 	if err != nil {
 		return admission.Denied(err.Error())
 	}
+	// only changed parameters are validated, status updates must not depend on the sensitive parameters Secret
+	if !reflect.DeepEqual(old.Spec.Parameters, new.Spec.Parameters) {
+		if err := validateSensitiveParameters(ov, new, ia.client); err != nil {
+			return admission.Denied(fmt.Sprintf("failed to validate sensitive parameters for Instance %s/%s: %v", new.Namespace, new.Name, err))
+		}
+	}
 
 	// populate Instance.PlanExecution with the plan triggered by param change and evtl. a new UID/Status
 	if triggered != nil {
 		new.Spec.PlanExecution.PlanName = *triggered
 		new.Spec.PlanExecution.UID = ""
 		new.Spec.PlanExecution.Status = ""
+		new.Spec.PlanExecution.Cancel = false
+		new.Spec.PlanExecution.Trigger = trigger
 		if *triggered != "" {
 			new.Spec.PlanExecution.UID = uuid.NewUUID()               // if there is a new plan, generate new UID
 			new.Spec.PlanExecution.Status = kudoapi.ExecutionNeverRun // and set status to NEVER_RUN
@@ -175,7 +197,10 @@ func handleUpdate(ia *InstanceAdmission, req admission.Request) admission.Respon
 | x                | x               |         |              |            | No²  | Forbid parameter updates when a plan is scheduled unless the same plan is triggered (instance status will be reset) |
 | x                |                 | x       |              |            | No    | Forbid upgrades if another plan is running                                                                          |
 | x                |                 |         | x            |            | No³   | Forbid plan overrides (for now)                                                                                     |
-| x                |                 |         |              | x          | No    | Forbid plan cancellations (for now)                                                                                 |
+| x                |                 |         |              | x          | Yes⁴  | Cancel the scheduled (or running) plan                                                                              |
+| x                |                 |         | x            |            | Yes⁵  | The instance controller schedules the 'onFailure' plan of a plan that failed with a fatal error                     |
+| x                | x               |         |              | x          | No    | Forbid simultaneous parameter update and plan cancellation                                                          |
+|                  |                 |         |              | x          | No    | Forbid plan cancellation when no plan is scheduled                                                                  |
 | ---              |                 |         |              |            |       | ---                                                                                                                 |
 |                  | x               |         | x            |            | No    | Forbid simultaneous parameter update and directly triggered plan                                                    |
 |                  |                 | x       | x            |            | No    | Forbid simultaneous upgrades and directly triggered plans                                                           |
@@ -186,14 +211,18 @@ func handleUpdate(ia *InstanceAdmission, req admission.Request) admission.Respon
    trigger).
 3. 'cleanup' plan is the only one that is allowed to override an existing one. Overriding 'cleanup' should be impossible even
    if an 'override=true' flag is introduced. This exception exists only during Instance cleanup phase.
+4. A plan is cancelled by setting the Spec.PlanExecution.Cancel flag (and not by removing the plan name). The instance controller
+   then stops the execution and sets the plan status to CANCELLED. 'cleanup' plan can not be cancelled.
+5. When a plan fails with a FATAL_ERROR and defines an 'onFailure' plan, the instance controller overrides it with the 'onFailure'
+   plan. This is the only plan override allowed during the normal life-cycle phase.
 */
 
 // admitUpdate takes in the old and new (updated) instance and returns a new plan that might
-// be triggered based on the update and an error if the update is not valid. Return plan might be
+// be triggered based on the update, what triggered it and an error if the update is not valid. Return plan might be
 // - <nil> when there is no change to an existing scheduled plan
-// - '' empty string when an existing plan should be canceled (not implemented yet)
+// - '' empty string when an existing plan is terminal and the plan execution should be reset
 // - 'newPlan' some new plan that should be triggered
-func admitUpdate(old, new *kudoapi.Instance, ov, oldOv *kudoapi.OperatorVersion) (*string, error) { //nolint:gocyclo
+func admitUpdate(old, new *kudoapi.Instance, ov, oldOv *kudoapi.OperatorVersion) (*string, kudoapi.PlanTrigger, error) { //nolint:gocyclo
 	// PREREQUISITES:
 	newPlan := new.Spec.PlanExecution.PlanName
 	oldPlan := old.Spec.PlanExecution.PlanName
@@ -209,30 +238,42 @@ func admitUpdate(old, new *kudoapi.Instance, ov, oldOv *kudoapi.OperatorVersion)
 	isPlanOverride := hadPlan && newPlan != "" && newPlan != oldPlan
 	isPlanRetriggered := hadPlan && newPlan == oldPlan && newUID != oldUID
 	isPlanCancellation := hadPlan && newPlan == ""
+	isPlanCancelRequested := new.Spec.PlanExecution.Cancel && !old.Spec.PlanExecution.Cancel
 	isDeleting := new.IsDeleting() // a non-empty meta.deletionTimestamp is a signal to switch to the uninstalling life-cycle phase
 	isPlanTerminal := new.Spec.PlanExecution.Status.IsTerminal()
+	isOnFailurePlanScheduled := isPlanOverride && isOnFailureOverride(oldPlan, newPlan, new, ov)
 
 	// validate plan first
 	if newPlan != "" && kudoapi.SelectPlan([]string{newPlan}, ov) == nil {
-		return nil, fmt.Errorf("plan %s does not exist", newPlan)
+		return nil, "", fmt.Errorf("plan %s does not exist", newPlan)
 	}
 
-	changedDefs, removedDefs, err := changedParameters(old.Spec.Parameters, new.Spec.Parameters, oldOv, ov)
+	// a parameter that is sourced from a Secret or ConfigMap counts as changed when its source changes
+	changedDefs, removedDefs, err := changedParameters(specParameters(old), specParameters(new), oldOv, ov)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update Instance %s/%s: %v", old.Namespace, old.Name, err)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: %v", old.Namespace, old.Name, err)
 	}
 
 	if err = checkImmutableParameters(old.Spec.Parameters, new.Spec.Parameters, ov, oldOv, changedDefs, isUpgrade); err != nil {
-		return nil, fmt.Errorf("failed to check immutable parameters for Instance %s/%s: %v", old.Namespace, old.Name, err)
+		return nil, "", fmt.Errorf("failed to check immutable parameters for Instance %s/%s: %v", old.Namespace, old.Name, err)
 	}
 	if err := validateParameters(ov, new); err != nil {
-		return nil, fmt.Errorf("failed to validate parameters for Instance %s/%s: %v", new.Namespace, new.Name, err)
+		return nil, "", fmt.Errorf("failed to validate parameters for Instance %s/%s: %v", new.Namespace, new.Name, err)
+	}
+	if err := validatePlaintextSensitiveParameters(ov, old, new); err != nil {
+		return nil, "", fmt.Errorf("failed to validate sensitive parameters for Instance %s/%s: %v", new.Namespace, new.Name, err)
+	}
+	if err := validateParametersFrom(ov, new); err != nil {
+		return nil, "", fmt.Errorf("failed to validate parameter sources for Instance %s/%s: %v", new.Namespace, new.Name, err)
+	}
+	if err := validateSchedules(ov, new); err != nil {
+		return nil, "", fmt.Errorf("failed to validate schedules for Instance %s/%s: %v", new.Namespace, new.Name, err)
 	}
 
 	updatedParameterDefs := append(changedDefs, removedDefs...)
-	triggeredPlan, err := triggeredByParameterUpdate(updatedParameterDefs, ov)
+	triggeredPlan, err := kudoapi.TriggeredByParameterUpdate(updatedParameterDefs, ov)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update Instance %s/%s: %v", old.Namespace, old.Name, err)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: %v", old.Namespace, old.Name, err)
 	}
 
 	isParameterUpdate := triggeredPlan != nil
@@ -257,40 +298,46 @@ func admitUpdate(old, new *kudoapi.Instance, ov, oldOv *kudoapi.OperatorVersion)
 		notCleanupScheduled := newPlan != "" && newPlan != Cleanup
 
 		switch {
+		case isPlanCancelRequested:
+			return nil, "", fmt.Errorf("failed to update Instance %s/%s: plan '%s' can not be cancelled since the instance is being deleted", old.Namespace, old.Name, oldPlan)
 		case isCleanupOverride:
-			return nil, fmt.Errorf("failed to update Instance %s/%s: '%s' plan can not be cancelled or overridden by another plan since the instance is being deleted", old.Namespace, old.Name, oldPlan)
+			return nil, "", fmt.Errorf("failed to update Instance %s/%s: '%s' plan can not be cancelled or overridden by another plan since the instance is being deleted", old.Namespace, old.Name, oldPlan)
 		case isParameterUpdate || isUpgrade:
-			return nil, fmt.Errorf("failed to update Instance %s/%s: parameter update and/or upgrade is not allowed when an instance is being deleted", old.Namespace, old.Name)
+			return nil, "", fmt.Errorf("failed to update Instance %s/%s: parameter update and/or upgrade is not allowed when an instance is being deleted", old.Namespace, old.Name)
 		case notCleanupScheduled:
-			return nil, fmt.Errorf("failed to update Instance %s/%s: only '%s' plan can be scheduled when an instance is being deleted", old.Namespace, old.Name, Cleanup)
+			return nil, "", fmt.Errorf("failed to update Instance %s/%s: only '%s' plan can be scheduled when an instance is being deleted", old.Namespace, old.Name, Cleanup)
 		}
 		// cleanup is being scheduled by the controller so we don't have to return anything here
-		return nil, nil
+		return nil, "", nil
 	}
 
 	// ----------------------------
 	// ---- Normal life-cycle -----
 	// ----------------------------
 	switch {
+	case isPlanCancelRequested && !hadPlan:
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: there is no scheduled (or running) plan that could be cancelled", old.Namespace, old.Name)
+	case isPlanCancelRequested && (isParameterUpdate || isUpgrade || isPlanRetriggered):
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: cancelling plan '%s' together with a parameter update, upgrade or re-triggering a plan is not allowed", old.Namespace, old.Name, oldPlan)
 	case hadPlan && isParameterUpdate && *triggeredPlan != oldPlan:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: plan '%s' is scheduled (or running) and an update would trigger a different plan '%s'", old.Namespace, old.Name, oldPlan, *triggeredPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: plan '%s' is scheduled (or running) and an update would trigger a different plan '%s'", old.Namespace, old.Name, oldPlan, *triggeredPlan)
 	case isUpgrade && hadPlan:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: upgrade to new OperatorVersion %s while a plan '%s' is scheduled (or running) is not allowed", old.Namespace, old.Name, newOvRef, oldPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: upgrade to new OperatorVersion %s while a plan '%s' is scheduled (or running) is not allowed", old.Namespace, old.Name, newOvRef, oldPlan)
 	case isUpgrade && isNovelPlan:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: upgrade to new OperatorVersion %s and triggering new plan '%s' is not allowed", old.Namespace, old.Name, newOvRef, newPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: upgrade to new OperatorVersion %s and triggering new plan '%s' is not allowed", old.Namespace, old.Name, newOvRef, newPlan)
 	case isUpgrade && updateIncompatibleWithUpgrade:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: upgrade to new OperatorVersion %s together with a parameter update triggering '%s' is not allowed", old.Namespace, old.Name, newOvRef, *triggeredPlan)
-	case isPlanOverride:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: overriding currently scheduled (or running) plan '%s' with '%s' is not supported", old.Namespace, old.Name, oldPlan, newPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: upgrade to new OperatorVersion %s together with a parameter update triggering '%s' is not allowed", old.Namespace, old.Name, newOvRef, *triggeredPlan)
+	case isPlanOverride && !isOnFailurePlanScheduled:
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: overriding currently scheduled (or running) plan '%s' with '%s' is not supported", old.Namespace, old.Name, oldPlan, newPlan)
 	case isPlanCancellation:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: cancelling currently scheduled (or running) plan '%s' is not supported", old.Namespace, old.Name, oldPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: removing currently scheduled (or running) plan '%s' is not supported, use the cancel flag instead", old.Namespace, old.Name, oldPlan)
 	case isParameterUpdate && isNovelPlan:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: triggering one plan '%s' directly and through parameter update '%s' is not allowed", old.Namespace, old.Name, oldPlan, newPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: triggering one plan '%s' directly and through parameter update '%s' is not allowed", old.Namespace, old.Name, oldPlan, newPlan)
 	// this case is effectively a noop because isPlanOverride is disallowed for now. However, once plan overrides are implemented, this will be needed so don't remove.
 	case isParameterUpdate && isPlanOverride:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: updating parameters and triggering plan '%s' is not allowed", old.Namespace, old.Name, *triggeredPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: updating parameters and triggering plan '%s' is not allowed", old.Namespace, old.Name, *triggeredPlan)
 	case newPlan == kudoapi.CleanupPlanName:
-		return nil, fmt.Errorf("failed to update Instance %s/%s: only the controller schedules the '%s' plan when the instance is deleted", old.Namespace, old.Name, newPlan)
+		return nil, "", fmt.Errorf("failed to update Instance %s/%s: only the controller schedules the '%s' plan when the instance is deleted", old.Namespace, old.Name, newPlan)
 	}
 
 	// Deciding which plan to trigger:
@@ -298,70 +345,63 @@ func admitUpdate(old, new *kudoapi.Instance, ov, oldOv *kudoapi.OperatorVersion)
 	case isUpgrade:
 		plan := kudoapi.SelectPlan([]string{kudoapi.UpgradePlanName, kudoapi.UpdatePlanName, kudoapi.DeployPlanName}, ov)
 		if plan == nil {
-			return nil, fmt.Errorf("failed to update Instance %s/%s: couldn't find any suitable plan that would be triggered by an OperatorVersion upgrade", old.Namespace, old.Name)
+			return nil, "", fmt.Errorf("failed to update Instance %s/%s: couldn't find any suitable plan that would be triggered by an OperatorVersion upgrade", old.Namespace, old.Name)
 		}
 		log.Printf("InstanceAdmission: instance %s/%s is being upgraded using %s plan", new.Namespace, new.Name, *plan)
-		return plan, nil
+		return plan, kudoapi.PlanTriggerUpgrade, nil
 
 	case isParameterUpdate:
 		// if the same plan is triggered by the update, we clean the Instance.Status to effectively restart the plan
 		log.Printf("InstanceAdmission: instance %s/%s, triggering %s plan after parameters has changed", new.Namespace, new.Name, *triggeredPlan)
-		return triggeredPlan, nil
+		return triggeredPlan, kudoapi.PlanTriggerParameterUpdate, nil
 
 	case isNovelPlan:
 		log.Printf("InstanceAdmission: instance %s/%s, new %s plan is triggered", new.Namespace, new.Name, newPlan)
-		return &newPlan, nil
+		// the instance controller marks plans triggered by a schedule or a changed parameter source, everything else is
+		// triggered directly by the user
+		switch new.Spec.PlanExecution.Trigger {
+		case kudoapi.PlanTriggerSchedule, kudoapi.PlanTriggerParameterSource:
+			return &newPlan, new.Spec.PlanExecution.Trigger, nil
+		}
+		return &newPlan, kudoapi.PlanTriggerDirect, nil
+
+	case isOnFailurePlanScheduled:
+		// the instance controller has already populated the plan execution, nothing to do here
+		log.Printf("InstanceAdmission: instance %s/%s, %s plan failed, %s plan is scheduled", new.Namespace, new.Name, oldPlan, newPlan)
+		return nil, "", nil
 
 	case isPlanTerminal:
 		// if current plan is terminal we reset the Instance.PlanExecution field and become ready for the new plan
 		log.Printf("InstanceAdmission: instance %s/%s, %s plan is terminal", new.Namespace, new.Name, newPlan)
 		empty := ""
-		return &empty, nil
+		return &empty, "", nil
+
+	case isPlanCancelRequested:
+		// the plan stays scheduled, the instance controller will stop its execution and set the status to CANCELLED
+		log.Printf("InstanceAdmission: instance %s/%s, %s plan is cancelled", new.Namespace, new.Name, newPlan)
+		return nil, "", nil
 
 	case isPlanRetriggered:
 		// return the existing plan which will lead to a new UID generated and hence the plan will be re-triggered
 		log.Printf("InstanceAdmission: instance %s/%s, %s plan is re-triggered", new.Namespace, new.Name, newPlan)
-		return &newPlan, nil
+		return &newPlan, kudoapi.PlanTriggerDirect, nil
 
 	default:
 		// effectively nothing changed so it's a noop.
 		log.Printf("InstanceAdmission: instance %s/%s no change in plan execution after the update", new.Namespace, new.Name)
-		return nil, nil
+		return nil, "", nil
 	}
 }
 
-// triggeredByParameterUpdate determines what plan to run based on parameters that changed and the corresponding parameter trigger.
-func triggeredByParameterUpdate(params []kudoapi.Parameter, ov *kudoapi.OperatorVersion) (*string, error) {
-	// If no parameters were changed, we return an empty string so no plan would be triggered
-	if len(params) == 0 {
-		return nil, nil
+// isOnFailureOverride returns true if the new plan is the 'onFailure' plan of the old plan and the old plan failed with a fatal error
+func isOnFailureOverride(oldPlan, newPlan string, new *kudoapi.Instance, ov *kudoapi.OperatorVersion) bool {
+	plan, ok := ov.Spec.Plans[oldPlan]
+	if !ok || plan.OnFailure == "" || plan.OnFailure != newPlan {
+		return false
 	}
 
-	plans := make([]string, 0)
-	for _, p := range params {
-		if p.Trigger != "" {
-			if kudoapi.PlanExists(p.Trigger, ov) {
-				plans = append(plans, p.Trigger)
-			} else {
-				return nil, fmt.Errorf("param %s defined trigger plan %s, but plan not defined in operatorversion", p.Name, p.Trigger)
-			}
-		}
-	}
-	plans = funk.UniqString(plans)
-
-	switch len(plans) {
-	case 0:
-		// no plan could be triggered since we do not force existence of the "deploy" plan in the operators
-		fallback := kudoapi.SelectPlan([]string{kudoapi.UpdatePlanName, kudoapi.DeployPlanName}, ov)
-		if fallback == nil {
-			return nil, fmt.Errorf("couldn't find any plans that would be triggered by the update")
-		}
-		return fallback, nil
-	case 1:
-		return &plans[0], nil
-	default:
-		return nil, fmt.Errorf("triggering multiple plans: [%v] at once is not allowed", plans)
-	}
+	ps := new.PlanStatus(oldPlan)
+	return ps != nil && ps.Status == kudoapi.ExecutionFatalError
 }
 
 // changedParameters returns a list of parameter definitions for params which value changed or that were added from old to new
@@ -455,11 +495,35 @@ func validateOVUpgrade(new map[string]string, newOv, oldOv *kudoapi.OperatorVers
 	return nil
 }
 
-// validateParameters ensures that all parameters have correct values
+// specParameters returns the parameters of the instance spec including the parameters sourced from Secrets and
+// ConfigMaps. The values of the latter are not known here, they are represented by their source instead.
+func specParameters(i *kudoapi.Instance) map[string]string {
+	if len(i.Spec.ParametersFrom) == 0 {
+		return i.Spec.Parameters
+	}
+
+	params := make(map[string]string, len(i.Spec.Parameters)+len(i.Spec.ParametersFrom))
+	for name, value := range i.Spec.Parameters {
+		params[name] = value
+	}
+	for name, source := range i.Spec.ParametersFrom {
+		params[name] = fmt.Sprintf("valueFrom:%s", source)
+	}
+	return params
+}
+
+// validateParameters ensures that all parameters have correct values. References to sensitive parameter values are
+// validated by validateSensitiveParameters, parameters sourced from Secrets and ConfigMaps when a plan is executed.
 func validateParameters(ov *kudoapi.OperatorVersion, instance *kudoapi.Instance) error {
 	for _, p := range ov.Spec.Parameters {
 		p := p
 		pValue := instance.Spec.Parameters[p.Name]
+		if p.IsSensitive() && kudoapi.IsSensitiveParameterRef(pValue) {
+			continue
+		}
+		if _, ok := instance.Spec.ParametersFrom[p.Name]; ok {
+			continue
+		}
 
 		if err := p.ValidateValue(pValue); err != nil {
 			return err
@@ -469,6 +533,113 @@ func validateParameters(ov *kudoapi.OperatorVersion, instance *kudoapi.Instance)
 	return nil
 }
 
+// validatePlaintextSensitiveParameters ensures that the values of sensitive parameters are stored in the sensitive
+// parameters Secret of the instance and that the instance references the Secret by its expected name. Plain values
+// are only accepted if they are unchanged from the old instance, which was created before values were stored in the
+// Secret. The old instance is nil when an instance is created.
+func validatePlaintextSensitiveParameters(ov *kudoapi.OperatorVersion, old, instance *kudoapi.Instance) error {
+	name := kudoapi.SensitiveParametersSecretName(instance.Name)
+	if instance.Spec.SensitiveParametersSecret != "" && instance.Spec.SensitiveParametersSecret != name {
+		return fmt.Errorf("the sensitive parameters secret has to be named '%s'", name)
+	}
+
+	for _, p := range kudoapi.PlaintextSensitiveParameters(ov, instance.Spec.Parameters) {
+		if old != nil {
+			if v, ok := old.Spec.Parameters[p]; ok && v == instance.Spec.Parameters[p] {
+				continue
+			}
+		}
+		return fmt.Errorf("parameter '%s' is sensitive, its value has to be stored in the secret '%s' and referenced as '%s<key>'", p, name, kudoapi.SensitiveParameterRefPrefix)
+	}
+	return nil
+}
+
+// validateSensitiveParameters ensures that all references to sensitive parameter values can be resolved from the
+// sensitive parameters Secret of the instance and that the resolved values are correct
+func validateSensitiveParameters(ov *kudoapi.OperatorVersion, instance *kudoapi.Instance, c client.Reader) error {
+	resolved, err := instance.ResolveSensitiveParameters(instance.Spec.Parameters, ov, c)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range ov.Spec.Parameters {
+		p := p
+		if !kudoapi.IsSensitiveParameterRef(instance.Spec.Parameters[p.Name]) {
+			continue
+		}
+
+		if err := p.ValidateValue(resolved[p.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateParametersFrom ensures that all parameters sourced from Secrets and ConfigMaps are defined by the operator
+// version, are not immutable, are not set directly as well and reference exactly one key of a Secret or ConfigMap.
+// The referenced objects don't have to exist yet.
+func validateParametersFrom(ov *kudoapi.OperatorVersion, instance *kudoapi.Instance) error {
+	for name, source := range instance.Spec.ParametersFrom {
+		defs, err := kudoapi.GetParamDefinitions(map[string]string{name: ""}, ov)
+		if err != nil {
+			return err
+		}
+		if defs[0].IsImmutable() {
+			return fmt.Errorf("parameter '%s' is immutable and can not be sourced from a secret or configmap", name)
+		}
+		if _, ok := instance.Spec.Parameters[name]; ok {
+			return fmt.Errorf("parameter '%s' has a value and is sourced from %s", name, source)
+		}
+
+		switch {
+		case source.SecretKeyRef != nil && source.ConfigMapKeyRef != nil:
+			return fmt.Errorf("parameter '%s' can be sourced either from a secret or from a configmap, not both", name)
+		case source.SecretKeyRef != nil:
+			if source.SecretKeyRef.Name == "" || source.SecretKeyRef.Key == "" {
+				return fmt.Errorf("parameter '%s' references a secret without name or key", name)
+			}
+		case source.ConfigMapKeyRef != nil:
+			if source.ConfigMapKeyRef.Name == "" || source.ConfigMapKeyRef.Key == "" {
+				return fmt.Errorf("parameter '%s' references a configmap without name or key", name)
+			}
+		default:
+			return fmt.Errorf("parameter '%s' has no secret or configmap reference", name)
+		}
+	}
+	return nil
+}
+
+// validateSchedules checks that all schedules have a unique name, a valid cron expression and concurrency policy and
+// trigger an existing plan. The 'cleanup' plan can not be scheduled.
+func validateSchedules(ov *kudoapi.OperatorVersion, instance *kudoapi.Instance) error {
+	names := map[string]bool{}
+	for _, s := range instance.Spec.Schedules {
+		if s.Name == "" {
+			return fmt.Errorf("schedule of plan '%s' has no name", s.Plan)
+		}
+		if names[s.Name] {
+			return fmt.Errorf("schedule '%s' is defined more than once", s.Name)
+		}
+		names[s.Name] = true
+
+		if _, err := cron.ParseStandard(s.Cron); err != nil {
+			return fmt.Errorf("schedule '%s' has an invalid cron expression '%s': %v", s.Name, s.Cron, err)
+		}
+		if !kudoapi.PlanExists(s.Plan, ov) {
+			return fmt.Errorf("schedule '%s' triggers plan '%s' that does not exist", s.Name, s.Plan)
+		}
+		if s.Plan == kudoapi.CleanupPlanName {
+			return fmt.Errorf("schedule '%s' can not trigger the '%s' plan", s.Name, s.Plan)
+		}
+		switch s.ConcurrencyPolicy {
+		case "", kudoapi.ConcurrencyPolicySkip, kudoapi.ConcurrencyPolicyQueue:
+		default:
+			return fmt.Errorf("schedule '%s' has an invalid concurrency policy '%s', must be one of '%s' or '%s'", s.Name, s.ConcurrencyPolicy, kudoapi.ConcurrencyPolicySkip, kudoapi.ConcurrencyPolicyQueue)
+		}
+	}
+	return nil
+}
+
 // setImmutableParameterDefaults sets the default values for immutable parameters into the instances parameter map
 func setImmutableParameterDefaults(ov *kudoapi.OperatorVersion, instance *kudoapi.Instance) {
 	for _, p := range ov.Spec.Parameters {
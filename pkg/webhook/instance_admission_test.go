@@ -10,6 +10,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	"github.com/kudobuilder/kudo/pkg/util/convert"
@@ -102,6 +104,14 @@ func TestValidateUpdate(t *testing.T) {
 	uninstalling := deleted.DeepCopy()
 	uninstalling.Spec.PlanExecution.PlanName = cleanup
 
+	rollback := "rollback"
+	rollbackOv := ov.DeepCopy()
+	rollbackOv.Spec.Plans[deploy] = kudoapi.Plan{OnFailure: rollback}
+	rollbackOv.Spec.Plans[rollback] = kudoapi.Plan{}
+
+	rollbackScheduled := scheduled.DeepCopy()
+	rollbackScheduled.Spec.PlanExecution = kudoapi.PlanExecution{PlanName: rollback, UID: uuid.NewUUID(), Status: kudoapi.ExecutionNeverRun}
+
 	tests := []struct {
 		name    string
 		new     *kudoapi.Instance
@@ -241,6 +251,87 @@ func TestValidateUpdate(t *testing.T) {
 			ov:      ov,
 			wantErr: true,
 		},
+		{
+			name: "canceling an existing plan with the cancel flag IS allowed",
+			old:  scheduled,
+			new: func() *kudoapi.Instance {
+				i := scheduled.DeepCopy()
+				i.Spec.PlanExecution.Cancel = true
+				return i
+			}(),
+			ov: ov,
+		},
+		{
+			name: "canceling with the cancel flag when NO plan is scheduled is NOT allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.PlanExecution.Cancel = true
+				return i
+			}(),
+			ov:      ov,
+			wantErr: true,
+		},
+		{
+			name: "canceling an existing plan together with a parameter update is NOT allowed",
+			old:  scheduled,
+			new: func() *kudoapi.Instance {
+				i := scheduled.DeepCopy()
+				i.Spec.PlanExecution.Cancel = true
+				i.Spec.Parameters = map[string]string{"foo": "newFoo"}
+				return i
+			}(),
+			ov:      ov,
+			wantErr: true,
+		},
+		{
+			name: "cleanup plan CAN NOT be cancelled with the cancel flag when the instance is being deleted",
+			old:  uninstalling,
+			new: func() *kudoapi.Instance {
+				i := uninstalling.DeepCopy()
+				i.Spec.PlanExecution.Cancel = true
+				return i
+			}(),
+			ov:      ov,
+			wantErr: true,
+		},
+		{
+			name: "plan execution IS reset when the plan is cancelled",
+			old: func() *kudoapi.Instance {
+				i := scheduled.DeepCopy()
+				i.Spec.PlanExecution.Cancel = true
+				return i
+			}(),
+			new: func() *kudoapi.Instance {
+				i := scheduled.DeepCopy()
+				i.Spec.PlanExecution.Cancel = true
+				i.Spec.PlanExecution.Status = kudoapi.ExecutionCancelled
+				return i
+			}(),
+			ov:   ov,
+			want: &empty,
+		},
+		{
+			name: "onFailure plan scheduled after a fatal error of the plan IS allowed",
+			old:  scheduled,
+			new: func() *kudoapi.Instance {
+				i := rollbackScheduled.DeepCopy()
+				i.Status.PlanStatus = map[string]kudoapi.PlanStatus{deploy: {Name: deploy, Status: kudoapi.ExecutionFatalError}}
+				return i
+			}(),
+			ov: rollbackOv,
+		},
+		{
+			name: "onFailure plan scheduled while the plan did NOT fail IS NOT allowed",
+			old:  scheduled,
+			new: func() *kudoapi.Instance {
+				i := rollbackScheduled.DeepCopy()
+				i.Status.PlanStatus = map[string]kudoapi.PlanStatus{deploy: {Name: deploy, Status: kudoapi.ExecutionInProgress}}
+				return i
+			}(),
+			ov:      rollbackOv,
+			wantErr: true,
+		},
 		{
 			name: "upgrade triggered on an idle instance IS allowed",
 			old:  idle,
@@ -548,6 +639,94 @@ func TestValidateUpdate(t *testing.T) {
 			}(),
 			want: &update,
 		},
+		{
+			name: "adding a valid schedule is allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "0 2 * * *", Plan: backup, ConcurrencyPolicy: kudoapi.ConcurrencyPolicyQueue}}
+				return i
+			}(),
+			ov:   ov,
+			want: nil,
+		},
+		{
+			name: "the instance controller triggering a scheduled plan is allowed",
+			old: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "@daily", Plan: backup}}
+				return i
+			}(),
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "@daily", Plan: backup}}
+				i.Spec.PlanExecution.PlanName = backup
+				i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerSchedule
+				return i
+			}(),
+			ov:   ov,
+			want: &backup,
+		},
+		{
+			name: "schedule with an invalid cron expression is NOT allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "every night", Plan: backup}}
+				return i
+			}(),
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "schedule of a non-existing plan is NOT allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "@daily", Plan: "missing"}}
+				return i
+			}(),
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "schedule of the cleanup plan is NOT allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "@daily", Plan: cleanup}}
+				return i
+			}(),
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "schedules with the same name are NOT allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "@daily", Plan: backup}, {Name: "nightly", Cron: "@hourly", Plan: update}}
+				return i
+			}(),
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "schedule with an unknown concurrency policy is NOT allowed",
+			old:  idle,
+			new: func() *kudoapi.Instance {
+				i := idle.DeepCopy()
+				i.Spec.Schedules = []kudoapi.Schedule{{Name: "nightly", Cron: "@daily", Plan: backup, ConcurrencyPolicy: "Replace"}}
+				return i
+			}(),
+			ov:      ov,
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "plan does not exist",
 			old:  idle,
@@ -569,7 +748,7 @@ func TestValidateUpdate(t *testing.T) {
 			if tt.oldOv == nil {
 				tt.oldOv = tt.ov
 			}
-			got, err := admitUpdate(tt.old, tt.new, tt.ov, tt.oldOv)
+			got, _, err := admitUpdate(tt.old, tt.new, tt.ov, tt.oldOv)
 			assert.Equal(t, tt.wantErr, err != nil, "expected an error: %v but got: %v", tt.wantErr, err)
 			if err != nil {
 				log.Printf("err: %v", err)
@@ -579,6 +758,61 @@ func TestValidateUpdate(t *testing.T) {
 	}
 }
 
+func Test_admitUpdateTrigger(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Plans:      map[string]kudoapi.Plan{"deploy": {}, "upgrade": {}, "backup": {}},
+			Parameters: []kudoapi.Parameter{{Name: "foo", Trigger: "deploy"}},
+		},
+	}
+	newOv := ov.DeepCopy()
+	newOv.Name = "foo-operator-2.0"
+
+	idle := &kudoapi.Instance{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"},
+		Spec: kudoapi.InstanceSpec{
+			OperatorVersion: v1.ObjectReference{Name: "foo-operator"},
+			Parameters:      map[string]string{"foo": "foo"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		new         func(i *kudoapi.Instance)
+		ov          *kudoapi.OperatorVersion
+		wantTrigger kudoapi.PlanTrigger
+	}{
+		{name: "parameter update", new: func(i *kudoapi.Instance) { i.Spec.Parameters["foo"] = "bar" }, ov: ov, wantTrigger: kudoapi.PlanTriggerParameterUpdate},
+		{name: "upgrade", new: func(i *kudoapi.Instance) { i.Spec.OperatorVersion.Name = newOv.Name }, ov: newOv, wantTrigger: kudoapi.PlanTriggerUpgrade},
+		{name: "directly triggered plan", new: func(i *kudoapi.Instance) { i.Spec.PlanExecution.PlanName = "backup" }, ov: ov, wantTrigger: kudoapi.PlanTriggerDirect},
+		{name: "plan triggered by a schedule", new: func(i *kudoapi.Instance) {
+			i.Spec.PlanExecution.PlanName = "backup"
+			i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerSchedule
+		}, ov: ov, wantTrigger: kudoapi.PlanTriggerSchedule},
+		{name: "plan triggered by a changed parameter source", new: func(i *kudoapi.Instance) {
+			i.Spec.PlanExecution.PlanName = "deploy"
+			i.Spec.PlanExecution.Trigger = kudoapi.PlanTriggerParameterSource
+		}, ov: ov, wantTrigger: kudoapi.PlanTriggerParameterSource},
+		{name: "parameter sourced from a secret", new: func(i *kudoapi.Instance) {
+			delete(i.Spec.Parameters, "foo")
+			i.Spec.ParametersFrom = map[string]kudoapi.ParameterSource{
+				"foo": {SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "foo"}, Key: "foo"}},
+			}
+		}, ov: ov, wantTrigger: kudoapi.PlanTriggerParameterUpdate},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			new := idle.DeepCopy()
+			tt.new(new)
+			_, trigger, err := admitUpdate(idle, new, tt.ov, ov)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTrigger, trigger)
+		})
+	}
+}
+
 func stringPtrToString(p *string) string {
 	if p != nil {
 		return *p
@@ -586,85 +820,134 @@ func stringPtrToString(p *string) string {
 	return "<nil>"
 }
 
-func Test_triggeredPlan(t *testing.T) {
+func Test_validateSensitiveParameters(t *testing.T) {
 	ov := &kudoapi.OperatorVersion{
-		ObjectMeta: metav1.ObjectMeta{Name: "foo-operator", Namespace: "default"},
-		TypeMeta:   metav1.TypeMeta{Kind: "OperatorVersion", APIVersion: "kudo.dev/v1beta1"},
 		Spec: kudoapi.OperatorVersionSpec{
-			Plans: map[string]kudoapi.Plan{"deploy": {}, "update": {}, "backup": {}},
 			Parameters: []kudoapi.Parameter{
-				{
-					Name:    "param",
-					Default: convert.StringPtr("default"),
-				},
+				{Name: "PASSWORD", Sensitive: convert.BoolPtr(true)},
+				{Name: "PORT", Type: kudoapi.IntegerValueType, Sensitive: convert.BoolPtr(true)},
 			},
 		},
 	}
-
-	update := "update"
-	backup := "backup"
-	deploy := "deploy"
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-sensitive-parameters", Namespace: "default"},
+		Data:       map[string][]byte{"PASSWORD.abc": []byte("s3cr3t"), "PORT.abc": []byte("8080"), "PORT.def": []byte("http")},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, secret)
 
 	tests := []struct {
 		name    string
-		params  []kudoapi.Parameter
-		ov      *kudoapi.OperatorVersion
-		want    *string
-		wantErr bool
+		params  map[string]string
+		wantErr string
 	}{
-		{
-			name:    "no change doesn't trigger anything",
-			params:  []kudoapi.Parameter{},
-			ov:      ov,
-			want:    nil,
-			wantErr: false,
-		},
-		{
-			name:    "param without an explicit trigger, triggers update plan",
-			params:  []kudoapi.Parameter{{Name: "foo"}},
-			ov:      ov,
-			want:    &update,
-			wantErr: false,
-		},
-		{
-			name:    "param with an explicit trigger",
-			params:  []kudoapi.Parameter{{Name: "foo", Trigger: "backup"}},
-			ov:      ov,
-			want:    &backup,
-			wantErr: false,
-		},
-		{
-			name:    "two params with the same triggers",
-			params:  []kudoapi.Parameter{{Name: "foo", Trigger: "deploy"}, {Name: "bar", Trigger: "deploy"}},
-			ov:      ov,
-			want:    &deploy,
-			wantErr: false,
-		},
-		{
-			name:    "two params with conflicting triggers lead to an error",
-			params:  []kudoapi.Parameter{{Name: "foo", Trigger: "deploy"}, {Name: "bar", Trigger: "update"}},
-			ov:      ov,
-			want:    nil,
-			wantErr: true,
+		{name: "plain values are validated by validateParameters", params: map[string]string{"PORT": "8080"}},
+		{name: "valid references", params: map[string]string{"PASSWORD": "sensitive:PASSWORD.abc", "PORT": "sensitive:PORT.abc"}},
+		{name: "invalid value", params: map[string]string{"PORT": "sensitive:PORT.def"}, wantErr: `parameter "PORT" has an invalid value`},
+		{name: "missing value", params: map[string]string{"PASSWORD": "sensitive:PASSWORD.def"}, wantErr: "sensitive parameters secret default/test-sensitive-parameters has no value sensitive:PASSWORD.def for parameter PASSWORD"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &kudoapi.Instance{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec:       kudoapi.InstanceSpec{Parameters: tt.params, SensitiveParametersSecret: "test-sensitive-parameters"},
+			}
+			assert.NoError(t, validateParameters(ov, instance), "references must be skipped by validateParameters")
+
+			err := validateSensitiveParameters(ov, instance, c)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_validatePlaintextSensitiveParameters(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{
+				{Name: "PASSWORD", Sensitive: convert.BoolPtr(true)},
+				{Name: "NODES"},
+			},
 		},
-		{
-			name:    "params triggering a non-existing plan",
-			params:  []kudoapi.Parameter{{Name: "foo", Trigger: "fake"}},
-			ov:      ov,
-			want:    nil,
-			wantErr: true,
+	}
+	instance := func(secret string, params map[string]string) *kudoapi.Instance {
+		return &kudoapi.Instance{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       kudoapi.InstanceSpec{Parameters: params, SensitiveParametersSecret: secret},
+		}
+	}
+	plain := instance("", map[string]string{"PASSWORD": "s3cr3t", "NODES": "3"})
+
+	tests := []struct {
+		name    string
+		old     *kudoapi.Instance
+		new     *kudoapi.Instance
+		wantErr string
+	}{
+		{name: "references", new: instance("test-sensitive-parameters", map[string]string{"PASSWORD": "sensitive:PASSWORD.abc", "NODES": "3"})},
+		{name: "plain values of other parameters", new: instance("", map[string]string{"NODES": "3"})},
+		{name: "plain value", new: plain, wantErr: "parameter 'PASSWORD' is sensitive, its value has to be stored in the secret 'test-sensitive-parameters' and referenced as 'sensitive:<key>'"},
+		{name: "unchanged plain value", old: plain, new: instance("", map[string]string{"PASSWORD": "s3cr3t", "NODES": "5"})},
+		{name: "changed plain value", old: plain, new: instance("", map[string]string{"PASSWORD": "n3w-s3cr3t"}), wantErr: "parameter 'PASSWORD' is sensitive, its value has to be stored in the secret 'test-sensitive-parameters' and referenced as 'sensitive:<key>'"},
+		{name: "other secret", new: instance("credentials", map[string]string{"PASSWORD": "sensitive:password"}), wantErr: "the sensitive parameters secret has to be named 'test-sensitive-parameters'"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePlaintextSensitiveParameters(ov, tt.old, tt.new)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateParametersFrom(t *testing.T) {
+	ov := &kudoapi.OperatorVersion{
+		Spec: kudoapi.OperatorVersionSpec{
+			Parameters: []kudoapi.Parameter{
+				{Name: "PASSWORD", Required: convert.BoolPtr(true)},
+				{Name: "DISK", Immutable: convert.BoolPtr(true)},
+			},
 		},
 	}
+	secretRef := &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "credentials"}, Key: "password"}
+	configMapRef := &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}, Key: "password"}
+
+	tests := []struct {
+		name    string
+		params  map[string]string
+		from    map[string]kudoapi.ParameterSource
+		wantErr string
+	}{
+		{name: "secret", from: map[string]kudoapi.ParameterSource{"PASSWORD": {SecretKeyRef: secretRef}}},
+		{name: "configmap", from: map[string]kudoapi.ParameterSource{"PASSWORD": {ConfigMapKeyRef: configMapRef}}},
+		{name: "unknown parameter", from: map[string]kudoapi.ParameterSource{"USER": {SecretKeyRef: secretRef}}, wantErr: `failed to find parameter "USER" in the OperatorVersion`},
+		{name: "immutable parameter", from: map[string]kudoapi.ParameterSource{"DISK": {SecretKeyRef: secretRef}}, wantErr: "parameter 'DISK' is immutable and can not be sourced from a secret or configmap"},
+		{name: "value and source", params: map[string]string{"PASSWORD": "s3cr3t"}, from: map[string]kudoapi.ParameterSource{"PASSWORD": {SecretKeyRef: secretRef}}, wantErr: "parameter 'PASSWORD' has a value and is sourced from secret credentials/password"},
+		{name: "secret and configmap", from: map[string]kudoapi.ParameterSource{"PASSWORD": {SecretKeyRef: secretRef, ConfigMapKeyRef: configMapRef}}, wantErr: "parameter 'PASSWORD' can be sourced either from a secret or from a configmap, not both"},
+		{name: "no key", from: map[string]kudoapi.ParameterSource{"PASSWORD": {SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "credentials"}}}}, wantErr: "parameter 'PASSWORD' references a secret without name or key"},
+		{name: "no reference", from: map[string]kudoapi.ParameterSource{"PASSWORD": {}}, wantErr: "parameter 'PASSWORD' has no secret or configmap reference"},
+	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := triggeredByParameterUpdate(tt.params, tt.ov)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("triggeredByParameterUpdate() error = %v, wantErr %v, got = %s", err, tt.wantErr, stringPtrToString(got))
+			instance := &kudoapi.Instance{Spec: kudoapi.InstanceSpec{Parameters: tt.params, ParametersFrom: tt.from}}
+			err := validateParametersFrom(ov, instance)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
 				return
 			}
-			assert.Equal(t, tt.want, got)
+			assert.NoError(t, err)
+			assert.NoError(t, validateParameters(ov, instance), "sourced required parameters must be skipped by validateParameters")
 		})
 	}
 }
@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/convert"
+	pkgverifier "github.com/kudobuilder/kudo/pkg/kudoctl/packages/verifier"
+)
+
+// +k8s:deepcopy-gen=false
+
+// OperatorVersionAdmission validates OperatorVersions and guards OperatorVersions used by Instances from being changed
+// or deleted
+type OperatorVersionAdmission struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+func NewOperatorVersionAdmission(cfg *rest.Config, s *runtime.Scheme) (*OperatorVersionAdmission, error) {
+	// an uncached client, see NewInstanceAdmission
+	c, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OperatorVersionAdmission{client: c}, nil
+}
+
+// Handle validates created and updated OperatorVersions with the package verifiers. An OperatorVersion is the contract
+// its Instances are executed against, so its tasks, plans, parameters and templates can't be changed and it can't be
+// deleted while it is referenced by an Instance.
+func (oa *OperatorVersionAdmission) Handle(ctx context.Context, req admission.Request) admission.Response {
+
+	switch req.Operation {
+
+	case v1beta1.Create:
+		ov := &kudoapi.OperatorVersion{}
+		if err := oa.decoder.DecodeRaw(req.Object, ov); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := validateOperatorVersion(ov); err != nil {
+			return admission.Denied(fmt.Sprintf("failed to create OperatorVersion %s/%s: %v", req.Namespace, req.Name, err))
+		}
+		return admission.Allowed("")
+
+	case v1beta1.Update:
+		old, new := &kudoapi.OperatorVersion{}, &kudoapi.OperatorVersion{}
+		if err := oa.decoder.DecodeRaw(req.Object, new); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err := oa.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		// we explicitly ignore Metadata updates
+		if reflect.DeepEqual(old.Spec, new.Spec) {
+			return admission.Allowed("")
+		}
+
+		instances, err := kudoapi.ListInstancesOfOperatorVersion(req.Name, req.Namespace, oa.client)
+		if err != nil {
+			log.Printf("OperatorVersionAdmission: Error listing instances of operatorVersion %s/%s: %v", req.Namespace, req.Name, err)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if err := validateOperatorVersionUpdate(old, new, instances); err != nil {
+			return admission.Denied(fmt.Sprintf("failed to update OperatorVersion %s/%s: %v", req.Namespace, req.Name, err))
+		}
+		return admission.Allowed("")
+
+	case v1beta1.Delete:
+		instances, err := kudoapi.ListInstancesOfOperatorVersion(req.Name, req.Namespace, oa.client)
+		if err != nil {
+			log.Printf("OperatorVersionAdmission: Error listing instances of operatorVersion %s/%s: %v", req.Namespace, req.Name, err)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if len(instances) > 0 {
+			return admission.Denied(fmt.Sprintf("failed to delete OperatorVersion %s/%s: it is used by instance(s) %s", req.Namespace, req.Name, instanceNames(instances)))
+		}
+		return admission.Allowed("")
+
+	default:
+		return admission.Allowed("")
+	}
+}
+
+// validateOperatorVersion returns an error with all errors found by the shared package verifiers. Warnings are
+// ignored, they are reported by the OperatorVersion controller.
+func validateOperatorVersion(ov *kudoapi.OperatorVersion) error {
+	pf, err := convert.OperatorVersionToFiles(ov)
+	if err != nil {
+		return fmt.Errorf("OperatorVersion is invalid: %v", err)
+	}
+	res := pkgverifier.Verify(pf)
+	if !res.IsValid() {
+		return fmt.Errorf("OperatorVersion is invalid: %s", strings.Join(res.Errors, ", "))
+	}
+	return nil
+}
+
+// validateOperatorVersionUpdate returns an error if the updated OperatorVersion is invalid, or if the tasks, plans,
+// parameters or templates of an OperatorVersion that is used by instances are changed
+func validateOperatorVersionUpdate(old, new *kudoapi.OperatorVersion, instances []kudoapi.Instance) error {
+	if len(instances) > 0 {
+		changed := []string{}
+		if !reflect.DeepEqual(old.Spec.Tasks, new.Spec.Tasks) {
+			changed = append(changed, "tasks")
+		}
+		if !reflect.DeepEqual(old.Spec.Plans, new.Spec.Plans) {
+			changed = append(changed, "plans")
+		}
+		if !reflect.DeepEqual(old.Spec.Parameters, new.Spec.Parameters) {
+			changed = append(changed, "parameters")
+		}
+		if !reflect.DeepEqual(old.Spec.Templates, new.Spec.Templates) {
+			changed = append(changed, "templates")
+		}
+		if len(changed) > 0 {
+			return fmt.Errorf("%s can not be changed while the OperatorVersion is used by instance(s) %s", strings.Join(changed, ", "), instanceNames(instances))
+		}
+	}
+
+	return validateOperatorVersion(new)
+}
+
+func instanceNames(instances []kudoapi.Instance) string {
+	names := make([]string, 0, len(instances))
+	for _, i := range instances {
+		names = append(names, fmt.Sprintf("%s/%s", i.Namespace, i.Name))
+	}
+	return strings.Join(names, ", ")
+}
+
+// InjectDecoder injects the decoder.
+func (oa *OperatorVersionAdmission) InjectDecoder(d *admission.Decoder) error {
+	oa.decoder = d
+	return nil
+}
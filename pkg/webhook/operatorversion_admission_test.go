@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/api/admission/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kudobuilder/kudo/pkg/apis"
+	kudoapi "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+// operatorVersion returns an OperatorVersion that passes the package verifiers
+func operatorVersion() *kudoapi.OperatorVersion {
+	replicas := "1"
+	return &kudoapi.OperatorVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-1.0", Namespace: "default"},
+		Spec: kudoapi.OperatorVersionSpec{
+			Operator:   v1.ObjectReference{Name: "foo"},
+			Version:    "1.0",
+			Parameters: []kudoapi.Parameter{{Name: "REPLICAS", Default: &replicas}},
+			Templates: map[string]string{
+				"app.yaml": "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: app\nspec:\n  replicas: {{ .Params.REPLICAS }}\n",
+			},
+			Tasks: []kudoapi.Task{{
+				Name: "app",
+				Kind: "Apply",
+				Spec: kudoapi.TaskSpec{ResourceTaskSpec: kudoapi.ResourceTaskSpec{Resources: []string{"app.yaml"}}},
+			}},
+			Plans: map[string]kudoapi.Plan{
+				"deploy": {Phases: []kudoapi.Phase{{Name: "app", Steps: []kudoapi.Step{{Name: "app", Tasks: []string{"app"}}}}}},
+			},
+		},
+	}
+}
+
+func TestValidateOperatorVersionUpdate(t *testing.T) {
+	ov := operatorVersion()
+	instances := []kudoapi.Instance{{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}}
+	updatePlan := kudoapi.Plan{Phases: []kudoapi.Phase{{Name: "app", Steps: []kudoapi.Step{{Name: "app", Tasks: []string{"app"}}}}}}
+
+	tests := []struct {
+		name      string
+		update    func(ov *kudoapi.OperatorVersion)
+		instances []kudoapi.Instance
+		wantErr   string
+	}{
+		{
+			name:   "unused operator version can change plans",
+			update: func(ov *kudoapi.OperatorVersion) { ov.Spec.Plans["update"] = updatePlan },
+		},
+		{
+			name:      "used operator version can't change plans",
+			update:    func(ov *kudoapi.OperatorVersion) { ov.Spec.Plans["update"] = updatePlan },
+			instances: instances,
+			wantErr:   "plans can not be changed while the OperatorVersion is used by instance(s) default/foo",
+		},
+		{
+			name: "used operator version can't change tasks, parameters and templates",
+			update: func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Tasks[0].Kind = "Delete"
+				ov.Spec.Parameters[0].Trigger = "update"
+				ov.Spec.Templates["app.yaml"] = "kind: Deployment"
+			},
+			instances: instances,
+			wantErr:   "tasks, parameters, templates can not be changed while the OperatorVersion is used by instance(s) default/foo",
+		},
+		{
+			name:      "used operator version can change other fields",
+			update:    func(ov *kudoapi.OperatorVersion) { ov.Spec.UpgradableFrom = []v1.ObjectReference{{Name: "foo-0.9"}} },
+			instances: instances,
+		},
+		{
+			name:    "invalid operator version",
+			update:  func(ov *kudoapi.OperatorVersion) { ov.Spec.Plans = map[string]kudoapi.Plan{} },
+			wantErr: "OperatorVersion is invalid: an operator is required to have 'deploy' plan",
+		},
+		{
+			name: "duplicate parameter",
+			update: func(ov *kudoapi.OperatorVersion) {
+				ov.Spec.Parameters = append(ov.Spec.Parameters, kudoapi.Parameter{Name: "replicas"})
+			},
+			wantErr: `OperatorVersion is invalid: parameter "replicas" has a duplicate`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			new := ov.DeepCopy()
+			tt.update(new)
+
+			err := validateOperatorVersionUpdate(ov, new, tt.instances)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOperatorVersionAdmission_HandleDelete(t *testing.T) {
+	assert.NoError(t, apis.AddToScheme(scheme.Scheme))
+
+	instance := func(name, ns string, ovRef v1.ObjectReference) *kudoapi.Instance {
+		return &kudoapi.Instance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec:       kudoapi.InstanceSpec{OperatorVersion: ovRef},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		instances []*kudoapi.Instance
+		allowed   bool
+	}{
+		{name: "unused operator version", allowed: true},
+		{
+			name:      "operator version used in another namespace",
+			instances: []*kudoapi.Instance{instance("foo", "other", v1.ObjectReference{Name: "foo-1.0"})},
+			allowed:   true,
+		},
+		{
+			name:      "operator version used by an instance",
+			instances: []*kudoapi.Instance{instance("foo", "default", v1.ObjectReference{Name: "foo-1.0"})},
+		},
+		{
+			name:      "operator version used by an instance of another namespace",
+			instances: []*kudoapi.Instance{instance("foo", "other", v1.ObjectReference{Name: "foo-1.0", Namespace: "default"})},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewFakeClientWithScheme(scheme.Scheme)
+			for _, i := range tt.instances {
+				assert.NoError(t, c.Create(context.TODO(), i))
+			}
+			oa := &OperatorVersionAdmission{client: c}
+
+			req := admission.Request{}
+			req.Operation = v1beta1.Delete
+			req.Name = "foo-1.0"
+			req.Namespace = "default"
+
+			res := oa.Handle(context.TODO(), req)
+			assert.Equal(t, tt.allowed, res.Allowed, res.Result.Message)
+		})
+	}
+}